@@ -0,0 +1,91 @@
+package nodepool
+
+import (
+	"io"
+	"os"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/giantswarm/kubectl-gs/pkg/middleware"
+	"github.com/giantswarm/kubectl-gs/pkg/middleware/renewtoken"
+)
+
+const (
+	name  = "nodepool <nodepool-name>"
+	alias = "nodepools"
+
+	shortDescription = "Show details of a node pool"
+	longDescription  = `Show details of a node pool
+
+Besides the information shown by 'kubectl gs get nodepools', this
+includes the instance type or VM size, the availability zones, the
+subnet, the distribution of on-demand and spot instances, the
+autoscaler settings compared to the actual number of nodes, and the
+capabilities of the node pool's release.`
+
+	examples = `  # Show details of a node pool
+  kubectl gs describe nodepool 3f01a
+
+  # Show details of a node pool of a specific cluster, in another namespace
+  kubectl gs describe nodepool 3f01a --cluster-name f83ir --namespace org-acme`
+)
+
+type Config struct {
+	Logger     micrologger.Logger
+	FileSystem afero.Fs
+
+	K8sConfigAccess clientcmd.ConfigAccess
+
+	Stderr io.Writer
+	Stdout io.Writer
+}
+
+func New(config Config) (*cobra.Command, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.FileSystem == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.FileSystem must not be empty", config)
+	}
+	if config.K8sConfigAccess == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sConfigAccess must not be empty", config)
+	}
+	if config.Stderr == nil {
+		config.Stderr = os.Stderr
+	}
+	if config.Stdout == nil {
+		config.Stdout = os.Stdout
+	}
+
+	f := &flag{}
+
+	r := &runner{
+		flag:   f,
+		logger: config.Logger,
+		fs:     config.FileSystem,
+
+		stderr: config.Stderr,
+		stdout: config.Stdout,
+	}
+
+	c := &cobra.Command{
+		Use:     name,
+		Short:   shortDescription,
+		Long:    longDescription,
+		Example: examples,
+		Aliases: []string{alias, "np"},
+		Args:    cobra.ExactArgs(1),
+		RunE:    r.Run,
+		PreRunE: middleware.Compose(
+			renewtoken.Middleware(config.K8sConfigAccess),
+		),
+	}
+
+	f.Init(c)
+
+	return c, nil
+}
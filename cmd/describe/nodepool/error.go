@@ -0,0 +1,30 @@
+package nodepool
+
+import "github.com/giantswarm/microerror"
+
+var invalidConfigError = &microerror.Error{
+	Kind: "invalidConfigError",
+}
+
+// IsInvalidConfig asserts invalidConfigError.
+func IsInvalidConfig(err error) bool {
+	return microerror.Cause(err) == invalidConfigError
+}
+
+var notFoundError = &microerror.Error{
+	Kind: "notFoundError",
+}
+
+// IsNotFound asserts notFoundError.
+func IsNotFound(err error) bool {
+	return microerror.Cause(err) == notFoundError
+}
+
+var unsupportedProviderError = &microerror.Error{
+	Kind: "unsupportedProviderError",
+}
+
+// IsUnsupportedProvider asserts unsupportedProviderError.
+func IsUnsupportedProvider(err error) bool {
+	return microerror.Cause(err) == unsupportedProviderError
+}
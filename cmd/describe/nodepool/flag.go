@@ -0,0 +1,30 @@
+package nodepool
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+const (
+	flagClusterName = "cluster-name"
+)
+
+type flag struct {
+	ClusterName string
+
+	config genericclioptions.RESTClientGetter
+}
+
+func (f *flag) Init(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&f.ClusterName, flagClusterName, "c", "", "Name of the cluster the node pool belongs to, if the node pool name is not unique.")
+
+	f.config = genericclioptions.NewConfigFlags(true)
+
+	// Merging current command flags and config flags,
+	// to be able to override kubectl-specific ones.
+	f.config.(*genericclioptions.ConfigFlags).AddFlags(cmd.Flags())
+}
+
+func (f *flag) Validate() error {
+	return nil
+}
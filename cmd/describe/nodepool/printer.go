@@ -0,0 +1,191 @@
+package nodepool
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/giantswarm/microerror"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/kubectl-gs/cmd/get/nodepools/provider"
+	"github.com/giantswarm/kubectl-gs/internal/feature"
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	nodepooldata "github.com/giantswarm/kubectl-gs/pkg/data/domain/nodepool"
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+)
+
+const (
+	naValue = "n/a"
+)
+
+// field is a single line of the description,
+// e.g. "Instance type: m5.xlarge".
+type field struct {
+	name  string
+	value string
+}
+
+// section groups the fields of the description under a title.
+// The fields of a section without title are printed unindented.
+type section struct {
+	title  string
+	fields []field
+}
+
+func (r *runner) printOutput(np nodepooldata.Nodepool) error {
+	var sections []section
+	switch r.provider {
+	case key.ProviderAWS:
+		sections = describeAWS(np, feature.New(feature.ProviderAWS))
+	case key.ProviderAzure:
+		sections = describeAzure(np, feature.New(feature.ProviderAzure))
+	default:
+		return microerror.Maskf(unsupportedProviderError, "Node pools cannot be described on the '%s' provider.", r.provider)
+	}
+
+	w := tabwriter.NewWriter(r.stdout, 0, 8, 2, ' ', 0)
+	for i, s := range sections {
+		indent := ""
+		if len(s.title) > 0 {
+			fmt.Fprintf(w, "%s:\n", s.title)
+			indent = "  "
+		}
+		for _, f := range s.fields {
+			fmt.Fprintf(w, "%s%s:\t%s\n", indent, f.name, f.value)
+		}
+		if i < len(sections)-1 {
+			fmt.Fprintln(w)
+		}
+	}
+
+	err := w.Flush()
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func describeAWS(np nodepooldata.Nodepool, capabilities *feature.Service) []section {
+	md := np.MachineDeployment
+	awsMD := np.AWSMachineDeployment
+	releaseVersion := key.ReleaseVersion(md)
+
+	return []section{
+		{
+			fields: []field{
+				{"Name", md.GetName()},
+				{"Namespace", md.GetNamespace()},
+				{"Cluster", formatOptional(key.ClusterID(md))},
+				{"Description", provider.AWSDescription(np)},
+				{"Release", formatOptional(releaseVersion)},
+				{"Organization", formatOptional(key.Organization(md))},
+				{"Created", output.NewTimestamp(md.CreationTimestamp, output.TimestampsAbsolute).String()},
+				{"Created by", formatOptional(key.CreatedBy(md))},
+				{"Condition", formatSupported(releaseVersion, func() string { return provider.AWSLatestCondition(np, capabilities) })},
+			},
+		},
+		{
+			title: "Instances",
+			fields: []field{
+				{"Instance type", formatOptional(awsMD.Spec.Provider.Worker.InstanceType)},
+				{"Use alike instance types", strconv.FormatBool(awsMD.Spec.Provider.Worker.UseAlikeInstanceTypes)},
+				{"Running instance types", formatOptional(strings.Join(awsMD.Status.Provider.Worker.InstanceTypes, ", "))},
+				{"Availability zones", formatOptional(strings.Join(awsMD.Spec.Provider.AvailabilityZones, ", "))},
+				{"Subnet", provider.AWSSubnet(np)},
+				{"On-demand base capacity", strconv.Itoa(awsMD.Spec.Provider.InstanceDistribution.OnDemandBaseCapacity)},
+				{"On-demand above base capacity", provider.AWSOnDemandAboveBase(np)},
+				{"Spot instances", strconv.Itoa(awsMD.Status.Provider.Worker.SpotInstances)},
+			},
+		},
+		{
+			title: "Scaling",
+			fields: []field{
+				{"Autoscaler min/max", formatSupported(releaseVersion, func() string { return provider.AWSAutoscaling(np, capabilities) })},
+				{"Nodes desired", strconv.Itoa(int(md.Status.Replicas))},
+				{"Nodes ready", strconv.Itoa(int(md.Status.ReadyReplicas))},
+			},
+		},
+		describeCapabilities(capabilities, releaseVersion),
+	}
+}
+
+func describeAzure(np nodepooldata.Nodepool, capabilities *feature.Service) []section {
+	mp := np.MachinePool
+	azureMP := np.AzureMachinePool
+	releaseVersion := key.ReleaseVersion(mp)
+
+	return []section{
+		{
+			fields: []field{
+				{"Name", mp.GetName()},
+				{"Namespace", mp.GetNamespace()},
+				{"Cluster", formatOptional(mp.Labels[capiv1alpha3.ClusterLabelName])},
+				{"Description", provider.AzureDescription(np)},
+				{"Release", formatOptional(releaseVersion)},
+				{"Organization", formatOptional(key.Organization(mp))},
+				{"Created", output.NewTimestamp(mp.CreationTimestamp, output.TimestampsAbsolute).String()},
+				{"Created by", formatOptional(key.CreatedBy(mp))},
+				{"Condition", formatSupported(releaseVersion, func() string { return provider.AzureLatestCondition(np, capabilities) })},
+			},
+		},
+		{
+			title: "Instances",
+			fields: []field{
+				{"VM size", formatOptional(azureMP.Spec.Template.VMSize)},
+				{"Availability zones", formatOptional(strings.Join(mp.Spec.FailureDomains, ", "))},
+				{"Spot VMs", provider.AzureSpotVMs(np)},
+				{"Spot max price", provider.AzureSpotMaxPrice(np)},
+			},
+		},
+		{
+			title: "Scaling",
+			fields: []field{
+				{"Autoscaler min/max", formatSupported(releaseVersion, func() string { return provider.AzureAutoscaling(np, capabilities) })},
+				{"Nodes desired", strconv.Itoa(int(mp.Status.Replicas))},
+				{"Nodes ready", strconv.Itoa(int(mp.Status.ReadyReplicas))},
+			},
+		},
+		describeCapabilities(capabilities, releaseVersion),
+	}
+}
+
+// describeCapabilities lists whether the release of the
+// node pool supports each of the provider's features.
+func describeCapabilities(capabilities *feature.Service, releaseVersion string) section {
+	s := section{
+		title: "Capabilities",
+	}
+	for _, name := range capabilities.Features() {
+		value := naValue
+		if len(releaseVersion) > 0 {
+			value = "not supported"
+			if capabilities.Supports(name, releaseVersion) {
+				value = "supported"
+			}
+		}
+		s.fields = append(s.fields, field{name, value})
+	}
+
+	return s
+}
+
+// formatSupported only computes values depending on release capabilities
+// if the node pool has a release version, which they require.
+func formatSupported(releaseVersion string, value func() string) string {
+	if len(releaseVersion) < 1 {
+		return naValue
+	}
+
+	return value()
+}
+
+func formatOptional(value string) string {
+	if len(value) < 1 {
+		return naValue
+	}
+
+	return value
+}
@@ -0,0 +1,119 @@
+package nodepool
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/giantswarm/kubectl-gs/pkg/commonconfig"
+	nodepooldata "github.com/giantswarm/kubectl-gs/pkg/data/domain/nodepool"
+)
+
+type runner struct {
+	flag   *flag
+	logger micrologger.Logger
+	fs     afero.Fs
+
+	provider string
+	service  nodepooldata.Interface
+
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func (r *runner) Run(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	err := r.flag.Validate()
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = r.run(ctx, cmd, args)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) error {
+	var err error
+
+	config := commonconfig.New(r.flag.config)
+	{
+		if r.provider == "" {
+			r.provider, err = config.GetProvider()
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+
+		err = r.getService(config)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	var options nodepooldata.GetOptions
+	{
+		options = nodepooldata.GetOptions{
+			Name:        strings.ToLower(args[0]),
+			ClusterName: r.flag.ClusterName,
+			Provider:    r.provider,
+		}
+
+		options.Namespace, _, err = r.flag.config.ToRawKubeConfigLoader().Namespace()
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	resource, err := r.service.Get(ctx, options)
+	if nodepooldata.IsNotFound(err) {
+		return microerror.Maskf(notFoundError, fmt.Sprintf("A node pool with name '%s' cannot be found.\n", options.Name))
+	} else if nodepooldata.IsInvalidProvider(err) {
+		return microerror.Maskf(unsupportedProviderError, "Node pools cannot be described on the '%s' provider.", r.provider)
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	np, ok := resource.(*nodepooldata.Nodepool)
+	if !ok {
+		return microerror.Maskf(notFoundError, fmt.Sprintf("A node pool with name '%s' cannot be found.\n", options.Name))
+	}
+
+	err = r.printOutput(*np)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *runner) getService(config *commonconfig.CommonConfig) error {
+	if r.service != nil {
+		return nil
+	}
+
+	client, err := config.GetClient(r.logger)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	serviceConfig := nodepooldata.Config{
+		Client: client,
+	}
+	r.service, err = nodepooldata.New(serviceConfig)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,308 @@
+package nodepool
+
+import (
+	"bytes"
+	"context"
+	goflag "flag"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/giantswarm/apiextensions/v3/pkg/annotation"
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	"github.com/giantswarm/apiextensions/v3/pkg/label"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capiexpv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	nodepooldata "github.com/giantswarm/kubectl-gs/pkg/data/domain/nodepool"
+	"github.com/giantswarm/kubectl-gs/test/goldenfile"
+	"github.com/giantswarm/kubectl-gs/test/kubeconfig"
+)
+
+var update = goflag.Bool("update", false, "update .golden reference test files")
+
+// Test_run uses golden files.
+//
+//  go test ./cmd/describe/nodepool -run Test_run -update
+//
+func Test_run(t *testing.T) {
+	testCases := []struct {
+		name               string
+		storage            []runtime.Object
+		provider           string
+		args               []string
+		clusterName        string
+		expectedGoldenFile string
+		errorMatcher       func(error) bool
+	}{
+		{
+			name: "case 0: describe AWS node pool",
+			storage: []runtime.Object{
+				newCAPIv1alpha3MachineDeployment("1sad2", "s921a", "2021-01-02T15:04:32Z", "10.5.0", 2, 1),
+				newAWSMachineDeployment("1sad2", "s921a", "2021-01-01T15:04:32Z", "10.5.0", "test nodepool 3", 1, 3),
+				newCAPIv1alpha3MachineDeployment("f930q", "s921a", "2021-01-02T15:04:32Z", "14.1.0", 5, 4),
+				newAWSMachineDeployment("f930q", "s921a", "2021-01-02T15:04:32Z", "14.1.0", "test nodepool 4", 3, 10),
+			},
+			provider:           key.ProviderAWS,
+			args:               []string{"f930q"},
+			expectedGoldenFile: "run_describe_aws_nodepool.golden",
+		},
+		{
+			name: "case 1: describe AWS node pool of a release without autoscaling",
+			storage: []runtime.Object{
+				newCAPIv1alpha3MachineDeployment("1sad2", "s921a", "2021-01-02T15:04:32Z", "9.0.0", 2, 1),
+				newAWSMachineDeployment("1sad2", "s921a", "2021-01-01T15:04:32Z", "9.0.0", "", 1, 3),
+			},
+			provider:           key.ProviderAWS,
+			args:               []string{"1sad2"},
+			clusterName:        "s921a",
+			expectedGoldenFile: "run_describe_aws_nodepool_without_autoscaling.golden",
+		},
+		{
+			name: "case 2: describe Azure node pool",
+			storage: []runtime.Object{
+				newCAPIexpv1alpha3MachinePool("f930q", "s921a", "2021-01-02T15:04:32Z", "14.1.0", "test nodepool 4", 3, 10, 5, 4),
+				newAzureMachinePool("f930q", "s921a", "2021-01-02T15:04:32Z", "14.1.0"),
+			},
+			provider:           key.ProviderAzure,
+			args:               []string{"f930q"},
+			expectedGoldenFile: "run_describe_azure_nodepool.golden",
+		},
+		{
+			name:         "case 3: describe node pool, with empty storage",
+			storage:      nil,
+			provider:     key.ProviderAWS,
+			args:         []string{"f930q"},
+			errorMatcher: IsNotFound,
+		},
+		{
+			name: "case 4: describe node pool of another cluster",
+			storage: []runtime.Object{
+				newCAPIv1alpha3MachineDeployment("f930q", "s921a", "2021-01-02T15:04:32Z", "14.1.0", 5, 4),
+				newAWSMachineDeployment("f930q", "s921a", "2021-01-02T15:04:32Z", "14.1.0", "test nodepool 4", 3, 10),
+			},
+			provider:     key.ProviderAWS,
+			args:         []string{"f930q"},
+			clusterName:  "29sa0",
+			errorMatcher: IsNotFound,
+		},
+		{
+			name:         "case 5: describe node pool on an unsupported provider",
+			storage:      nil,
+			provider:     key.ProviderKVM,
+			args:         []string{"f930q"},
+			errorMatcher: IsUnsupportedProvider,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			fakeKubeConfig := kubeconfig.CreateFakeKubeConfig()
+			flag := &flag{
+				config:      genericclioptions.NewTestConfigFlags().WithClientConfig(fakeKubeConfig),
+				ClusterName: tc.clusterName,
+			}
+			out := new(bytes.Buffer)
+			runner := &runner{
+				service:  nodepooldata.NewFakeService(tc.storage),
+				flag:     flag,
+				stdout:   out,
+				provider: tc.provider,
+			}
+
+			err := runner.run(ctx, nil, tc.args)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			var expectedResult []byte
+			{
+				gf := goldenfile.New("testdata", tc.expectedGoldenFile)
+				if *update {
+					err = gf.Update(out.Bytes())
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err.Error())
+					}
+					expectedResult = out.Bytes()
+				} else {
+					expectedResult, err = gf.Read()
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err.Error())
+					}
+				}
+			}
+
+			diff := cmp.Diff(string(expectedResult), out.String())
+			if diff != "" {
+				t.Fatalf("value not expected, got:\n %s", diff)
+			}
+		})
+	}
+}
+
+func newAWSMachineDeployment(name, clusterName, created, release, description string, nodesMin, nodesMax int) *infrastructurev1alpha3.AWSMachineDeployment {
+	location, _ := time.LoadLocation("UTC")
+	parsedCreationDate, _ := time.ParseInLocation(time.RFC3339, created, location)
+	onDemandAboveBase := 50
+	n := &infrastructurev1alpha3.AWSMachineDeployment{
+		TypeMeta: infrastructurev1alpha3.NewAWSMachineDeploymentTypeMeta(),
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(parsedCreationDate),
+			Labels: map[string]string{
+				label.MachineDeployment: name,
+				label.ReleaseVersion:    release,
+				label.Organization:      "giantswarm",
+				label.Cluster:           clusterName,
+			},
+			Annotations: map[string]string{
+				annotation.AWSSubnetSize: "25",
+			},
+		},
+		Spec: infrastructurev1alpha3.AWSMachineDeploymentSpec{
+			NodePool: infrastructurev1alpha3.AWSMachineDeploymentSpecNodePool{
+				Description: description,
+				Scaling: infrastructurev1alpha3.AWSMachineDeploymentSpecNodePoolScaling{
+					Min: nodesMin,
+					Max: nodesMax,
+				},
+			},
+			Provider: infrastructurev1alpha3.AWSMachineDeploymentSpecProvider{
+				AvailabilityZones: []string{"eu-west-1a", "eu-west-1b"},
+				InstanceDistribution: infrastructurev1alpha3.AWSMachineDeploymentSpecInstanceDistribution{
+					OnDemandBaseCapacity:                1,
+					OnDemandPercentageAboveBaseCapacity: &onDemandAboveBase,
+				},
+				Worker: infrastructurev1alpha3.AWSMachineDeploymentSpecProviderWorker{
+					InstanceType:          "m5.xlarge",
+					UseAlikeInstanceTypes: true,
+				},
+			},
+		},
+		Status: infrastructurev1alpha3.AWSMachineDeploymentStatus{
+			Provider: infrastructurev1alpha3.AWSMachineDeploymentStatusProvider{
+				Worker: infrastructurev1alpha3.AWSMachineDeploymentStatusProviderWorker{
+					InstanceTypes: []string{"m5.xlarge", "m5a.xlarge"},
+					SpotInstances: 2,
+				},
+			},
+		},
+	}
+
+	return n
+}
+
+func newCAPIv1alpha3MachineDeployment(name, clusterName, created, release string, nodesDesired, nodesReady int) *capiv1alpha3.MachineDeployment {
+	location, _ := time.LoadLocation("UTC")
+	parsedCreationDate, _ := time.ParseInLocation(time.RFC3339, created, location)
+	n := &capiv1alpha3.MachineDeployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "cluster.x-k8s.io/v1alpha3",
+			Kind:       "MachineDeployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(parsedCreationDate),
+			Labels: map[string]string{
+				label.MachineDeployment: name,
+				label.ReleaseVersion:    release,
+				label.Organization:      "giantswarm",
+				label.Cluster:           clusterName,
+			},
+		},
+		Status: capiv1alpha3.MachineDeploymentStatus{
+			Replicas:      int32(nodesDesired),
+			ReadyReplicas: int32(nodesReady),
+		},
+	}
+
+	return n
+}
+
+func newAzureMachinePool(name, clusterName, created, release string) *capzexpv1alpha3.AzureMachinePool {
+	location, _ := time.LoadLocation("UTC")
+	parsedCreationDate, _ := time.ParseInLocation(time.RFC3339, created, location)
+	maxPrice := resource.MustParse("-1")
+	n := &capzexpv1alpha3.AzureMachinePool{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "exp.infrastructure.cluster.x-k8s.io/v1alpha3",
+			Kind:       "AzureMachinePool",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(parsedCreationDate),
+			Labels: map[string]string{
+				label.MachinePool:             name,
+				label.ReleaseVersion:          release,
+				label.Organization:            "giantswarm",
+				capiv1alpha3.ClusterLabelName: clusterName,
+			},
+		},
+		Spec: capzexpv1alpha3.AzureMachinePoolSpec{
+			Template: capzexpv1alpha3.AzureMachineTemplate{
+				VMSize: "Standard_D4s_v3",
+				SpotVMOptions: &capzv1alpha3.SpotVMOptions{
+					MaxPrice: &maxPrice,
+				},
+			},
+		},
+	}
+
+	return n
+}
+
+func newCAPIexpv1alpha3MachinePool(name, clusterName, created, release, description string, nodesMin, nodesMax, nodesDesired, nodesReady int) *capiexpv1alpha3.MachinePool {
+	location, _ := time.LoadLocation("UTC")
+	parsedCreationDate, _ := time.ParseInLocation(time.RFC3339, created, location)
+	n := &capiexpv1alpha3.MachinePool{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "exp.cluster.x-k8s.io/v1alpha3",
+			Kind:       "MachinePool",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(parsedCreationDate),
+			Labels: map[string]string{
+				label.MachinePool:             name,
+				label.ReleaseVersion:          release,
+				label.Organization:            "giantswarm",
+				capiv1alpha3.ClusterLabelName: clusterName,
+			},
+			Annotations: map[string]string{
+				annotation.NodePoolMinSize: fmt.Sprintf("%d", nodesMin),
+				annotation.NodePoolMaxSize: fmt.Sprintf("%d", nodesMax),
+				annotation.MachinePoolName: description,
+			},
+		},
+		Spec: capiexpv1alpha3.MachinePoolSpec{
+			FailureDomains: []string{"1", "2"},
+		},
+		Status: capiexpv1alpha3.MachinePoolStatus{
+			Replicas:      int32(nodesDesired),
+			ReadyReplicas: int32(nodesReady),
+		},
+	}
+
+	return n
+}
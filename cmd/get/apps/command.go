@@ -24,15 +24,35 @@ const (
 Output columns:
 
 - NAME: Name of the app.
-- VERSION: Version of the app.
+- SPEC VERSION: Version of the app that should be deployed.
+- DEPLOYED VERSION: Version of the app that is deployed.
 - LAST DEPLOYED: When the app was last deployed.
-- STATUS: Status of the app.`
+- STATUS: Status of the app release.
+- DRIFT: Why the deployed app differs from its spec, if it does. Can be "version", "status" or both.
+- CATALOG: Catalog the app is installed from.
+- TARGET NAMESPACE: Namespace the app is deployed to.
+- AGE: How long ago the app was created.
+
+With -o wide, the ORGANIZATION owning the app and who it was CREATED BY
+are shown as well.
+
+When listing apps across all namespaces, a summary of the number of apps,
+and how many of them have drifted or are failing, is printed per namespace and cluster.`
 
 	examples = `  # List all apps for the current namespace
   kubectl gs get apps
   
   # Get one app by its name
-  kubectl gs get app coredns`
+  kubectl gs get app coredns
+
+  # Watch one app for changes, without listing it first
+  kubectl gs get app coredns --watch-only
+
+  # List all apps, sorted by status
+  kubectl gs get apps --sort-by status
+
+  # List the failing apps of all workload clusters
+  kubectl gs get apps -A --failing`
 )
 
 type Config struct {
@@ -2,16 +2,26 @@ package apps
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/giantswarm/microerror"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/printers"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/internal/label"
 	"github.com/giantswarm/kubectl-gs/pkg/data/domain/app"
 	"github.com/giantswarm/kubectl-gs/pkg/output"
 )
 
+const (
+	naValue      = "n/a"
+	noDriftValue = "none"
+)
+
 func (r *runner) printOutput(appResource app.Resource) error {
 	var (
 		err      error
@@ -20,12 +30,42 @@ func (r *runner) printOutput(appResource app.Resource) error {
 	)
 
 	switch {
-	case output.IsOutputDefault(r.flag.print.OutputFormat):
-		resource = getTable(appResource)
-		printOptions := printers.PrintOptions{
+	case output.IsOutputTable(r.flag.print.OutputFormat):
+		tableOptions := output.TableOptions{
+			OutputFormat:  r.flag.print.OutputFormat,
+			SortBy:        r.flag.SortBy,
 			WithNamespace: r.flag.AllNamespaces,
 		}
-		printer = printers.NewTablePrinter(printOptions)
+		if r.tablePrinter == nil {
+			r.tablePrinter = output.NewTablePrinter(r.stdout)
+		}
+		err = r.tablePrinter.PrintTable(getTable(appResource, r.flag.Timestamps), tableOptions)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		// The summary is only printed once below the list of all apps,
+		// since it would get in the way of the rows added when watching.
+		// When the apps are printed chunk by chunk, it is printed after
+		// the last chunk.
+		c, isCollection := appResource.(*app.Collection)
+		if isCollection && r.flag.AllNamespaces && !r.flag.Watch && !output.IsOutputCustomColumns(r.flag.print.OutputFormat) {
+			if r.chunkSummary != nil {
+				r.chunkSummary.add(c)
+				return nil
+			}
+
+			s := newSummary()
+			s.add(c)
+
+			err = r.printSummary(s)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+
+		return nil
+
 	case output.IsOutputName(r.flag.print.OutputFormat):
 		resource = appResource.Object()
 		err = output.PrintResourceNames(r.stdout, resource)
@@ -51,41 +91,164 @@ func (r *runner) printOutput(appResource app.Resource) error {
 	return nil
 }
 
-func (r *runner) printNoMatchOutput() {
-	fmt.Fprintf(r.stdout, "No App CRD found.\n")
-	fmt.Fprintf(r.stdout, "Please check you are accessing a management cluster\n\n")
+func (r *runner) printNoMatchOutput() error {
+	fmt.Fprintf(r.stderr, "No App CRD found.\n")
+	fmt.Fprintf(r.stderr, "Please check you are accessing a management cluster\n\n")
+
+	err := output.PrintEmptyList(r.stdout, r.flag.print)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *runner) printNoResourcesOutput() error {
+	fmt.Fprintf(r.stderr, "No apps found.\n")
+	fmt.Fprintf(r.stderr, "To create an app, please check\n\n")
+	fmt.Fprintf(r.stderr, "  kubectl gs template app --help\n")
+
+	err := output.PrintEmptyList(r.stdout, r.flag.print)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// summary counts the apps per namespace and cluster, and how
+// many of them have drifted from their spec or are failing.
+type summary struct {
+	groups []summaryGroup
+	counts map[summaryGroup]*groupSummary
+}
+
+type summaryGroup struct {
+	namespace string
+	cluster   string
+}
+
+type groupSummary struct {
+	apps    int
+	drifted int
+	failing int
+}
+
+func newSummary() *summary {
+	return &summary{
+		counts: map[summaryGroup]*groupSummary{},
+	}
+}
+
+func (s *summary) add(c *app.Collection) {
+	for _, a := range c.Items {
+		if a.CR == nil {
+			continue
+		}
+
+		group := summaryGroup{
+			namespace: a.CR.Namespace,
+			cluster:   appCluster(a),
+		}
+
+		g, exists := s.counts[group]
+		if !exists {
+			g = &groupSummary{}
+			s.counts[group] = g
+			s.groups = append(s.groups, group)
+		}
+
+		g.apps++
+		if len(app.Drift(a)) > 0 {
+			g.drifted++
+		}
+		if app.IsFailing(a) {
+			g.failing++
+		}
+	}
+}
+
+// appCluster returns the name of the cluster the app belongs to, as set in
+// its Giant Swarm or Cluster API cluster label.
+func appCluster(a app.App) string {
+	if cluster := a.CR.Labels[label.Cluster]; len(cluster) > 0 {
+		return cluster
+	}
+	if cluster := a.CR.Labels[capiv1alpha3.ClusterLabelName]; len(cluster) > 0 {
+		return cluster
+	}
+
+	return naValue
 }
 
-func (r *runner) printNoResourcesOutput() {
-	fmt.Fprintf(r.stdout, "No apps found.\n")
-	fmt.Fprintf(r.stdout, "To create an app, please check\n\n")
-	fmt.Fprintf(r.stdout, "  kubectl gs template app --help\n")
+// printSummary prints the number of apps per namespace and cluster, and
+// how many of them have drifted from their spec or are failing.
+func (r *runner) printSummary(s *summary) error {
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Namespace", Type: "string"},
+			{Name: "Cluster", Type: "string"},
+			{Name: "Apps", Type: "integer"},
+			{Name: "Drifted", Type: "integer"},
+			{Name: "Failing", Type: "integer"},
+		},
+	}
+
+	sort.Slice(s.groups, func(i, j int) bool {
+		if s.groups[i].namespace != s.groups[j].namespace {
+			return s.groups[i].namespace < s.groups[j].namespace
+		}
+		return s.groups[i].cluster < s.groups[j].cluster
+	})
+	for _, group := range s.groups {
+		g := s.counts[group]
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells: []interface{}{group.namespace, group.cluster, g.apps, g.drifted, g.failing},
+		})
+	}
+
+	fmt.Fprintf(r.stdout, "\n")
+
+	printer := printers.NewTablePrinter(printers.PrintOptions{})
+	err := printer.PrintObj(table, r.stdout)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
 }
 
-func getTable(appResource app.Resource) *metav1.Table {
+func getTable(appResource app.Resource, timestamps string) *metav1.Table {
 	// Creating a custom table resource.
 	table := &metav1.Table{}
 
 	table.ColumnDefinitions = []metav1.TableColumnDefinition{
 		{Name: "Name", Type: "string"},
-		{Name: "Version", Type: "string"},
-		{Name: "Last Deployed", Type: "string", Format: "date-time"},
+		{Name: "Spec Version", Type: "string"},
+		{Name: "Deployed Version", Type: "string"},
+		{Name: "Last Deployed", Type: "string"},
 		{Name: "Status", Type: "string"},
+		{Name: "Drift", Type: "string"},
+		{Name: "Catalog", Type: "string"},
+		{Name: "Target Namespace", Type: "string"},
+		{Name: "Age", Type: "string"},
+		{Name: "Organization", Type: "string", Priority: 1},
+		{Name: "Created By", Type: "string", Priority: 1},
 	}
 
 	switch c := appResource.(type) {
 	case *app.App:
-		table.Rows = append(table.Rows, getAppRow(*c))
+		table.Rows = append(table.Rows, getAppRow(*c, timestamps))
 	case *app.Collection:
 		for _, appItem := range c.Items {
-			table.Rows = append(table.Rows, getAppRow(appItem))
+			table.Rows = append(table.Rows, getAppRow(appItem, timestamps))
 		}
 	}
 
 	return table
 }
 
-func getAppRow(a app.App) metav1.TableRow {
+func getAppRow(a app.App, timestamps string) metav1.TableRow {
 	if a.CR == nil {
 		return metav1.TableRow{}
 	}
@@ -93,12 +256,36 @@ func getAppRow(a app.App) metav1.TableRow {
 	return metav1.TableRow{
 		Cells: []interface{}{
 			a.CR.Name,
-			a.CR.Status.Version,
-			output.TranslateTimestampSince(a.CR.Status.Release.LastDeployed),
-			a.CR.Status.Release.Status,
+			formatOptional(a.CR.Spec.Version),
+			formatOptional(a.CR.Status.Version),
+			output.NewTimestamp(a.CR.Status.Release.LastDeployed, timestamps),
+			formatOptional(a.CR.Status.Release.Status),
+			getDrift(a),
+			formatOptional(a.CR.Spec.Catalog),
+			formatOptional(a.CR.Spec.Namespace),
+			output.NewTimestamp(a.CR.CreationTimestamp, timestamps),
+			formatOptional(key.Organization(a.CR)),
+			formatOptional(a.CreatedBy),
 		},
 		Object: runtime.RawExtension{
 			Object: a.CR,
 		},
 	}
 }
+
+func getDrift(a app.App) string {
+	reasons := app.Drift(a)
+	if len(reasons) < 1 {
+		return noDriftValue
+	}
+
+	return strings.Join(reasons, ",")
+}
+
+func formatOptional(value string) string {
+	if len(value) < 1 {
+		return naValue
+	}
+
+	return value
+}
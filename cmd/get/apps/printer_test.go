@@ -0,0 +1,178 @@
+package apps
+
+import (
+	"bytes"
+	goflag "flag"
+	"testing"
+
+	applicationv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/application/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/giantswarm/kubectl-gs/internal/label"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/app"
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+	"github.com/giantswarm/kubectl-gs/test/goldenfile"
+)
+
+var update = goflag.Bool("update", false, "update .golden reference test files")
+
+// Test_printOutput uses golden files.
+//
+//  go test ./cmd/get/apps -run Test_printOutput -update
+//
+func Test_printOutput(t *testing.T) {
+	testCases := []struct {
+		name               string
+		appRes             app.Resource
+		outputType         string
+		allNamespaces      bool
+		watch              bool
+		expectedGoldenFile string
+	}{
+		{
+			name: "case 0: print list of apps, with table output",
+			appRes: newAppCollection(
+				*newApp("coredns", "abc12", "1.2.0", "1.2.0", "deployed"),
+				*newApp("cert-manager", "abc12", "2.4.0", "2.3.1", "deployed"),
+				*newApp("kiam", "abc12", "1.7.0", "1.6.0", "failed"),
+				*newApp("nginx-ingress-controller", "abc12", "1.9.0", "", ""),
+			),
+			outputType:         output.TypeDefault,
+			expectedGoldenFile: "print_list_of_apps_table_output.golden",
+		},
+		{
+			name: "case 1: print list of apps across all namespaces, with table output",
+			appRes: newAppCollection(
+				*newApp("coredns", "abc12", "1.2.0", "1.2.0", "deployed"),
+				*newApp("kiam", "abc12", "1.7.0", "1.6.0", "failed"),
+				*newApp("coredns", "f930q", "1.2.0", "1.2.0", "deployed"),
+				*newApp("cert-manager", "f930q", "2.4.0", "2.3.1", "deployed"),
+				*newApp("efk-stack-app", "default", "0.5.0", "0.5.0", "deployed"),
+			),
+			outputType:         output.TypeDefault,
+			allNamespaces:      true,
+			expectedGoldenFile: "print_list_of_apps_all_namespaces_table_output.golden",
+		},
+		{
+			name: "case 2: print list of apps across all namespaces, with table output, watching",
+			appRes: newAppCollection(
+				*newApp("coredns", "abc12", "1.2.0", "1.2.0", "deployed"),
+				*newApp("kiam", "abc12", "1.7.0", "1.6.0", "failed"),
+			),
+			outputType:         output.TypeDefault,
+			allNamespaces:      true,
+			watch:              true,
+			expectedGoldenFile: "print_list_of_apps_all_namespaces_watch_table_output.golden",
+		},
+		{
+			name:               "case 3: print single app, with table output",
+			appRes:             newApp("kiam", "abc12", "1.7.0", "1.6.0", "failed"),
+			outputType:         output.TypeDefault,
+			allNamespaces:      true,
+			expectedGoldenFile: "print_single_app_table_output.golden",
+		},
+		{
+			name: "case 4: print list of apps of several clusters across all namespaces, with table output",
+			appRes: newAppCollection(
+				*newClusterApp("a1b2c-coredns", "org-acme", "a1b2c", "1.2.0", "1.2.0", "deployed"),
+				*newClusterApp("a1b2c-kiam", "org-acme", "a1b2c", "1.7.0", "1.6.0", "failed"),
+				*newClusterApp("x9y8z-coredns", "org-acme", "x9y8z", "1.2.0", "1.2.0", "deployed"),
+				*newClusterApp("x9y8z-cert-manager", "org-acme", "x9y8z", "2.4.0", "2.3.1", "deployed"),
+				*newApp("efk-stack-app", "org-acme", "0.5.0", "0.5.0", "deployed"),
+			),
+			outputType:         output.TypeDefault,
+			allNamespaces:      true,
+			expectedGoldenFile: "print_list_of_apps_of_clusters_all_namespaces_table_output.golden",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			flag := &flag{
+				print:         genericclioptions.NewPrintFlags("").WithDefaultOutput(tc.outputType),
+				AllNamespaces: tc.allNamespaces,
+				Watch:         tc.watch,
+			}
+			out := new(bytes.Buffer)
+			runner := &runner{
+				flag:   flag,
+				stdout: out,
+			}
+
+			err := runner.printOutput(tc.appRes)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			var expectedResult []byte
+			{
+				gf := goldenfile.New("testdata", tc.expectedGoldenFile)
+				if *update {
+					err = gf.Update(out.Bytes())
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err.Error())
+					}
+					expectedResult = out.Bytes()
+				} else {
+					expectedResult, err = gf.Read()
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err.Error())
+					}
+				}
+			}
+
+			diff := cmp.Diff(string(expectedResult), out.String())
+			if diff != "" {
+				t.Fatalf("value not expected, got:\n %s", diff)
+			}
+		})
+	}
+}
+
+func newApp(name, namespace, specVersion, deployedVersion, status string) *app.App {
+	a := &applicationv1alpha1.App{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "application.giantswarm.io/v1alpha1",
+			Kind:       "App",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: applicationv1alpha1.AppSpec{
+			Catalog:   "default",
+			Name:      name,
+			Namespace: "kube-system",
+			Version:   specVersion,
+		},
+		Status: applicationv1alpha1.AppStatus{
+			Release: applicationv1alpha1.AppStatusRelease{
+				Status: status,
+			},
+			Version: deployedVersion,
+		},
+	}
+
+	return &app.App{
+		CR: a,
+	}
+}
+
+func newClusterApp(name, namespace, cluster, specVersion, deployedVersion, status string) *app.App {
+	a := newApp(name, namespace, specVersion, deployedVersion, status)
+	a.CR.Labels = map[string]string{
+		label.Cluster: cluster,
+	}
+
+	return a
+}
+
+func newAppCollection(apps ...app.App) *app.Collection {
+	collection := &app.Collection{
+		Items: apps,
+	}
+
+	return collection
+}
@@ -24,6 +24,13 @@ type runner struct {
 
 	service app.Interface
 
+	// tablePrinter prints all the tables of the command, so that further chunks and watch events
+	// only add new rows, aligned with the headers.
+	tablePrinter *output.TablePrinter
+	// chunkSummary collects the summary of all the apps
+	// while they are printed chunk by chunk.
+	chunkSummary *summary
+
 	stdout io.Writer
 	stderr io.Writer
 }
@@ -74,26 +81,77 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 		}
 	}
 
-	var appResource app.Resource
-	{
-		options := app.GetOptions{
-			Namespace: namespace,
-			Name:      name,
-		}
-		appResource, err = r.service.Get(ctx, options)
+	if len(name) > 0 && r.flag.Failing {
+		return microerror.Maskf(invalidFlagError, "--%s cannot be used when getting an app by name", flagFailing)
+	}
+
+	options := app.GetOptions{
+		ChunkSize: r.flag.ChunkSize,
+		Failing:   r.flag.Failing,
+		Namespace: namespace,
+		Name:      name,
+	}
+
+	if !r.flag.WatchOnly {
+		err = r.printApps(ctx, options)
 		if app.IsNotFound(err) {
 			return microerror.Maskf(notFoundError, fmt.Sprintf("An app '%s/%s' cannot be found.\n", options.Namespace, options.Name))
 		} else if app.IsNoMatch(err) {
-			r.printNoMatchOutput()
-			return nil
-		} else if app.IsNoResources(err) && output.IsOutputDefault(r.flag.print.OutputFormat) {
-			r.printNoResourcesOutput()
+			err = r.printNoMatchOutput()
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
 			return nil
+		} else if app.IsNoResources(err) {
+			err = r.printNoResourcesOutput()
+			if err != nil {
+				return microerror.Mask(err)
+			}
 		} else if err != nil {
 			return microerror.Mask(err)
 		}
 	}
 
+	if r.flag.Watch || r.flag.WatchOnly {
+		err = r.service.Watch(ctx, options, func(e app.Event) error {
+			return r.printOutput(e.Resource)
+		})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	return nil
+}
+
+// printApps prints the apps matching the given options. Tables that
+// don't have to be sorted are printed chunk by chunk, as soon as the
+// apps are listed, like kubectl does.
+func (r *runner) printApps(ctx context.Context, options app.GetOptions) error {
+	if output.IsOutputTable(r.flag.print.OutputFormat) && len(r.flag.SortBy) < 1 {
+		r.chunkSummary = newSummary()
+		err := r.service.Stream(ctx, options, r.printOutput)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		if len(r.chunkSummary.groups) > 0 {
+			err = r.printSummary(r.chunkSummary)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+		r.chunkSummary = nil
+
+		return nil
+	}
+
+	appResource, err := r.service.Get(ctx, options)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	err = r.printOutput(appResource)
 	if err != nil {
 		return microerror.Mask(err)
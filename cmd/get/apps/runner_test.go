@@ -0,0 +1,200 @@
+package apps
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/app"
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+	"github.com/giantswarm/kubectl-gs/test/goldenfile"
+	"github.com/giantswarm/kubectl-gs/test/kubeconfig"
+)
+
+// Test_run uses golden files.
+//
+//  go test ./cmd/get/apps -run Test_run -update
+//
+func Test_run(t *testing.T) {
+	storage := []runtime.Object{
+		newApp("coredns", "default", "1.2.0", "1.2.0", "deployed").CR,
+		newApp("cert-manager", "default", "2.4.0", "2.4.0", "deployed").CR,
+	}
+
+	testCases := []struct {
+		name                  string
+		storage               []runtime.Object
+		args                  []string
+		allNamespaces         bool
+		failing               bool
+		sortBy                string
+		outputType            string
+		watch                 bool
+		expectedGoldenFile    string
+		expectedErrGoldenFile string
+		errorMatcher          func(error) bool
+	}{
+		{
+			name:               "case 0: get apps",
+			storage:            storage,
+			expectedGoldenFile: "run_get_apps.golden",
+		},
+		{
+			name:                  "case 1: get apps, with empty storage",
+			storage:               nil,
+			expectedErrGoldenFile: "run_get_apps_empty_storage.golden",
+		},
+		{
+			name:                  "case 2: get apps, with empty storage, and JSON output",
+			storage:               nil,
+			outputType:            output.TypeJSON,
+			expectedGoldenFile:    "run_get_apps_empty_storage_json_output.golden",
+			expectedErrGoldenFile: "run_get_apps_empty_storage.golden",
+		},
+		{
+			name:                  "case 3: get failing apps, with none failing, and YAML output",
+			storage:               storage,
+			failing:               true,
+			outputType:            output.TypeYAML,
+			expectedGoldenFile:    "run_get_apps_failing_yaml_output.golden",
+			expectedErrGoldenFile: "run_get_apps_empty_storage.golden",
+		},
+		{
+			name:         "case 4: get app by name, not found",
+			storage:      storage,
+			args:         []string{"unknown"},
+			outputType:   output.TypeJSON,
+			errorMatcher: IsNotFound,
+		},
+		{
+			name:               "case 5: get apps across all namespaces, printed in chunks",
+			storage:            storage,
+			allNamespaces:      true,
+			expectedGoldenFile: "run_get_apps_all_namespaces.golden",
+		},
+		{
+			name:               "case 6: get apps across all namespaces, sorted",
+			storage:            storage,
+			allNamespaces:      true,
+			sortBy:             "name",
+			expectedGoldenFile: "run_get_apps_all_namespaces_sorted.golden",
+		},
+		{
+			name:               "case 7: get apps, with wide output, created by the first field manager",
+			storage:            []runtime.Object{withManagedFields(newApp("coredns", "default", "1.2.0", "1.2.0", "deployed")).CR},
+			outputType:         output.TypeWide,
+			expectedGoldenFile: "run_get_apps_wide_output.golden",
+		},
+		{
+			name:               "case 8: get app by name, with wide output, created by the first field manager",
+			storage:            []runtime.Object{withManagedFields(newApp("coredns", "default", "1.2.0", "1.2.0", "deployed")).CR},
+			args:               []string{"coredns"},
+			outputType:         output.TypeWide,
+			expectedGoldenFile: "run_get_app_wide_output.golden",
+		},
+		{
+			name:               "case 9: get apps, and watch for changes",
+			storage:            storage,
+			watch:              true,
+			expectedGoldenFile: "run_get_apps_watch.golden",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			outputType := output.TypeDefault
+			if len(tc.outputType) > 0 {
+				outputType = tc.outputType
+			}
+
+			fakeKubeConfig := kubeconfig.CreateFakeKubeConfig()
+			flag := &flag{
+				print:  genericclioptions.NewPrintFlags("").WithDefaultOutput(outputType),
+				config: genericclioptions.NewTestConfigFlags().WithClientConfig(fakeKubeConfig),
+
+				AllNamespaces: tc.allNamespaces,
+				Failing:       tc.failing,
+				SortBy:        tc.sortBy,
+				Watch:         tc.watch,
+			}
+			out := new(bytes.Buffer)
+			errOut := new(bytes.Buffer)
+			runner := &runner{
+				service: app.NewFakeService(tc.storage),
+				flag:    flag,
+				stdout:  out,
+				stderr:  errOut,
+			}
+
+			err := runner.run(ctx, nil, tc.args)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			assertGoldenFile(t, tc.expectedGoldenFile, out.Bytes())
+			assertGoldenFile(t, tc.expectedErrGoldenFile, errOut.Bytes())
+		})
+	}
+}
+
+// withManagedFields sets the managed fields of an app, as written by a
+// client creating it and an operator updating its status later on.
+func withManagedFields(a *app.App) *app.App {
+	created := metav1.NewTime(time.Date(2021, 1, 2, 15, 4, 32, 0, time.UTC))
+	updated := metav1.NewTime(created.Add(time.Hour))
+	a.CR.ManagedFields = []metav1.ManagedFieldsEntry{
+		{Manager: "app-operator", Operation: metav1.ManagedFieldsOperationUpdate, Time: &updated},
+		{Manager: "kubectl-client-side-apply", Operation: metav1.ManagedFieldsOperationUpdate, Time: &created},
+	}
+
+	return a
+}
+
+func assertGoldenFile(t *testing.T, name string, actual []byte) {
+	var err error
+
+	if len(name) < 1 {
+		if len(actual) > 0 {
+			t.Fatalf("unexpected output, got:\n %s", actual)
+		}
+
+		return
+	}
+
+	var expectedResult []byte
+	{
+		gf := goldenfile.New("testdata", name)
+		if *update {
+			err = gf.Update(actual)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			expectedResult = actual
+		} else {
+			expectedResult, err = gf.Read()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+		}
+	}
+
+	diff := cmp.Diff(string(expectedResult), string(actual))
+	if diff != "" {
+		t.Fatalf("value not expected, got:\n %s", diff)
+	}
+}
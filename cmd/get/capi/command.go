@@ -11,8 +11,39 @@ import (
 )
 
 const (
-	name        = "cluster-api"
-	description = "Display information about Cluster API CRDs and controllers"
+	name  = "cluster-api"
+	alias = "capi"
+
+	shortDescription = "Display information about Cluster API CRDs and controllers"
+	longDescription  = `Display information about Cluster API CRDs and controllers
+
+Checks the Cluster API controllers running on the management cluster, and
+whether the CRDs they reconcile serve the API version that each controller
+version requires.
+
+Output columns:
+
+- TYPE: Either Controller, or CRD for the CRDs listed after their controller.
+- PROVIDER: Infrastructure provider of the component, or 'all' for the ones
+  shared by all providers.
+- NAME: Name of the component.
+- VERSION: For controllers, the versions run by their replicas. For CRDs,
+  the served API versions. NONE if the component is not installed.
+- STATUS: Result of the compatibility check. One of OK, Incompatible (a CRD
+  doesn't serve the required API version), Missing (CRD not installed),
+  NotInstalled (no controller pods), VersionMismatch (controller replicas
+  run different versions), UnknownVersion (controller version not covered
+  by the compatibility matrix) or Unknown.`
+
+	examples = `  # Check all Cluster API components
+  kubectl gs get capi
+
+  # Check the core components and the ones of the AWS provider, including
+  # the required API versions
+  kubectl gs get capi --provider aws -o wide
+
+  # Get the full report, including the image of each controller replica
+  kubectl gs get capi -o yaml`
 )
 
 type Config struct {
@@ -42,9 +73,10 @@ func New(config Config) (*cobra.Command, error) {
 
 	c := &cobra.Command{
 		Use:     name,
-		Aliases: []string{"capi"},
-		Short:   description,
-		Long:    description,
+		Aliases: []string{alias},
+		Short:   shortDescription,
+		Long:    longDescription,
+		Example: examples,
 		RunE:    r.Run,
 	}
 
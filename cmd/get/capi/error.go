@@ -21,3 +21,21 @@ var invalidFlagsError = &microerror.Error{
 func IsInvalidFlags(err error) bool {
 	return microerror.Cause(err) == invalidFlagsError
 }
+
+var invalidImageError = &microerror.Error{
+	Kind: "invalidImageError",
+}
+
+// IsInvalidImage asserts invalidImageError.
+func IsInvalidImage(err error) bool {
+	return microerror.Cause(err) == invalidImageError
+}
+
+var invalidMatrixError = &microerror.Error{
+	Kind: "invalidMatrixError",
+}
+
+// IsInvalidMatrix asserts invalidMatrixError.
+func IsInvalidMatrix(err error) bool {
+	return microerror.Cause(err) == invalidMatrixError
+}
@@ -1,16 +1,30 @@
 package capi
 
 import (
+	"github.com/giantswarm/microerror"
 	"github.com/spf13/cobra"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+)
+
+const (
+	flagProvider = "provider"
+	flagSortBy   = "sort-by"
 )
 
 type flag struct {
+	Provider string
+	SortBy   string
+
 	config genericclioptions.RESTClientGetter
 	print  *genericclioptions.PrintFlags
 }
 
 func (f *flag) Init(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.Provider, flagProvider, "", "Only show the core components and the ones of this provider, e.g. 'aws', 'azure' or 'vsphere'.")
+	cmd.Flags().StringVar(&f.SortBy, flagSortBy, "", "If non-empty, sort table output using this column name, e.g. 'status'.")
+
 	f.config = genericclioptions.NewConfigFlags(true)
 	f.print = genericclioptions.NewPrintFlags("")
 
@@ -18,8 +32,14 @@ func (f *flag) Init(cmd *cobra.Command) {
 	// to be able to override kubectl-specific ones.
 	f.config.(*genericclioptions.ConfigFlags).AddFlags(cmd.Flags())
 	f.print.AddFlags(cmd)
+	output.AddTableFormatsUsage(cmd)
 }
 
 func (f *flag) Validate() error {
+	outputFormat := f.print.OutputFormat
+	if !output.IsOutputTable(outputFormat) && *outputFormat != output.TypeJSON && *outputFormat != output.TypeYAML {
+		return microerror.Maskf(invalidFlagsError, "--output must be one of %s, %s or %s", output.TypeWide, output.TypeJSON, output.TypeYAML)
+	}
+
 	return nil
 }
@@ -0,0 +1,58 @@
+package capi
+
+import (
+	"strings"
+
+	"github.com/giantswarm/microerror"
+)
+
+// imageReference is a parsed container image reference of
+// the form [registry[:port]/]repository[:tag][@digest].
+type imageReference struct {
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+func parseImage(image string) (imageReference, error) {
+	var ref imageReference
+
+	name := strings.TrimSpace(image)
+	if i := strings.Index(name, "@"); i >= 0 {
+		ref.Digest = name[i+1:]
+		name = name[:i]
+
+		if len(ref.Digest) < 1 {
+			return imageReference{}, microerror.Maskf(invalidImageError, "image %#q has an empty digest", image)
+		}
+	}
+
+	// The tag can only follow the last path component, as the
+	// registry host in the first one may contain a port.
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		ref.Tag = name[i+1:]
+		name = name[:i]
+
+		if len(ref.Tag) < 1 {
+			return imageReference{}, microerror.Maskf(invalidImageError, "image %#q has an empty tag", image)
+		}
+	}
+
+	if len(name) < 1 {
+		return imageReference{}, microerror.Maskf(invalidImageError, "image %#q has no repository", image)
+	}
+	ref.Repository = name
+
+	return ref, nil
+}
+
+// Version returns the tag of the image or, for images that are
+// only referenced by digest, the digest. It is empty for images
+// without either.
+func (r imageReference) Version() string {
+	if len(r.Tag) > 0 {
+		return r.Tag
+	}
+
+	return r.Digest
+}
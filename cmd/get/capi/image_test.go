@@ -0,0 +1,93 @@
+package capi
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func Test_parseImage(t *testing.T) {
+	testCases := []struct {
+		name            string
+		image           string
+		expectedRef     imageReference
+		expectedVersion string
+		errorMatcher    func(error) bool
+	}{
+		{
+			name:            "case 0: repository and tag",
+			image:           "quay.io/giantswarm/cluster-api-controller:v0.3.13-gs",
+			expectedRef:     imageReference{Repository: "quay.io/giantswarm/cluster-api-controller", Tag: "v0.3.13-gs"},
+			expectedVersion: "v0.3.13-gs",
+		},
+		{
+			name:            "case 1: registry with port",
+			image:           "registry.local:5000/giantswarm/cluster-api-controller:v0.3.13",
+			expectedRef:     imageReference{Repository: "registry.local:5000/giantswarm/cluster-api-controller", Tag: "v0.3.13"},
+			expectedVersion: "v0.3.13",
+		},
+		{
+			name:            "case 2: registry with port, without tag",
+			image:           "registry.local:5000/giantswarm/cluster-api-controller",
+			expectedRef:     imageReference{Repository: "registry.local:5000/giantswarm/cluster-api-controller"},
+			expectedVersion: "",
+		},
+		{
+			name:            "case 3: without tag",
+			image:           "giantswarm/cluster-api-controller",
+			expectedRef:     imageReference{Repository: "giantswarm/cluster-api-controller"},
+			expectedVersion: "",
+		},
+		{
+			name:            "case 4: digest",
+			image:           "quay.io/giantswarm/cluster-api-controller@sha256:8d9ad4a3b2e4f3e8f1c2c8d2f0e0b9f1a6c3d1e2f3a4b5c6d7e8f9a0b1c2d3e4",
+			expectedRef:     imageReference{Repository: "quay.io/giantswarm/cluster-api-controller", Digest: "sha256:8d9ad4a3b2e4f3e8f1c2c8d2f0e0b9f1a6c3d1e2f3a4b5c6d7e8f9a0b1c2d3e4"},
+			expectedVersion: "sha256:8d9ad4a3b2e4f3e8f1c2c8d2f0e0b9f1a6c3d1e2f3a4b5c6d7e8f9a0b1c2d3e4",
+		},
+		{
+			name:            "case 5: tag and digest",
+			image:           "quay.io/giantswarm/cluster-api-controller:v0.3.13@sha256:8d9ad4a3",
+			expectedRef:     imageReference{Repository: "quay.io/giantswarm/cluster-api-controller", Tag: "v0.3.13", Digest: "sha256:8d9ad4a3"},
+			expectedVersion: "v0.3.13",
+		},
+		{
+			name:         "case 6: empty tag",
+			image:        "quay.io/giantswarm/cluster-api-controller:",
+			errorMatcher: IsInvalidImage,
+		},
+		{
+			name:         "case 7: empty digest",
+			image:        "quay.io/giantswarm/cluster-api-controller@",
+			errorMatcher: IsInvalidImage,
+		},
+		{
+			name:         "case 8: empty image",
+			image:        "",
+			errorMatcher: IsInvalidImage,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			ref, err := parseImage(tc.image)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if diff := cmp.Diff(tc.expectedRef, ref); diff != "" {
+				t.Fatalf("reference not expected, got:\n %s", diff)
+			}
+			if ref.Version() != tc.expectedVersion {
+				t.Fatalf("version not expected, expected %#q, got %#q", tc.expectedVersion, ref.Version())
+			}
+		})
+	}
+}
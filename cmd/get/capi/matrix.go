@@ -0,0 +1,104 @@
+package capi
+
+import (
+	_ "embed"
+
+	semver "github.com/blang/semver/v4"
+	"github.com/giantswarm/microerror"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	providerAll = "all"
+)
+
+//go:embed compatibility.yaml
+var compatibilityMatrix []byte
+
+// matrix describes the Cluster API controllers to look for, the CRDs they
+// reconcile, and which API version each controller version requires.
+type matrix struct {
+	Namespace   string             `json:"namespace"`
+	Controllers []matrixController `json:"controllers"`
+}
+
+type matrixController struct {
+	Name          string          `json:"name"`
+	Provider      string          `json:"provider"`
+	LabelSelector string          `json:"labelSelector"`
+	ContainerName string          `json:"containerName"`
+	CRDs          []matrixCRD     `json:"crds"`
+	Versions      []matrixVersion `json:"versions"`
+}
+
+type matrixCRD struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type matrixVersion struct {
+	Range      string `json:"range"`
+	APIVersion string `json:"apiVersion"`
+
+	versionRange semver.Range
+}
+
+func parseMatrix(data []byte) (*matrix, error) {
+	m := &matrix{}
+	err := yaml.UnmarshalStrict(data, m)
+	if err != nil {
+		return nil, microerror.Maskf(invalidMatrixError, "%s", err)
+	}
+
+	for i, c := range m.Controllers {
+		if len(c.Name) < 1 || len(c.Provider) < 1 || len(c.LabelSelector) < 1 || len(c.ContainerName) < 1 {
+			return nil, microerror.Maskf(invalidMatrixError, "controller %d must have a name, provider, label selector and container name", i)
+		}
+
+		for j, v := range c.Versions {
+			r, err := semver.ParseRange(v.Range)
+			if err != nil {
+				return nil, microerror.Maskf(invalidMatrixError, "controller %#q has an invalid version range %#q: %s", c.Name, v.Range, err)
+			}
+			m.Controllers[i].Versions[j].versionRange = r
+		}
+	}
+
+	return m, nil
+}
+
+// providers returns the providers that controllers are listed for,
+// other than the ones shared by all providers.
+func (m *matrix) providers() []string {
+	var providers []string
+	seen := map[string]bool{}
+	for _, c := range m.Controllers {
+		if c.Provider == providerAll || seen[c.Provider] {
+			continue
+		}
+		seen[c.Provider] = true
+		providers = append(providers, c.Provider)
+	}
+
+	return providers
+}
+
+// requiredAPIVersion returns the API version that the given
+// controller version requires, or false if it is not known.
+func (c matrixController) requiredAPIVersion(version string) (string, bool) {
+	v, err := semver.ParseTolerant(version)
+	if err != nil {
+		return "", false
+	}
+	// Pre-release suffixes such as "-gs1" are used for
+	// patched forks and must not affect the matching.
+	v.Pre = nil
+
+	for _, mv := range c.Versions {
+		if mv.versionRange(v) {
+			return mv.APIVersion, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,132 @@
+package capi
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func Test_parseMatrix(t *testing.T) {
+	testCases := []struct {
+		name         string
+		data         []byte
+		errorMatcher func(error) bool
+	}{
+		{
+			name: "case 0: embedded compatibility matrix",
+			data: compatibilityMatrix,
+		},
+		{
+			name:         "case 1: unknown field",
+			data:         []byte("namespace: giantswarm\ncontrolers: []\n"),
+			errorMatcher: IsInvalidMatrix,
+		},
+		{
+			name: "case 2: invalid version range",
+			data: []byte(`controllers:
+  - name: Core
+    provider: all
+    labelSelector: app.kubernetes.io/name=cluster-api-core
+    containerName: manager
+    versions:
+      - range: "0.3.x || latest"
+        apiVersion: v1alpha3
+`),
+			errorMatcher: IsInvalidMatrix,
+		},
+		{
+			name: "case 3: controller without label selector",
+			data: []byte(`controllers:
+  - name: Core
+    provider: all
+    containerName: manager
+`),
+			errorMatcher: IsInvalidMatrix,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			_, err := parseMatrix(tc.data)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+		})
+	}
+}
+
+func Test_requiredAPIVersion(t *testing.T) {
+	m, err := parseMatrix(compatibilityMatrix)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	testCases := []struct {
+		name               string
+		controller         string
+		version            string
+		expectedAPIVersion string
+		expectedOK         bool
+	}{
+		{
+			name:               "case 0: core release",
+			controller:         "Core",
+			version:            "v0.3.13",
+			expectedAPIVersion: "v1alpha3",
+			expectedOK:         true,
+		},
+		{
+			name:               "case 1: patched fork with pre-release suffix",
+			controller:         "AWS Provider",
+			version:            "v0.6.5-gs1",
+			expectedAPIVersion: "v1alpha3",
+			expectedOK:         true,
+		},
+		{
+			name:               "case 2: newer API version",
+			controller:         "Azure Provider",
+			version:            "0.5.2",
+			expectedAPIVersion: "v1alpha4",
+			expectedOK:         true,
+		},
+		{
+			name:       "case 3: version not covered",
+			controller: "Core",
+			version:    "v0.2.9",
+			expectedOK: false,
+		},
+		{
+			name:       "case 4: digest",
+			controller: "Core",
+			version:    "sha256:8d9ad4a3",
+			expectedOK: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			var controller *matrixController
+			for j := range m.Controllers {
+				if m.Controllers[j].Name == tc.controller {
+					controller = &m.Controllers[j]
+				}
+			}
+			if controller == nil {
+				t.Fatalf("controller %#q not found in matrix", tc.controller)
+			}
+
+			apiVersion, ok := controller.requiredAPIVersion(tc.version)
+			if ok != tc.expectedOK {
+				t.Fatalf("expected ok to be %t, got %t", tc.expectedOK, ok)
+			}
+			if apiVersion != tc.expectedAPIVersion {
+				t.Fatalf("API version not expected, expected %#q, got %#q", tc.expectedAPIVersion, apiVersion)
+			}
+		})
+	}
+}
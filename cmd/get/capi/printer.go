@@ -0,0 +1,127 @@
+package capi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/giantswarm/microerror"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+)
+
+const (
+	noneValue    = "NONE"
+	unknownValue = "unknown"
+)
+
+func (r *runner) printOutput(rep report) error {
+	var err error
+
+	switch {
+	case output.IsOutputTable(r.flag.print.OutputFormat):
+		table := getTable(rep)
+
+		err = output.PrintTable(r.stdout, table, output.TableOptions{
+			OutputFormat: r.flag.print.OutputFormat,
+			SortBy:       r.flag.SortBy,
+		})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+	case *r.flag.print.OutputFormat == output.TypeJSON:
+		data, err := json.MarshalIndent(rep, "", "    ")
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		_, err = fmt.Fprintln(r.stdout, string(data))
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+	case *r.flag.print.OutputFormat == output.TypeYAML:
+		data, err := yaml.Marshal(rep)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		_, err = r.stdout.Write(data)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	return nil
+}
+
+func getTable(rep report) *metav1.Table {
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Type", Type: "string"},
+			{Name: "Provider", Type: "string"},
+			{Name: "Name", Type: "string"},
+			{Name: "Version", Type: "string"},
+			{Name: "Status", Type: "string"},
+			{Name: "Required API Version", Type: "string", Priority: 1},
+			{Name: "Replicas", Type: "integer", Priority: 1},
+		},
+	}
+
+	for _, c := range rep.Controllers {
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells: []interface{}{
+				"Controller",
+				c.Provider,
+				c.Name,
+				formatVersions(c.Versions),
+				c.Status,
+				formatRequiredAPIVersion(c.RequiredAPIVersion),
+				len(c.Replicas),
+			},
+		})
+
+		for _, crd := range c.CRDs {
+			table.Rows = append(table.Rows, metav1.TableRow{
+				Cells: []interface{}{
+					"CRD",
+					c.Provider,
+					crd.DisplayName,
+					formatVersions(crd.ServedVersions),
+					crd.Status,
+					formatRequiredAPIVersion(c.RequiredAPIVersion),
+					"",
+				},
+			})
+		}
+	}
+
+	return table
+}
+
+func formatVersions(versions []string) string {
+	if len(versions) < 1 {
+		return noneValue
+	}
+
+	formatted := make([]string, 0, len(versions))
+	for _, v := range versions {
+		if len(v) < 1 {
+			v = unknownValue
+		}
+		formatted = append(formatted, v)
+	}
+
+	return strings.Join(formatted, ",")
+}
+
+func formatRequiredAPIVersion(version string) string {
+	if len(version) < 1 {
+		return unknownValue
+	}
+
+	return version
+}
@@ -0,0 +1,53 @@
+package capi
+
+const (
+	// statusOK is set for controllers whose CRDs all serve the
+	// required API version, and for CRDs serving it.
+	statusOK = "OK"
+	// statusIncompatible is set for CRDs not serving the API version
+	// required by their controller, and for the controller.
+	statusIncompatible = "Incompatible"
+	// statusMissing is set for CRDs that are not installed.
+	statusMissing = "Missing"
+	// statusNotInstalled is set for controllers without any pods.
+	statusNotInstalled = "NotInstalled"
+	// statusUnknown is set for CRDs whose controller's
+	// required API version can't be determined.
+	statusUnknown = "Unknown"
+	// statusUnknownVersion is set for controllers running a version
+	// that is not covered by the compatibility matrix.
+	statusUnknownVersion = "UnknownVersion"
+	// statusVersionMismatch is set for controllers whose
+	// replicas run different versions.
+	statusVersionMismatch = "VersionMismatch"
+)
+
+// report is the result of checking the installed Cluster API
+// controllers against the compatibility matrix.
+type report struct {
+	Controllers []controllerReport `json:"controllers"`
+}
+
+type controllerReport struct {
+	Name     string `json:"name"`
+	Provider string `json:"provider"`
+	// Versions are the distinct versions run by the replicas.
+	Versions           []string        `json:"versions,omitempty"`
+	Replicas           []replicaReport `json:"replicas,omitempty"`
+	RequiredAPIVersion string          `json:"requiredAPIVersion,omitempty"`
+	Status             string          `json:"status"`
+	CRDs               []crdReport     `json:"crds"`
+}
+
+type replicaReport struct {
+	Pod     string `json:"pod"`
+	Image   string `json:"image"`
+	Version string `json:"version"`
+}
+
+type crdReport struct {
+	Name           string   `json:"name"`
+	DisplayName    string   `json:"displayName"`
+	ServedVersions []string `json:"servedVersions,omitempty"`
+	Status         string   `json:"status"`
+}
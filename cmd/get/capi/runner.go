@@ -3,212 +3,30 @@ package capi
 import (
 	"context"
 	"io"
+	"sort"
 	"strings"
 
-	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	"github.com/spf13/cobra"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/giantswarm/kubectl-gs/pkg/commonconfig"
 )
 
-const (
-	giantswarmNamespace = "giantswarm"
-
-	providerAll    = "All"
-	providerAWS    = "AWS"
-	providerAzure  = "Azure"
-	providerVMware = "VMware"
-)
-
 type runner struct {
 	flag   *flag
 	logger micrologger.Logger
 
-	stdout io.Writer
-}
-
-type crd struct {
-	DisplayName string
-	Name        string
-	Provider    string
-}
+	extClient apiextensionsclient.Interface
+	k8sClient kubernetes.Interface
 
-type controller struct {
-	DisplayName   string
-	LabelSelector string
-	ContainerName string
-	Provider      string
+	stdout io.Writer
 }
 
-var (
-	crds = []crd{
-		{
-			DisplayName: "Cluster",
-			Name:        "clusters.cluster.x-k8s.io",
-			Provider:    providerAll,
-		},
-		{
-			DisplayName: "ClusterResourceSet",
-			Name:        "clusterresourcesets.addons.cluster.x-k8s.io",
-			Provider:    providerAll,
-		},
-		{
-			DisplayName: "ClusterResourceSetBinding",
-			Name:        "clusterresourcesetbindings.addons.cluster.x-k8s.io",
-			Provider:    providerAll,
-		},
-		{
-			DisplayName: "Machine Pool",
-			Name:        "machinepools.exp.cluster.x-k8s.io",
-			Provider:    providerAll,
-		},
-		{
-			DisplayName: "Machine Deployment",
-			Name:        "machinedeployments.cluster.x-k8s.io",
-			Provider:    providerAll,
-		},
-		{
-			DisplayName: "Machine Set",
-			Name:        "machinesets.cluster.x-k8s.io",
-			Provider:    providerAll,
-		},
-		{
-			DisplayName: "Machine",
-			Name:        "machines.cluster.x-k8s.io",
-			Provider:    providerAll,
-		},
-		{
-			DisplayName: "Machine Health Check",
-			Name:        "machinehealthchecks.cluster.x-k8s.io",
-			Provider:    providerAll,
-		},
-		{
-			DisplayName: "Kubeadm Control Plane",
-			Name:        "kubeadmcontrolplanes.controlplane.cluster.x-k8s.io",
-			Provider:    providerAll,
-		},
-		{
-			DisplayName: "Kubeadm Config",
-			Name:        "kubeadmconfigs.bootstrap.cluster.x-k8s.io",
-			Provider:    providerAll,
-		},
-		{
-			DisplayName: "Kubeadm Config Template",
-			Name:        "kubeadmconfigtemplates.bootstrap.cluster.x-k8s.io",
-			Provider:    providerAll,
-		},
-		{
-			DisplayName: "AWS Cluster",
-			Name:        "awsclusters.infrastructure.cluster.x-k8s.io",
-			Provider:    providerAWS,
-		},
-		{
-			DisplayName: "AWS Machine Pools",
-			Name:        "awsmachinepools.infrastructure.cluster.x-k8s.io",
-			Provider:    providerAWS,
-		},
-		{
-			DisplayName: "AWS Machine",
-			Name:        "awsmachines.infrastructure.cluster.x-k8s.io",
-			Provider:    providerAWS,
-		},
-		{
-			DisplayName: "AWS Machine Template",
-			Name:        "awsmachinetemplates.infrastructure.cluster.x-k8s.io",
-			Provider:    providerAWS,
-		},
-		{
-			DisplayName: "Azure Cluster",
-			Name:        "azureclusters.infrastructure.cluster.x-k8s.io",
-			Provider:    providerAzure,
-		},
-		{
-			DisplayName: "Azure Machine Pools",
-			Name:        "azuremachinepools.exp.infrastructure.cluster.x-k8s.io",
-			Provider:    providerAzure,
-		},
-		{
-			DisplayName: "Azure Machine",
-			Name:        "azuremachines.infrastructure.cluster.x-k8s.io",
-			Provider:    providerAzure,
-		},
-		{
-			DisplayName: "Azure Machine Template",
-			Name:        "azuremachinetemplates.infrastructure.cluster.x-k8s.io",
-			Provider:    providerAzure,
-		},
-		{
-			DisplayName: "VMware Cluster",
-			Name:        "vsphereclusters.infrastructure.cluster.x-k8s.io",
-			Provider:    providerVMware,
-		},
-		{
-			DisplayName: "VMware Machine",
-			Name:        "vspheremachines.infrastructure.cluster.x-k8s.io",
-			Provider:    providerVMware,
-		},
-		{
-			DisplayName: "VMware Machine Template",
-			Name:        "vspheremachinetemplates.infrastructure.cluster.x-k8s.io",
-			Provider:    providerVMware,
-		},
-		{
-			DisplayName: "VMware VM",
-			Name:        "vspherevms.infrastructure.cluster.x-k8s.io",
-			Provider:    providerVMware,
-		},
-		{
-			DisplayName: "VMware HAProxy",
-			Name:        "haproxyloadbalancers.infrastructure.cluster.x-k8s.io",
-			Provider:    providerVMware,
-		},
-	}
-
-	controllers = []controller{
-		{
-			DisplayName:   "Core",
-			LabelSelector: "app.kubernetes.io/name=cluster-api-core",
-			ContainerName: "manager",
-			Provider:      providerAll,
-		},
-		{
-			DisplayName:   "Kubeadm Bootstrap",
-			LabelSelector: "app.kubernetes.io/name=cluster-api-bootstrap-provider-kubeadm",
-			ContainerName: "manager",
-			Provider:      providerAll,
-		},
-		{
-			DisplayName:   "Kubeadm Control Plane",
-			LabelSelector: "app.kubernetes.io/name=cluster-api-control-plane",
-			ContainerName: "manager",
-			Provider:      providerAll,
-		},
-		{
-			DisplayName:   "AWS Provider",
-			LabelSelector: "app.kubernetes.io/name=cluster-api-provider-aws,control-plane=capa-controller-manager",
-			ContainerName: "manager",
-			Provider:      providerAWS,
-		},
-		{
-			DisplayName:   "Azure Provider",
-			LabelSelector: "app.kubernetes.io/name=cluster-api-provider-azure",
-			ContainerName: "manager",
-			Provider:      providerAzure,
-		},
-		{
-			DisplayName:   "VMWare Provider",
-			LabelSelector: "app.kubernetes.io/name=cluster-api-provider-vmware",
-			ContainerName: "manager",
-			Provider:      providerVMware,
-		},
-	}
-)
-
 func (r *runner) Run(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
@@ -226,78 +44,200 @@ func (r *runner) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) error {
-	var client k8sclient.Interface
-	{
-		config := commonconfig.New(r.flag.config)
-		c, err := config.GetClient(r.logger)
+	m, err := parseMatrix(compatibilityMatrix)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	controllers, err := filterControllers(m, r.flag.Provider)
+	if err != nil {
+		return microerror.Mask(err)
+	}
 
+	err = r.getClients()
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	rep := report{}
+	for _, c := range controllers {
+		cr, err := r.getControllerReport(ctx, m.Namespace, c)
 		if err != nil {
 			return microerror.Mask(err)
 		}
 
-		client = c.K8sClient
+		rep.Controllers = append(rep.Controllers, cr)
 	}
 
-	table := &metav1.Table{
-		ColumnDefinitions: []metav1.TableColumnDefinition{
-			{Name: "Type", Type: "string"},
-			{Name: "Provider", Type: "string"},
-			{Name: "Name", Type: "string"},
-			{Name: "Version", Type: "string"},
-		},
+	err = r.printOutput(rep)
+	if err != nil {
+		return microerror.Mask(err)
 	}
 
-	for _, crd := range crds {
-		foundCrd, err := client.ExtClient().ApiextensionsV1().CustomResourceDefinitions().Get(
-			ctx,
-			crd.Name,
-			metav1.GetOptions{},
-		)
-		if err != nil && !errors.IsNotFound(err) {
-			return microerror.Mask(err)
+	return nil
+}
+
+func (r *runner) getClients() error {
+	if r.extClient != nil && r.k8sClient != nil {
+		return nil
+	}
+
+	config := commonconfig.New(r.flag.config)
+	c, err := config.GetClient(r.logger)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.extClient = c.K8sClient.ExtClient()
+	r.k8sClient = c.K8sClient.K8sClient()
+
+	return nil
+}
+
+// getControllerReport looks up the pods of the given controller and the
+// CRDs it reconciles, and checks whether the CRDs serve the API version
+// that the running controller version requires.
+func (r *runner) getControllerReport(ctx context.Context, namespace string, c matrixController) (controllerReport, error) {
+	cr := controllerReport{
+		Name:     c.Name,
+		Provider: c.Provider,
+	}
+
+	podList, err := r.k8sClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: c.LabelSelector,
+	})
+	if err != nil {
+		return controllerReport{}, microerror.Mask(err)
+	}
+
+	versions := map[string]bool{}
+	for _, pod := range podList.Items {
+		for _, container := range pod.Spec.Containers {
+			if container.Name != c.ContainerName {
+				continue
+			}
+
+			// Images that can't be parsed are reported
+			// with an unknown version rather than failing.
+			var version string
+			ref, err := parseImage(container.Image)
+			if err == nil {
+				version = ref.Version()
+			}
+
+			cr.Replicas = append(cr.Replicas, replicaReport{
+				Pod:     pod.Name,
+				Image:   container.Image,
+				Version: version,
+			})
+			versions[version] = true
 		}
+	}
 
-		if errors.IsNotFound(err) {
-			row := metav1.TableRow{Cells: []interface{}{"CRD", crd.Provider, crd.DisplayName, "NONE"}}
-			table.Rows = append(table.Rows, row)
+	for v := range versions {
+		cr.Versions = append(cr.Versions, v)
+	}
+	sort.Strings(cr.Versions)
+
+	switch {
+	case len(cr.Replicas) < 1:
+		cr.Status = statusNotInstalled
+	case len(cr.Versions) > 1:
+		cr.Status = statusVersionMismatch
+	default:
+		var ok bool
+		cr.RequiredAPIVersion, ok = c.requiredAPIVersion(cr.Versions[0])
+		if !ok {
+			cr.Status = statusUnknownVersion
 		}
+	}
 
-		for _, version := range foundCrd.Spec.Versions {
-			row := metav1.TableRow{Cells: []interface{}{"CRD", crd.Provider, crd.DisplayName, version.Name}}
-			table.Rows = append(table.Rows, row)
+	compatible := true
+	for _, crd := range c.CRDs {
+		crdr, err := r.getCRDReport(ctx, crd, cr.RequiredAPIVersion)
+		if err != nil {
+			return controllerReport{}, microerror.Mask(err)
 		}
+		if crdr.Status != statusOK {
+			compatible = false
+		}
+
+		cr.CRDs = append(cr.CRDs, crdr)
 	}
 
-	for _, controller := range controllers {
-		version := "NONE"
+	if len(cr.Status) < 1 {
+		if compatible {
+			cr.Status = statusOK
+		} else {
+			cr.Status = statusIncompatible
+		}
+	}
 
-		podList, err := client.K8sClient().CoreV1().Pods(giantswarmNamespace).List(
-			ctx,
-			metav1.ListOptions{
-				LabelSelector: controller.LabelSelector,
-			},
-		)
-		if err != nil {
-			return microerror.Mask(err)
+	return cr, nil
+}
+
+func (r *runner) getCRDReport(ctx context.Context, crd matrixCRD, requiredAPIVersion string) (crdReport, error) {
+	crdr := crdReport{
+		Name:        crd.Name,
+		DisplayName: crd.DisplayName,
+	}
+
+	found, err := r.extClient.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, crd.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		crdr.Status = statusMissing
+		return crdr, nil
+	} else if err != nil {
+		return crdReport{}, microerror.Mask(err)
+	}
+
+	served := false
+	for _, v := range found.Spec.Versions {
+		if !v.Served {
+			continue
 		}
 
-		for _, pod := range podList.Items {
-			for _, container := range pod.Spec.Containers {
-				if container.Name == "manager" {
-					version = strings.Split(container.Image, ":")[1]
-				}
-			}
+		crdr.ServedVersions = append(crdr.ServedVersions, v.Name)
+		if v.Name == requiredAPIVersion {
+			served = true
 		}
+	}
 
-		row := metav1.TableRow{Cells: []interface{}{"Controller", controller.Provider, controller.DisplayName, version}}
-		table.Rows = append(table.Rows, row)
+	switch {
+	case len(requiredAPIVersion) < 1:
+		crdr.Status = statusUnknown
+	case served:
+		crdr.Status = statusOK
+	default:
+		crdr.Status = statusIncompatible
 	}
 
-	printer := printers.NewTablePrinter(printers.PrintOptions{})
-	err := printer.PrintObj(table, r.stdout)
-	if err != nil {
-		return microerror.Mask(err)
+	return crdr, nil
+}
+
+// filterControllers returns the controllers shared by all providers and
+// the ones of the given provider, or all of them if provider is empty.
+func filterControllers(m *matrix, provider string) ([]matrixController, error) {
+	if len(provider) < 1 {
+		return m.Controllers, nil
 	}
 
-	return nil
+	var known bool
+	for _, p := range m.providers() {
+		if p == provider {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return nil, microerror.Maskf(invalidFlagsError, "--%s must be one of %s", flagProvider, strings.Join(m.providers(), ", "))
+	}
+
+	var controllers []matrixController
+	for _, c := range m.Controllers {
+		if c.Provider == providerAll || c.Provider == provider {
+			controllers = append(controllers, c)
+		}
+	}
+
+	return controllers, nil
 }
@@ -0,0 +1,200 @@
+package capi
+
+import (
+	"bytes"
+	"context"
+	goflag "flag"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+	"github.com/giantswarm/kubectl-gs/test/goldenfile"
+)
+
+var update = goflag.Bool("update", false, "update .golden reference test files")
+
+// Test_run uses golden files.
+//
+//  go test ./cmd/get/capi -run Test_run -update
+//
+func Test_run(t *testing.T) {
+	coreCRDs := []runtime.Object{
+		newCRD("clusters.cluster.x-k8s.io", "v1alpha2", "v1alpha3"),
+		newCRD("clusterresourcesets.addons.cluster.x-k8s.io", "v1alpha3"),
+		newCRD("clusterresourcesetbindings.addons.cluster.x-k8s.io", "v1alpha3"),
+		newCRD("machinepools.exp.cluster.x-k8s.io", "v1alpha3"),
+		newCRD("machinedeployments.cluster.x-k8s.io", "v1alpha3"),
+		newCRD("machinesets.cluster.x-k8s.io", "v1alpha3"),
+		newCRD("machines.cluster.x-k8s.io", "v1alpha3"),
+		newCRD("machinehealthchecks.cluster.x-k8s.io", "v1alpha3"),
+		newCRD("kubeadmconfigs.bootstrap.cluster.x-k8s.io", "v1alpha3"),
+		newCRD("kubeadmconfigtemplates.bootstrap.cluster.x-k8s.io", "v1alpha3"),
+		newCRD("kubeadmcontrolplanes.controlplane.cluster.x-k8s.io", "v1alpha3"),
+	}
+	awsCRDs := []runtime.Object{
+		newCRD("awsclusters.infrastructure.cluster.x-k8s.io", "v1alpha3"),
+		newCRD("awsmachinepools.infrastructure.cluster.x-k8s.io", "v1alpha3"),
+		newCRD("awsmachines.infrastructure.cluster.x-k8s.io", "v1alpha3"),
+		// Only served in an older API version.
+		newCRD("awsmachinetemplates.infrastructure.cluster.x-k8s.io", "v1alpha2"),
+	}
+	corePods := []runtime.Object{
+		newPod("capi-core-0", "app.kubernetes.io/name=cluster-api-core", "quay.io/giantswarm/cluster-api-controller:v0.3.13-gs"),
+		newPod("capi-bootstrap-0", "app.kubernetes.io/name=cluster-api-bootstrap-provider-kubeadm", "quay.io/giantswarm/kubeadm-bootstrap-controller:v0.3.13"),
+		newPod("capi-bootstrap-1", "app.kubernetes.io/name=cluster-api-bootstrap-provider-kubeadm", "quay.io/giantswarm/kubeadm-bootstrap-controller:v0.3.14"),
+		newPod("capi-control-plane-0", "app.kubernetes.io/name=cluster-api-control-plane", "quay.io/giantswarm/kubeadm-control-plane-controller@sha256:8d9ad4a3"),
+	}
+	awsPods := []runtime.Object{
+		newPod("capa-0", "app.kubernetes.io/name=cluster-api-provider-aws,control-plane=capa-controller-manager", "registry.local:5000/giantswarm/cluster-api-aws-controller:v0.6.5-gs1"),
+	}
+
+	testCases := []struct {
+		name               string
+		crds               []runtime.Object
+		pods               []runtime.Object
+		provider           string
+		outputType         string
+		expectedGoldenFile string
+		errorMatcher       func(error) bool
+	}{
+		{
+			name:               "case 0: get capi, without any components",
+			expectedGoldenFile: "run_get_capi_empty.golden",
+		},
+		{
+			name:               "case 1: get capi of a provider",
+			crds:               append(append([]runtime.Object{}, coreCRDs...), awsCRDs...),
+			pods:               append(append([]runtime.Object{}, corePods...), awsPods...),
+			provider:           "aws",
+			expectedGoldenFile: "run_get_capi_provider.golden",
+		},
+		{
+			name:               "case 2: get capi of a provider, with wide output",
+			crds:               append(append([]runtime.Object{}, coreCRDs...), awsCRDs...),
+			pods:               append(append([]runtime.Object{}, corePods...), awsPods...),
+			provider:           "aws",
+			outputType:         output.TypeWide,
+			expectedGoldenFile: "run_get_capi_provider_wide.golden",
+		},
+		{
+			name:               "case 3: get capi of a provider, with YAML output",
+			crds:               awsCRDs,
+			pods:               awsPods,
+			provider:           "aws",
+			outputType:         output.TypeYAML,
+			expectedGoldenFile: "run_get_capi_provider_yaml.golden",
+		},
+		{
+			name:         "case 4: get capi of an unknown provider",
+			provider:     "openstack",
+			errorMatcher: IsInvalidFlags,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			outputType := output.TypeDefault
+			if len(tc.outputType) > 0 {
+				outputType = tc.outputType
+			}
+
+			flag := &flag{
+				print: genericclioptions.NewPrintFlags("").WithDefaultOutput(outputType),
+
+				Provider: tc.provider,
+			}
+			out := new(bytes.Buffer)
+			runner := &runner{
+				flag:      flag,
+				extClient: apiextensionsfake.NewSimpleClientset(tc.crds...),
+				k8sClient: k8sfake.NewSimpleClientset(tc.pods...),
+				stdout:    out,
+			}
+
+			err := runner.run(ctx, nil, nil)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			var expectedResult []byte
+			{
+				gf := goldenfile.New("testdata", tc.expectedGoldenFile)
+				if *update {
+					err = gf.Update(out.Bytes())
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err.Error())
+					}
+					expectedResult = out.Bytes()
+				} else {
+					expectedResult, err = gf.Read()
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err.Error())
+					}
+				}
+			}
+
+			diff := cmp.Diff(string(expectedResult), out.String())
+			if diff != "" {
+				t.Fatalf("value not expected, got:\n %s", diff)
+			}
+		})
+	}
+}
+
+func newCRD(name string, versions ...string) *apiextensionsv1.CustomResourceDefinition {
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+
+	for _, v := range versions {
+		crd.Spec.Versions = append(crd.Spec.Versions, apiextensionsv1.CustomResourceDefinitionVersion{
+			Name:   v,
+			Served: true,
+		})
+	}
+
+	return crd
+}
+
+func newPod(name, labelSelector, image string) *corev1.Pod {
+	labels, _ := metav1.ParseToLabelSelector(labelSelector)
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "giantswarm",
+			Labels:    labels.MatchLabels,
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  "manager",
+					Image: image,
+				},
+				{
+					Name:  "kube-rbac-proxy",
+					Image: "quay.io/giantswarm/kube-rbac-proxy:v0.8.0",
+				},
+			},
+		},
+	}
+}
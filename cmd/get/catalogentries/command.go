@@ -0,0 +1,104 @@
+package catalogentries
+
+import (
+	"io"
+	"os"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/giantswarm/kubectl-gs/pkg/middleware"
+	"github.com/giantswarm/kubectl-gs/pkg/middleware/renewtoken"
+)
+
+const (
+	name  = "catalog-entries"
+	alias = "catalog-entry"
+
+	shortDescription = "Search apps across catalogs"
+	longDescription  = `Search apps across catalogs
+
+Lists the entries of all catalogs, i.e. the versions of the apps they
+ship, optionally filtered by app, catalog and version. Entries are
+searched in all namespaces, unless --namespace is given.
+
+Output columns:
+
+- CATALOG: Name of the catalog.
+- APP NAME: Name of the app.
+- APP VERSION: Upstream version of the app.
+- VERSION: Version of the app chart.
+- DATE: When the app chart was last updated.
+- LATEST: Whether this is the latest version of the app in the catalog.`
+
+	examples = `  # List all versions of an app, in all catalogs
+  kubectl gs get catalog-entries --app nginx-ingress-controller-app
+
+  # List the latest version of all apps in a catalog
+  kubectl gs get catalog-entries --catalog giantswarm --latest
+
+  # List the versions of an app matching a semantic version range
+  kubectl gs get catalog-entries --app cert-manager-app --version-constraint ">=2.3.0 <3.0.0"
+
+  # Same as above, using the search command
+  kubectl gs search apps --app cert-manager-app --version-constraint ">=2.3.0 <3.0.0"`
+)
+
+type Config struct {
+	Logger     micrologger.Logger
+	FileSystem afero.Fs
+
+	K8sConfigAccess clientcmd.ConfigAccess
+
+	Stderr io.Writer
+	Stdout io.Writer
+}
+
+func New(config Config) (*cobra.Command, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.FileSystem == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.FileSystem must not be empty", config)
+	}
+	if config.K8sConfigAccess == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sConfigAccess must not be empty", config)
+	}
+	if config.Stderr == nil {
+		config.Stderr = os.Stderr
+	}
+	if config.Stdout == nil {
+		config.Stdout = os.Stdout
+	}
+
+	f := &flag{}
+
+	r := &runner{
+		flag:   f,
+		logger: config.Logger,
+		fs:     config.FileSystem,
+
+		stderr: config.Stderr,
+		stdout: config.Stdout,
+	}
+
+	c := &cobra.Command{
+		Use:     name,
+		Short:   shortDescription,
+		Long:    longDescription,
+		Example: examples,
+		Aliases: []string{alias},
+		Args:    cobra.NoArgs,
+		RunE:    r.Run,
+		PreRunE: middleware.Compose(
+			renewtoken.Middleware(config.K8sConfigAccess),
+		),
+	}
+
+	f.Init(c)
+
+	return c, nil
+}
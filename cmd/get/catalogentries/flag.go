@@ -0,0 +1,61 @@
+package catalogentries
+
+import (
+	"github.com/blang/semver/v4"
+	"github.com/giantswarm/microerror"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"strings"
+
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+)
+
+const (
+	flagApp               = "app"
+	flagCatalog           = "catalog"
+	flagLatest            = "latest"
+	flagTimestamps        = "timestamps"
+	flagVersionConstraint = "version-constraint"
+)
+
+type flag struct {
+	App               string
+	Catalog           string
+	Latest            bool
+	Timestamps        string
+	VersionConstraint string
+
+	config genericclioptions.RESTClientGetter
+	print  *genericclioptions.PrintFlags
+}
+
+func (f *flag) Init(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.App, flagApp, "", "If present, only list the entries of the app with this name.")
+	cmd.Flags().StringVar(&f.Catalog, flagCatalog, "", "If present, only list the entries of the catalog with this name.")
+	cmd.Flags().BoolVar(&f.Latest, flagLatest, false, "If present, only list the latest entry of each app.")
+	cmd.Flags().StringVar(&f.VersionConstraint, flagVersionConstraint, "", "If present, only list the entries with a version in this semantic version range, e.g. '>=1.2.0 <2.0.0' or '1.x'.")
+	cmd.Flags().StringVar(&f.Timestamps, flagTimestamps, output.TimestampsRelative, "Print timestamps in table output as the time elapsed since then (relative) or as dates in UTC (absolute).")
+
+	f.config = genericclioptions.NewConfigFlags(true)
+	f.print = genericclioptions.NewPrintFlags("")
+
+	// Merging current command flags and config flags,
+	// to be able to override kubectl-specific ones.
+	f.config.(*genericclioptions.ConfigFlags).AddFlags(cmd.Flags())
+	f.print.AddFlags(cmd)
+	output.AddTableFormatsUsage(cmd)
+}
+
+func (f *flag) Validate() error {
+	if len(f.VersionConstraint) > 0 {
+		_, err := semver.ParseRange(f.VersionConstraint)
+		if err != nil {
+			return microerror.Maskf(invalidFlagError, "--%s must be a valid version range: %s", flagVersionConstraint, err)
+		}
+	}
+	if !output.IsTimestampFormat(f.Timestamps) {
+		return microerror.Maskf(invalidFlagError, "--%s must be one of %s", flagTimestamps, strings.Join(output.TimestampFormats, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package catalogentries
+
+import (
+	"fmt"
+
+	"github.com/giantswarm/microerror"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/catalogentry"
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+)
+
+func (r *runner) printOutput(entryResource catalogentry.Resource) error {
+	var (
+		err      error
+		printer  printers.ResourcePrinter
+		resource runtime.Object
+	)
+
+	switch {
+	case output.IsOutputTable(r.flag.print.OutputFormat):
+		tableOptions := output.TableOptions{
+			OutputFormat: r.flag.print.OutputFormat,
+		}
+		err = output.PrintTable(r.stdout, getTable(entryResource, r.flag.Timestamps), tableOptions)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+
+	case output.IsOutputName(r.flag.print.OutputFormat):
+		resource = entryResource.Object()
+		err = output.PrintResourceNames(r.stdout, resource)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+
+	default:
+		resource = entryResource.Object()
+		printer, err = r.flag.print.ToPrinter()
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	err = printer.PrintObj(resource, r.stdout)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *runner) printNoMatchOutput() error {
+	fmt.Fprintf(r.stderr, "No AppCatalogEntry CRD found.\n")
+	fmt.Fprintf(r.stderr, "Please check you are accessing a management cluster\n\n")
+
+	err := output.PrintEmptyList(r.stdout, r.flag.print)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *runner) printNoResourcesOutput() error {
+	fmt.Fprintf(r.stderr, "No catalog entries found.\n")
+	fmt.Fprintf(r.stderr, "To list the available catalogs, please check\n\n")
+	fmt.Fprintf(r.stderr, "  kubectl gs get catalogs --help\n")
+
+	err := output.PrintEmptyList(r.stdout, r.flag.print)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func getTable(entryResource catalogentry.Resource, timestamps string) *metav1.Table {
+	// Creating a custom table resource.
+	table := &metav1.Table{}
+
+	table.ColumnDefinitions = []metav1.TableColumnDefinition{
+		{Name: "Catalog", Type: "string"},
+		{Name: "App Name", Type: "string"},
+		{Name: "App Version", Type: "string"},
+		{Name: "Version", Type: "string"},
+		{Name: "Date", Type: "string"},
+		{Name: "Latest", Type: "boolean"},
+	}
+
+	switch e := entryResource.(type) {
+	case *catalogentry.CatalogEntry:
+		table.Rows = append(table.Rows, getEntryRow(*e, timestamps))
+	case *catalogentry.Collection:
+		for _, entryItem := range e.Items {
+			table.Rows = append(table.Rows, getEntryRow(entryItem, timestamps))
+		}
+	}
+
+	return table
+}
+
+func getEntryRow(e catalogentry.CatalogEntry, timestamps string) metav1.TableRow {
+	if e.CR == nil {
+		return metav1.TableRow{}
+	}
+
+	return metav1.TableRow{
+		Cells: []interface{}{
+			e.CR.Spec.Catalog.Name,
+			e.CR.Spec.AppName,
+			e.CR.Spec.AppVersion,
+			e.CR.Spec.Version,
+			output.NewTimestamp(getDate(e), timestamps),
+			catalogentry.IsLatest(e.CR),
+		},
+		Object: runtime.RawExtension{
+			Object: e.CR,
+		},
+	}
+}
+
+func getDate(e catalogentry.CatalogEntry) metav1.Time {
+	if e.CR.Spec.DateUpdated == nil {
+		return metav1.Time{}
+	}
+
+	return *e.CR.Spec.DateUpdated
+}
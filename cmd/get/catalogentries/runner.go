@@ -0,0 +1,135 @@
+package catalogentries
+
+import (
+	"context"
+	"io"
+
+	"github.com/blang/semver/v4"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/kubectl-gs/pkg/commonconfig"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/catalogentry"
+)
+
+type runner struct {
+	flag   *flag
+	logger micrologger.Logger
+	fs     afero.Fs
+
+	service catalogentry.Interface
+
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func (r *runner) Run(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	err := r.flag.Validate()
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = r.run(ctx, cmd, args)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) error {
+	var err error
+
+	config := commonconfig.New(r.flag.config)
+	{
+		err = r.getService(config)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	// Catalog entries are searched in all namespaces,
+	// unless a namespace is given explicitly.
+	namespace := metav1.NamespaceAll
+	{
+		configNamespace, overridden, err := r.flag.config.ToRawKubeConfigLoader().Namespace()
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		if overridden {
+			namespace = configNamespace
+		}
+	}
+
+	var versionConstraint semver.Range
+	{
+		if len(r.flag.VersionConstraint) > 0 {
+			versionConstraint, err = semver.ParseRange(r.flag.VersionConstraint)
+			if err != nil {
+				return microerror.Maskf(invalidFlagError, "--%s must be a valid version range: %s", flagVersionConstraint, err)
+			}
+		}
+	}
+
+	var entryResource catalogentry.Resource
+	{
+		options := catalogentry.GetOptions{
+			AppName:           r.flag.App,
+			Catalog:           r.flag.Catalog,
+			Latest:            r.flag.Latest,
+			Namespace:         namespace,
+			VersionConstraint: versionConstraint,
+		}
+		entryResource, err = r.service.Get(ctx, options)
+		if catalogentry.IsNoMatch(err) {
+			err = r.printNoMatchOutput()
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			return nil
+		} else if catalogentry.IsNoResources(err) {
+			err = r.printNoResourcesOutput()
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			return nil
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	err = r.printOutput(entryResource)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *runner) getService(config *commonconfig.CommonConfig) error {
+	if r.service != nil {
+		return nil
+	}
+
+	client, err := config.GetClient(r.logger)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	serviceConfig := catalogentry.Config{
+		Client: client,
+	}
+	r.service, err = catalogentry.New(serviceConfig)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package catalogentries
+
+import (
+	"bytes"
+	"context"
+	goflag "flag"
+	"testing"
+	"time"
+
+	applicationv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/application/v1alpha1"
+	"github.com/giantswarm/apiextensions/v3/pkg/label"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/catalogentry"
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+	"github.com/giantswarm/kubectl-gs/test/goldenfile"
+	"github.com/giantswarm/kubectl-gs/test/kubeconfig"
+)
+
+var update = goflag.Bool("update", false, "update .golden reference test files")
+
+// Test_run uses golden files.
+//
+//  go test ./cmd/get/catalogentries -run Test_run -update
+//
+func Test_run(t *testing.T) {
+	storage := []runtime.Object{
+		newAppCatalogEntry("giantswarm", "cert-manager-app", "1.0.8", "2.3.1", "2021-03-01T10:00:00Z", false),
+		newAppCatalogEntry("giantswarm", "cert-manager-app", "1.1.0", "2.4.0", "2021-04-01T10:00:00Z", true),
+		newAppCatalogEntry("giantswarm", "cert-manager-app", "1.0.8", "2.10.0", "2021-05-01T10:00:00Z", false),
+		newAppCatalogEntry("giantswarm", "nginx-ingress-controller-app", "0.44.0", "1.15.0", "2021-03-02T10:00:00Z", true),
+		newAppCatalogEntry("default", "cert-manager-app", "1.0.8", "2.3.1", "2021-03-01T10:00:00Z", true),
+		newAppCatalogEntry("default", "efk-stack-app", "1.2.0", "0.5.0", "2021-02-01T10:00:00Z", true),
+	}
+
+	testCases := []struct {
+		name                  string
+		storage               []runtime.Object
+		app                   string
+		catalog               string
+		latest                bool
+		versionConstraint     string
+		outputType            string
+		expectedGoldenFile    string
+		expectedErrGoldenFile string
+		errorMatcher          func(error) bool
+	}{
+		{
+			name:               "case 0: get catalog entries",
+			storage:            storage,
+			expectedGoldenFile: "run_get_catalog_entries.golden",
+		},
+		{
+			name:                  "case 1: get catalog entries, with empty storage",
+			storage:               nil,
+			expectedErrGoldenFile: "run_get_catalog_entries_empty_storage.golden",
+		},
+		{
+			name:               "case 2: get catalog entries of an app",
+			storage:            storage,
+			app:                "cert-manager-app",
+			expectedGoldenFile: "run_get_catalog_entries_by_app.golden",
+		},
+		{
+			name:               "case 3: get latest catalog entries of a catalog",
+			storage:            storage,
+			catalog:            "giantswarm",
+			latest:             true,
+			expectedGoldenFile: "run_get_catalog_entries_by_catalog_latest.golden",
+		},
+		{
+			name:               "case 4: get catalog entries of an app, with a version constraint",
+			storage:            storage,
+			app:                "cert-manager-app",
+			versionConstraint:  ">=2.4.0",
+			expectedGoldenFile: "run_get_catalog_entries_by_version_constraint.golden",
+		},
+		{
+			name:                  "case 5: get catalog entries, with filters not matching any entry",
+			storage:               storage,
+			app:                   "efk-stack-app",
+			catalog:               "giantswarm",
+			expectedErrGoldenFile: "run_get_catalog_entries_empty_storage.golden",
+		},
+		{
+			name:               "case 6: get catalog entries of an app, with YAML output",
+			storage:            storage,
+			app:                "efk-stack-app",
+			outputType:         output.TypeYAML,
+			expectedGoldenFile: "run_get_catalog_entries_yaml_output.golden",
+		},
+		{
+			name:              "case 7: get catalog entries, with an invalid version constraint",
+			storage:           storage,
+			versionConstraint: "latest",
+			errorMatcher:      IsInvalidFlag,
+		},
+		{
+			name:                  "case 8: get catalog entries, with empty storage, and JSON output",
+			storage:               nil,
+			outputType:            output.TypeJSON,
+			expectedGoldenFile:    "run_get_catalog_entries_empty_storage_json_output.golden",
+			expectedErrGoldenFile: "run_get_catalog_entries_empty_storage.golden",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			outputType := output.TypeDefault
+			if len(tc.outputType) > 0 {
+				outputType = tc.outputType
+			}
+
+			fakeKubeConfig := kubeconfig.CreateFakeKubeConfig()
+			flag := &flag{
+				print:  genericclioptions.NewPrintFlags("").WithDefaultOutput(outputType),
+				config: genericclioptions.NewTestConfigFlags().WithClientConfig(fakeKubeConfig),
+
+				App:               tc.app,
+				Catalog:           tc.catalog,
+				Latest:            tc.latest,
+				VersionConstraint: tc.versionConstraint,
+				Timestamps:        output.TimestampsAbsolute,
+			}
+			out := new(bytes.Buffer)
+			errOut := new(bytes.Buffer)
+			runner := &runner{
+				service: catalogentry.NewFakeService(tc.storage),
+				flag:    flag,
+				stdout:  out,
+				stderr:  errOut,
+			}
+
+			err := runner.run(ctx, nil, nil)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			assertGoldenFile(t, tc.expectedGoldenFile, out.Bytes())
+			assertGoldenFile(t, tc.expectedErrGoldenFile, errOut.Bytes())
+		})
+	}
+}
+
+func assertGoldenFile(t *testing.T, name string, actual []byte) {
+	var err error
+
+	if len(name) < 1 {
+		if len(actual) > 0 {
+			t.Fatalf("unexpected output, got:\n %s", actual)
+		}
+
+		return
+	}
+
+	var expectedResult []byte
+	{
+		gf := goldenfile.New("testdata", name)
+		if *update {
+			err = gf.Update(actual)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			expectedResult = actual
+		} else {
+			expectedResult, err = gf.Read()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+		}
+	}
+
+	diff := cmp.Diff(string(expectedResult), string(actual))
+	if diff != "" {
+		t.Fatalf("value not expected, got:\n %s", diff)
+	}
+}
+
+func newAppCatalogEntry(catalog, app, appVersion, version, updated string, latest bool) *applicationv1alpha1.AppCatalogEntry {
+	parsedUpdated, _ := time.Parse(time.RFC3339, updated)
+	dateUpdated := metav1.NewTime(parsedUpdated)
+
+	latestValue := "false"
+	if latest {
+		latestValue = "true"
+	}
+
+	return &applicationv1alpha1.AppCatalogEntry{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "application.giantswarm.io/v1alpha1",
+			Kind:       "AppCatalogEntry",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      catalog + "-" + app + "-" + version,
+			Namespace: "default",
+			Labels: map[string]string{
+				label.AppKubernetesName: app,
+				label.CatalogName:       catalog,
+				"latest":                latestValue,
+			},
+		},
+		Spec: applicationv1alpha1.AppCatalogEntrySpec{
+			AppName:    app,
+			AppVersion: appVersion,
+			Catalog: applicationv1alpha1.AppCatalogEntrySpecCatalog{
+				Name:      catalog,
+				Namespace: "default",
+			},
+			DateUpdated: &dateUpdated,
+			Version:     version,
+		},
+	}
+}
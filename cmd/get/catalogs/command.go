@@ -26,7 +26,10 @@ Output columns:
 - NAME: Name of the catalog.
 - NAMESPACE: Namespace of the catalog.
 - URL: URL for the Helm chart repository.
-- CREATED: How long ago the catalog was created.
+- AGE: How long ago the catalog was created.
+
+With -o wide, the TYPE, DESCRIPTION, ORGANIZATION owning the catalog and
+who it was CREATED BY are shown as well.
 
 Getting a catalog by name will display the latest versions of the apps
 in this catalog according to semantic versioning.
@@ -37,7 +40,7 @@ Output columns:
 - APP NAME: Name of the app.
 - APP VERSION: Upstream version of the app.
 - VERSION: Latest version of the app.
-- CREATED: How long ago the app release was created.`
+- AGE: How long ago the app release was created.`
 
 	examples = `  # List all public app catalogs
   kubectl gs get catalogs
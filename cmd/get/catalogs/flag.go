@@ -1,16 +1,26 @@
 package catalogs
 
 import (
+	"github.com/giantswarm/microerror"
 	"github.com/spf13/cobra"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"strings"
+
+	"github.com/giantswarm/kubectl-gs/pkg/output"
 )
 
 const (
 	flagAllNamespaces = "all-namespaces"
+	flagChunkSize     = "chunk-size"
+	flagSortBy        = "sort-by"
+	flagTimestamps    = "timestamps"
 )
 
 type flag struct {
 	AllNamespaces bool
+	ChunkSize     int64
+	SortBy        string
+	Timestamps    string
 
 	config genericclioptions.RESTClientGetter
 	print  *genericclioptions.PrintFlags
@@ -18,6 +28,9 @@ type flag struct {
 
 func (f *flag) Init(cmd *cobra.Command) {
 	cmd.Flags().BoolVarP(&f.AllNamespaces, flagAllNamespaces, "A", false, "If present, list the requested object(s) across all namespaces. Namespace in current context is ignored even if specified with --namespace.")
+	cmd.Flags().Int64Var(&f.ChunkSize, flagChunkSize, 500, "Return large lists in chunks rather than all at once. Pass 0 to disable.")
+	cmd.Flags().StringVar(&f.SortBy, flagSortBy, "", "If non-empty, sort table output using this column name or JSONPath expression, e.g. 'age' or '.metadata.creationTimestamp'.")
+	cmd.Flags().StringVar(&f.Timestamps, flagTimestamps, output.TimestampsRelative, "Print timestamps in table output as the time elapsed since then (relative) or as dates in UTC (absolute).")
 
 	f.config = genericclioptions.NewConfigFlags(true)
 	f.print = genericclioptions.NewPrintFlags("")
@@ -26,8 +39,16 @@ func (f *flag) Init(cmd *cobra.Command) {
 	// to be able to override kubectl-specific ones.
 	f.config.(*genericclioptions.ConfigFlags).AddFlags(cmd.Flags())
 	f.print.AddFlags(cmd)
+	output.AddTableFormatsUsage(cmd)
 }
 
 func (f *flag) Validate() error {
+	if f.ChunkSize < 0 {
+		return microerror.Maskf(invalidFlagError, "--%s must not be negative", flagChunkSize)
+	}
+	if !output.IsTimestampFormat(f.Timestamps) {
+		return microerror.Maskf(invalidFlagError, "--%s must be one of %s", flagTimestamps, strings.Join(output.TimestampFormats, ", "))
+	}
+
 	return nil
 }
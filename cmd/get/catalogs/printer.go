@@ -4,15 +4,21 @@ import (
 	"fmt"
 
 	applicationv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/application/v1alpha1"
+	"github.com/giantswarm/apiextensions/v3/pkg/label"
 	"github.com/giantswarm/microerror"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/printers"
 
+	"github.com/giantswarm/kubectl-gs/internal/key"
 	catalogdata "github.com/giantswarm/kubectl-gs/pkg/data/domain/catalog"
 	"github.com/giantswarm/kubectl-gs/pkg/output"
 )
 
+const (
+	naValue = "n/a"
+)
+
 func (r *runner) printOutput(catalogResource catalogdata.Resource) error {
 	var (
 		err      error
@@ -21,10 +27,21 @@ func (r *runner) printOutput(catalogResource catalogdata.Resource) error {
 	)
 
 	switch {
-	case output.IsOutputDefault(r.flag.print.OutputFormat):
-		resource = getTable(catalogResource)
-		printOptions := printers.PrintOptions{}
-		printer = printers.NewTablePrinter(printOptions)
+	case output.IsOutputTable(r.flag.print.OutputFormat):
+		tableOptions := output.TableOptions{
+			OutputFormat: r.flag.print.OutputFormat,
+			SortBy:       r.flag.SortBy,
+		}
+		if r.tablePrinter == nil {
+			r.tablePrinter = output.NewTablePrinter(r.stdout)
+		}
+		err = r.tablePrinter.PrintTable(getTable(catalogResource, r.flag.Timestamps), tableOptions)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+
 	case output.IsOutputName(r.flag.print.OutputFormat):
 		resource = catalogResource.Object()
 		err = output.PrintResourceNames(r.stdout, resource)
@@ -50,30 +67,56 @@ func (r *runner) printOutput(catalogResource catalogdata.Resource) error {
 	return nil
 }
 
-func (r *runner) printNoMatchOutput() {
-	fmt.Fprintf(r.stdout, "No Catalog CRD found.\n")
-	fmt.Fprintf(r.stdout, "Please check you are accessing a management cluster\n\n")
+func (r *runner) printNoMatchOutput() error {
+	fmt.Fprintf(r.stderr, "No Catalog CRD found.\n")
+	fmt.Fprintf(r.stderr, "Please check you are accessing a management cluster\n\n")
+
+	err := output.PrintEmptyList(r.stdout, r.flag.print)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
 }
 
-func (r *runner) printNoResourcesOutput() {
-	fmt.Fprintf(r.stdout, "No catalogs found.\n")
-	fmt.Fprintf(r.stdout, "To create a catalog, please check\n\n")
-	fmt.Fprintf(r.stdout, "  kubectl gs template catalog --help\n")
+func (r *runner) printNoResourcesOutput() error {
+	fmt.Fprintf(r.stderr, "No catalogs found.\n")
+	fmt.Fprintf(r.stderr, "To create a catalog, please check\n\n")
+	fmt.Fprintf(r.stderr, "  kubectl gs template catalog --help\n")
+
+	err := output.PrintEmptyList(r.stdout, r.flag.print)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
 }
 
-func getAppCatalogEntryRow(ace applicationv1alpha1.AppCatalogEntry) metav1.TableRow {
+func getAppCatalogEntryRow(ace applicationv1alpha1.AppCatalogEntry, timestamps string) metav1.TableRow {
 	return metav1.TableRow{
 		Cells: []interface{}{
 			ace.Spec.Catalog.Name,
 			ace.Spec.AppName,
 			ace.Spec.AppVersion,
 			ace.Spec.Version,
-			output.TranslateTimestampSince(ace.CreationTimestamp),
+			output.NewTimestamp(ace.CreationTimestamp, timestamps),
+			output.NewTimestamp(getDateUpdated(ace), timestamps),
+		},
+		Object: runtime.RawExtension{
+			Object: ace.DeepCopy(),
 		},
 	}
 }
 
-func getCatalogEntryTable(catalogResource *catalogdata.Catalog) *metav1.Table {
+func getDateUpdated(ace applicationv1alpha1.AppCatalogEntry) metav1.Time {
+	if ace.Spec.DateUpdated == nil {
+		return metav1.Time{}
+	}
+
+	return *ace.Spec.DateUpdated
+}
+
+func getCatalogEntryTable(catalogResource *catalogdata.Catalog, timestamps string) *metav1.Table {
 	// Creating a custom table resource.
 	table := &metav1.Table{}
 
@@ -82,17 +125,18 @@ func getCatalogEntryTable(catalogResource *catalogdata.Catalog) *metav1.Table {
 		{Name: "App Name", Type: "string"},
 		{Name: "App Version", Type: "string"},
 		{Name: "Version", Type: "string"},
-		{Name: "Created", Type: "string"},
+		{Name: "Age", Type: "string"},
+		{Name: "Updated", Type: "string", Priority: 1},
 	}
 
 	for _, ace := range catalogResource.Entries.Items {
-		table.Rows = append(table.Rows, getAppCatalogEntryRow(ace))
+		table.Rows = append(table.Rows, getAppCatalogEntryRow(ace, timestamps))
 	}
 
 	return table
 }
 
-func getCatalogRow(a catalogdata.Catalog) metav1.TableRow {
+func getCatalogRow(a catalogdata.Catalog, timestamps string) metav1.TableRow {
 	if a.CR == nil {
 		return metav1.TableRow{}
 	}
@@ -102,7 +146,11 @@ func getCatalogRow(a catalogdata.Catalog) metav1.TableRow {
 			a.CR.Name,
 			a.CR.Namespace,
 			a.CR.Spec.Storage.URL,
-			output.TranslateTimestampSince(a.CR.CreationTimestamp),
+			output.NewTimestamp(a.CR.CreationTimestamp, timestamps),
+			a.CR.Labels[label.CatalogType],
+			a.CR.Spec.Description,
+			formatOptional(key.Organization(a.CR)),
+			formatOptional(a.CreatedBy),
 		},
 		Object: runtime.RawExtension{
 			Object: a.CR,
@@ -110,7 +158,7 @@ func getCatalogRow(a catalogdata.Catalog) metav1.TableRow {
 	}
 }
 
-func getCatalogTable(catalogResource catalogdata.Resource) *metav1.Table {
+func getCatalogTable(catalogResource catalogdata.Resource, timestamps string) *metav1.Table {
 	// Creating a custom table resource.
 	table := &metav1.Table{}
 
@@ -118,28 +166,40 @@ func getCatalogTable(catalogResource catalogdata.Resource) *metav1.Table {
 		{Name: "Name", Type: "string"},
 		{Name: "Namespace", Type: "string"},
 		{Name: "Catalog URL", Type: "string"},
-		{Name: "Created", Type: "string", Format: "date-time"},
+		{Name: "Age", Type: "string"},
+		{Name: "Type", Type: "string", Priority: 1},
+		{Name: "Description", Type: "string", Priority: 1},
+		{Name: "Organization", Type: "string", Priority: 1},
+		{Name: "Created By", Type: "string", Priority: 1},
 	}
 
 	switch c := catalogResource.(type) {
 	case *catalogdata.Catalog:
-		table.Rows = append(table.Rows, getCatalogRow(*c))
+		table.Rows = append(table.Rows, getCatalogRow(*c, timestamps))
 	case *catalogdata.Collection:
 		for _, catalogItem := range c.Items {
-			table.Rows = append(table.Rows, getCatalogRow(catalogItem))
+			table.Rows = append(table.Rows, getCatalogRow(catalogItem, timestamps))
 		}
 	}
 
 	return table
 }
 
-func getTable(catalogResource catalogdata.Resource) *metav1.Table {
+func getTable(catalogResource catalogdata.Resource, timestamps string) *metav1.Table {
 	switch c := catalogResource.(type) {
 	case *catalogdata.Catalog:
-		return getCatalogEntryTable(c)
+		return getCatalogEntryTable(c, timestamps)
 	case *catalogdata.Collection:
-		return getCatalogTable(c)
+		return getCatalogTable(c, timestamps)
 	}
 
 	return nil
 }
+
+func formatOptional(value string) string {
+	if len(value) < 1 {
+		return naValue
+	}
+
+	return value
+}
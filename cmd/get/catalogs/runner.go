@@ -25,6 +25,10 @@ type runner struct {
 
 	service catalogdata.Interface
 
+	// tablePrinter prints all the tables of the command, so that further chunks
+	// only add new rows, aligned with the headers.
+	tablePrinter *output.TablePrinter
+
 	stdout io.Writer
 	stderr io.Writer
 }
@@ -84,27 +88,53 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 		}
 	}
 
-	var catalogResource catalogdata.Resource
-	{
+	options := catalogdata.GetOptions{
+		AllNamespaces: r.flag.AllNamespaces,
+		ChunkSize:     r.flag.ChunkSize,
+		Name:          name,
+		Namespace:     namespace,
+		LabelSelector: labelSelector,
+	}
+	err = r.printCatalogs(ctx, options)
+	if catalogdata.IsNotFound(err) {
+		return microerror.Maskf(notFoundError, fmt.Sprintf("A catalog '%s/%s' cannot be found.\n", options.Namespace, options.Name))
+	} else if catalogdata.IsNoMatch(err) {
+		err = r.printNoMatchOutput()
+		if err != nil {
+			return microerror.Mask(err)
+		}
 
-		options := catalogdata.GetOptions{
-			AllNamespaces: r.flag.AllNamespaces,
-			Name:          name,
-			Namespace:     namespace,
-			LabelSelector: labelSelector,
+		return nil
+	} else if catalogdata.IsNoResources(err) {
+		err = r.printNoResourcesOutput()
+		if err != nil {
+			return microerror.Mask(err)
 		}
-		catalogResource, err = r.service.Get(ctx, options)
-		if catalogdata.IsNotFound(err) {
-			return microerror.Maskf(notFoundError, fmt.Sprintf("A catalog '%s/%s' cannot be found.\n", options.Namespace, options.Name))
-		} else if catalogdata.IsNoMatch(err) {
-			r.printNoMatchOutput()
-			return nil
-		} else if catalogdata.IsNoResources(err) && output.IsOutputDefault(r.flag.print.OutputFormat) {
-			r.printNoResourcesOutput()
-			return nil
-		} else if err != nil {
+
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// printCatalogs prints the catalogs matching the given options. Tables
+// that don't have to be sorted are printed chunk by chunk, as soon as
+// the catalogs are listed.
+func (r *runner) printCatalogs(ctx context.Context, options catalogdata.GetOptions) error {
+	if output.IsOutputTable(r.flag.print.OutputFormat) && len(r.flag.SortBy) < 1 {
+		err := r.service.Stream(ctx, options, r.printOutput)
+		if err != nil {
 			return microerror.Mask(err)
 		}
+
+		return nil
+	}
+
+	catalogResource, err := r.service.Get(ctx, options)
+	if err != nil {
+		return microerror.Mask(err)
 	}
 
 	err = r.printOutput(catalogResource)
@@ -0,0 +1,190 @@
+package catalogs
+
+import (
+	"bytes"
+	"context"
+	goflag "flag"
+	"testing"
+	"time"
+
+	applicationv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/application/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	catalogdata "github.com/giantswarm/kubectl-gs/pkg/data/domain/catalog"
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+	"github.com/giantswarm/kubectl-gs/test/goldenfile"
+	"github.com/giantswarm/kubectl-gs/test/kubeconfig"
+)
+
+var update = goflag.Bool("update", false, "update .golden reference test files")
+
+// Test_run uses golden files.
+//
+//  go test ./cmd/get/catalogs -run Test_run -update
+//
+func Test_run(t *testing.T) {
+	storage := []runtime.Object{
+		newCatalog("giantswarm", "default", "https://giantswarm.github.io/giantswarm-catalog/"),
+		newCatalog("control-plane", "default", "https://giantswarm.github.io/control-plane-catalog/"),
+	}
+
+	testCases := []struct {
+		name                  string
+		storage               []runtime.Object
+		args                  []string
+		outputType            string
+		expectedGoldenFile    string
+		expectedErrGoldenFile string
+		errorMatcher          func(error) bool
+	}{
+		{
+			name:               "case 0: get catalogs",
+			storage:            storage,
+			expectedGoldenFile: "run_get_catalogs.golden",
+		},
+		{
+			name:                  "case 1: get catalogs, with empty storage",
+			storage:               nil,
+			expectedErrGoldenFile: "run_get_catalogs_empty_storage.golden",
+		},
+		{
+			name:                  "case 2: get catalogs, with empty storage, and JSON output",
+			storage:               nil,
+			outputType:            output.TypeJSON,
+			expectedGoldenFile:    "run_get_catalogs_empty_storage_json_output.golden",
+			expectedErrGoldenFile: "run_get_catalogs_empty_storage.golden",
+		},
+		{
+			name:                  "case 3: get catalog by name, without entries, and YAML output",
+			storage:               storage,
+			args:                  []string{"giantswarm"},
+			outputType:            output.TypeYAML,
+			expectedGoldenFile:    "run_get_catalog_by_name_yaml_output.golden",
+			expectedErrGoldenFile: "run_get_catalogs_empty_storage.golden",
+		},
+		{
+			name:         "case 4: get catalog by name, not found",
+			storage:      storage,
+			args:         []string{"unknown"},
+			outputType:   output.TypeJSON,
+			errorMatcher: IsNotFound,
+		},
+		{
+			name:               "case 5: get catalogs, with wide output, created by the first field manager",
+			storage:            []runtime.Object{withManagedFields(newCatalog("giantswarm", "default", "https://giantswarm.github.io/giantswarm-catalog/"))},
+			outputType:         output.TypeWide,
+			expectedGoldenFile: "run_get_catalogs_wide_output.golden",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			outputType := output.TypeDefault
+			if len(tc.outputType) > 0 {
+				outputType = tc.outputType
+			}
+
+			fakeKubeConfig := kubeconfig.CreateFakeKubeConfig()
+			flag := &flag{
+				print:      genericclioptions.NewPrintFlags("").WithDefaultOutput(outputType),
+				config:     genericclioptions.NewTestConfigFlags().WithClientConfig(fakeKubeConfig),
+				Timestamps: output.TimestampsAbsolute,
+			}
+			out := new(bytes.Buffer)
+			errOut := new(bytes.Buffer)
+			runner := &runner{
+				service: catalogdata.NewFakeService(tc.storage),
+				flag:    flag,
+				stdout:  out,
+				stderr:  errOut,
+			}
+
+			err := runner.run(ctx, nil, tc.args)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			assertGoldenFile(t, tc.expectedGoldenFile, out.Bytes())
+			assertGoldenFile(t, tc.expectedErrGoldenFile, errOut.Bytes())
+		})
+	}
+}
+
+func assertGoldenFile(t *testing.T, name string, actual []byte) {
+	var err error
+
+	if len(name) < 1 {
+		if len(actual) > 0 {
+			t.Fatalf("unexpected output, got:\n %s", actual)
+		}
+
+		return
+	}
+
+	var expectedResult []byte
+	{
+		gf := goldenfile.New("testdata", name)
+		if *update {
+			err = gf.Update(actual)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			expectedResult = actual
+		} else {
+			expectedResult, err = gf.Read()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+		}
+	}
+
+	diff := cmp.Diff(string(expectedResult), string(actual))
+	if diff != "" {
+		t.Fatalf("value not expected, got:\n %s", diff)
+	}
+}
+
+func newCatalog(name, namespace, url string) *applicationv1alpha1.Catalog {
+	return &applicationv1alpha1.Catalog{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "application.giantswarm.io/v1alpha1",
+			Kind:       "Catalog",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: applicationv1alpha1.CatalogSpec{
+			Title: name,
+			Storage: applicationv1alpha1.CatalogSpecStorage{
+				Type: "helm",
+				URL:  url,
+			},
+		},
+	}
+}
+
+// withManagedFields sets the managed fields of a catalog, as written by a
+// client creating it and a controller updating it later on.
+func withManagedFields(catalog *applicationv1alpha1.Catalog) *applicationv1alpha1.Catalog {
+	created := metav1.NewTime(time.Date(2021, 1, 2, 15, 4, 32, 0, time.UTC))
+	updated := metav1.NewTime(created.Add(time.Hour))
+	catalog.ManagedFields = []metav1.ManagedFieldsEntry{
+		{Manager: "flux", Operation: metav1.ManagedFieldsOperationUpdate, Time: &updated},
+		{Manager: "kubectl-client-side-apply", Operation: metav1.ManagedFieldsOperationUpdate, Time: &created},
+	}
+
+	return catalog
+}
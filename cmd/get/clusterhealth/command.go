@@ -0,0 +1,113 @@
+package clusterhealth
+
+import (
+	"io"
+	"os"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/giantswarm/kubectl-gs/pkg/middleware"
+	"github.com/giantswarm/kubectl-gs/pkg/middleware/renewtoken"
+)
+
+const (
+	name  = "cluster-health [cluster-name]"
+	alias = "health"
+
+	shortDescription = "Display a health report of one or many clusters"
+	longDescription  = `Display a health report of one or many clusters
+
+Rolls up the status of every workload cluster from its conditions, the
+readiness of its control plane, the ready nodes of its node pools, its
+failing apps and its stuck machines.
+
+The command exits with a non-zero code if any of the clusters is not
+healthy, so that it can be used in monitoring scripts.
+
+Output columns:
+
+- NAME: Name of the cluster.
+- STATUS: Rollup status of the cluster. One of Healthy, Degraded (the
+  cluster is working, but something needs attention, e.g. an app is
+  failing) or Unhealthy (e.g. the control plane or a whole node pool is
+  not ready).
+- CLUSTER: Latest condition of the cluster.
+- CONTROL PLANE: Whether the control plane is ready, n/a if it can't
+  be determined.
+- NODE POOLS: Number of node pools having all their nodes ready, and
+  number of node pools.
+- NODES: Number of ready nodes, and number of desired nodes.
+- FAILING APPS: Number of apps whose release is not deployed.
+- STUCK MACHINES: Number of machines that have been provisioning or
+  deleting for longer than --stuck-threshold.
+- REASONS: Why the cluster is not healthy.`
+
+	examples = `  # Check the health of all clusters in the current namespace
+  kubectl gs get cluster-health
+
+  # Check the health of one cluster
+  kubectl gs get cluster-health f83ir
+
+  # Check all clusters, from a monitoring script
+  kubectl gs get cluster-health -A -o json > report.json || alert`
+)
+
+type Config struct {
+	Logger     micrologger.Logger
+	FileSystem afero.Fs
+
+	K8sConfigAccess clientcmd.ConfigAccess
+
+	Stderr io.Writer
+	Stdout io.Writer
+}
+
+func New(config Config) (*cobra.Command, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.FileSystem == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.FileSystem must not be empty", config)
+	}
+	if config.K8sConfigAccess == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sConfigAccess must not be empty", config)
+	}
+	if config.Stderr == nil {
+		config.Stderr = os.Stderr
+	}
+	if config.Stdout == nil {
+		config.Stdout = os.Stdout
+	}
+
+	f := &flag{}
+
+	r := &runner{
+		flag:   f,
+		logger: config.Logger,
+		fs:     config.FileSystem,
+
+		stderr: config.Stderr,
+		stdout: config.Stdout,
+	}
+
+	c := &cobra.Command{
+		Use:     name,
+		Short:   shortDescription,
+		Long:    longDescription,
+		Example: examples,
+		Aliases: []string{alias},
+		Args:    cobra.MaximumNArgs(1),
+		RunE:    r.Run,
+		PreRunE: middleware.Compose(
+			renewtoken.Middleware(config.K8sConfigAccess),
+		),
+	}
+
+	f.Init(c)
+
+	return c, nil
+}
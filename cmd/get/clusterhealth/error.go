@@ -0,0 +1,41 @@
+package clusterhealth
+
+import "github.com/giantswarm/microerror"
+
+var invalidConfigError = &microerror.Error{
+	Kind: "invalidConfigError",
+}
+
+// IsInvalidConfig asserts invalidConfigError.
+func IsInvalidConfig(err error) bool {
+	return microerror.Cause(err) == invalidConfigError
+}
+
+var invalidFlagError = &microerror.Error{
+	Kind: "invalidFlagError",
+}
+
+// IsInvalidFlag asserts invalidFlagError.
+func IsInvalidFlag(err error) bool {
+	return microerror.Cause(err) == invalidFlagError
+}
+
+var notFoundError = &microerror.Error{
+	Kind: "notFoundError",
+}
+
+// IsNotFound asserts notFoundError.
+func IsNotFound(err error) bool {
+	return microerror.Cause(err) == notFoundError
+}
+
+// notHealthyError is returned after printing the report when any of the
+// clusters is not healthy, so that the command exits with a non-zero code.
+var notHealthyError = &microerror.Error{
+	Kind: "notHealthyError",
+}
+
+// IsNotHealthy asserts notHealthyError.
+func IsNotHealthy(err error) bool {
+	return microerror.Cause(err) == notHealthyError
+}
@@ -0,0 +1,57 @@
+package clusterhealth
+
+import (
+	"time"
+
+	"github.com/giantswarm/microerror"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+)
+
+const (
+	flagAllNamespaces  = "all-namespaces"
+	flagSortBy         = "sort-by"
+	flagStuckThreshold = "stuck-threshold"
+)
+
+const (
+	defaultStuckThreshold = 30 * time.Minute
+)
+
+type flag struct {
+	AllNamespaces  bool
+	SortBy         string
+	StuckThreshold time.Duration
+
+	config genericclioptions.RESTClientGetter
+	print  *genericclioptions.PrintFlags
+}
+
+func (f *flag) Init(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&f.AllNamespaces, flagAllNamespaces, "A", false, "If present, check the clusters across all namespaces. Namespace in current context is ignored even if specified with --namespace.")
+	cmd.Flags().StringVar(&f.SortBy, flagSortBy, "", "If non-empty, sort table output using this column name, e.g. 'status'.")
+	cmd.Flags().DurationVar(&f.StuckThreshold, flagStuckThreshold, defaultStuckThreshold, "Consider machines that have been provisioning or deleting for longer than this as stuck.")
+
+	f.config = genericclioptions.NewConfigFlags(true)
+	f.print = genericclioptions.NewPrintFlags("")
+
+	// Merging current command flags and config flags,
+	// to be able to override kubectl-specific ones.
+	f.config.(*genericclioptions.ConfigFlags).AddFlags(cmd.Flags())
+	f.print.AddFlags(cmd)
+	output.AddTableFormatsUsage(cmd)
+}
+
+func (f *flag) Validate() error {
+	outputFormat := f.print.OutputFormat
+	if !output.IsOutputTable(outputFormat) && *outputFormat != output.TypeJSON && *outputFormat != output.TypeYAML {
+		return microerror.Maskf(invalidFlagError, "--output must be one of %s, %s or %s", output.TypeWide, output.TypeJSON, output.TypeYAML)
+	}
+	if f.StuckThreshold <= 0 {
+		return microerror.Maskf(invalidFlagError, "--%s must be a positive duration", flagStuckThreshold)
+	}
+
+	return nil
+}
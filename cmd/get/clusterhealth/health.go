@@ -0,0 +1,300 @@
+package clusterhealth
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/kubectl-gs/internal/label"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/app"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/cluster"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/machine"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/nodepool"
+)
+
+const (
+	conditionNotReady = "NotReady"
+	conditionReady    = "Ready"
+)
+
+// clusterResources are a cluster and the resources that belong to it.
+type clusterResources struct {
+	Cluster   cluster.Cluster
+	Nodepools []nodepool.Nodepool
+	Apps      []app.App
+	Machines  []machine.Machine
+}
+
+// getClusterReport computes the health of a cluster from the
+// health of its components.
+func getClusterReport(res clusterResources, stuckThreshold time.Duration, now time.Time) clusterReport {
+	c := res.Cluster.Cluster
+
+	cr := clusterReport{
+		Name:         c.GetName(),
+		Namespace:    c.GetNamespace(),
+		Organization: clusterLabel(res.Cluster, label.Organization),
+		Release:      clusterLabel(res.Cluster, label.ReleaseVersion),
+	}
+
+	statuses := []string{}
+	addReason := func(status, reason string) {
+		statuses = append(statuses, status)
+		if len(reason) > 0 {
+			cr.Reasons = append(cr.Reasons, reason)
+		}
+	}
+
+	var reason string
+	cr.Cluster, reason = getClusterComponent(res.Cluster)
+	addReason(cr.Cluster.Status, reason)
+
+	cr.ControlPlane, reason = getControlPlaneComponent(res)
+	addReason(cr.ControlPlane.Status, reason)
+
+	for _, np := range res.Nodepools {
+		npr := getNodepoolReport(np)
+		cr.Nodepools = append(cr.Nodepools, npr)
+
+		switch npr.Status {
+		case statusUnhealthy:
+			addReason(npr.Status, fmt.Sprintf("node pool %s has no ready nodes", npr.Name))
+		case statusDegraded:
+			addReason(npr.Status, fmt.Sprintf("node pool %s has %d of %d nodes ready", npr.Name, npr.ReadyNodes, npr.DesiredNodes))
+		}
+	}
+
+	for _, a := range res.Apps {
+		if !app.IsFailing(a) {
+			continue
+		}
+
+		cr.FailingApps = append(cr.FailingApps, a.CR.GetName())
+		addReason(statusDegraded, formatFailingApp(a))
+	}
+
+	for _, m := range res.Machines {
+		if !machine.IsStuck(m, stuckThreshold, now) {
+			continue
+		}
+
+		cr.StuckMachines = append(cr.StuckMachines, m.Machine.GetName())
+		addReason(statusDegraded, fmt.Sprintf("machine %s is stuck in phase %s", m.Machine.GetName(), m.Machine.Status.Phase))
+	}
+
+	cr.Status = worstStatus(statuses...)
+
+	return cr
+}
+
+// getClusterComponent returns the health of the cluster resource itself,
+// from the conditions of the Giant Swarm cluster resource on AWS, and from
+// the Ready condition or the phase of the Cluster API cluster otherwise.
+func getClusterComponent(c cluster.Cluster) (componentReport, string) {
+	switch {
+	case c.Cluster.DeletionTimestamp != nil:
+		return componentReport{Status: statusDegraded, Condition: "Deleting"}, "cluster is being deleted"
+
+	case c.Cluster.Status.FailureMessage != nil:
+		return componentReport{Status: statusUnhealthy, Condition: "Failed"}, fmt.Sprintf("cluster failed: %s", *c.Cluster.Status.FailureMessage)
+
+	case c.AWSCluster != nil:
+		conditions := c.AWSCluster.Status.Cluster.Conditions
+		if len(conditions) < 1 {
+			return componentReport{Status: statusDegraded}, "cluster has not reported any condition"
+		}
+
+		condition := conditions[0].Condition
+		switch condition {
+		case infrastructurev1alpha3.ClusterStatusConditionCreated, infrastructurev1alpha3.ClusterStatusConditionUpdated:
+			return componentReport{Status: statusHealthy, Condition: condition}, ""
+		default:
+			return componentReport{Status: statusDegraded, Condition: condition}, fmt.Sprintf("cluster is %s", strings.ToLower(condition))
+		}
+	}
+
+	ready := getCondition(c.Cluster.GetConditions(), capiv1alpha3.ReadyCondition)
+	if ready != nil {
+		if ready.Status == corev1.ConditionTrue {
+			return componentReport{Status: statusHealthy, Condition: conditionReady}, ""
+		}
+
+		status := statusDegraded
+		if ready.Severity == capiv1alpha3.ConditionSeverityError {
+			status = statusUnhealthy
+		}
+
+		return componentReport{Status: status, Condition: conditionNotReady}, "cluster is not ready" + formatConditionReason(ready)
+	}
+
+	phase := c.Cluster.Status.Phase
+	switch phase {
+	case "":
+		return componentReport{Status: statusUnknown}, ""
+	case string(capiv1alpha3.ClusterPhaseProvisioned):
+		return componentReport{Status: statusHealthy, Condition: phase}, ""
+	default:
+		return componentReport{Status: statusDegraded, Condition: phase}, fmt.Sprintf("cluster is %s", strings.ToLower(phase))
+	}
+}
+
+// getControlPlaneComponent returns the readiness of the control plane, as
+// reported on the Cluster API cluster. Clusters not reporting it, e.g. Giant
+// Swarm AWS clusters, are checked by the nodes of their control plane
+// machines, if they have any.
+func getControlPlaneComponent(res clusterResources) (componentReport, string) {
+	c := res.Cluster.Cluster
+
+	ready := getCondition(c.GetConditions(), capiv1alpha3.ControlPlaneReadyCondition)
+	switch {
+	case ready != nil && ready.Status == corev1.ConditionTrue:
+		return componentReport{Status: statusHealthy, Condition: conditionReady}, ""
+	case ready != nil:
+		return componentReport{Status: statusUnhealthy, Condition: conditionNotReady}, "control plane is not ready" + formatConditionReason(ready)
+	case c.Spec.ControlPlaneRef != nil && c.Status.ControlPlaneReady:
+		return componentReport{Status: statusHealthy, Condition: conditionReady}, ""
+	case c.Spec.ControlPlaneRef != nil:
+		return componentReport{Status: statusUnhealthy, Condition: conditionNotReady}, "control plane is not ready"
+	}
+
+	var total, readyNodes int
+	for _, m := range res.Machines {
+		if _, ok := m.Machine.Labels[capiv1alpha3.MachineControlPlaneLabelName]; !ok {
+			continue
+		}
+
+		total++
+		if m.Machine.Status.NodeRef != nil && m.Machine.Status.Phase == string(capiv1alpha3.MachinePhaseRunning) {
+			readyNodes++
+		}
+	}
+
+	condition := fmt.Sprintf("%d/%d", readyNodes, total)
+	switch {
+	case total == 0:
+		return componentReport{Status: statusUnknown}, ""
+	case readyNodes == total:
+		return componentReport{Status: statusHealthy, Condition: condition}, ""
+	case readyNodes == 0:
+		return componentReport{Status: statusUnhealthy, Condition: condition}, "control plane has no ready nodes"
+	default:
+		return componentReport{Status: statusDegraded, Condition: condition}, fmt.Sprintf("control plane has %d of %d nodes ready", readyNodes, total)
+	}
+}
+
+// getNodepoolReport compares the ready nodes of a node pool to its
+// desired nodes. A node pool without any ready node is unhealthy.
+func getNodepoolReport(np nodepool.Nodepool) nodepoolReport {
+	var npr nodepoolReport
+	switch {
+	case np.MachineDeployment != nil:
+		npr.Name = np.MachineDeployment.GetName()
+		npr.DesiredNodes = desiredReplicas(np.MachineDeployment.Spec.Replicas, np.MachineDeployment.Status.Replicas)
+		npr.ReadyNodes = np.MachineDeployment.Status.ReadyReplicas
+	case np.MachinePool != nil:
+		npr.Name = np.MachinePool.GetName()
+		npr.DesiredNodes = desiredReplicas(np.MachinePool.Spec.Replicas, np.MachinePool.Status.Replicas)
+		npr.ReadyNodes = np.MachinePool.Status.ReadyReplicas
+	}
+
+	switch {
+	case npr.ReadyNodes >= npr.DesiredNodes:
+		npr.Status = statusHealthy
+	case npr.ReadyNodes == 0:
+		npr.Status = statusUnhealthy
+	default:
+		npr.Status = statusDegraded
+	}
+
+	return npr
+}
+
+// nodepoolBelongsTo returns true if the given node pool is one of
+// the node pools of the given cluster.
+func nodepoolBelongsTo(np nodepool.Nodepool, c cluster.Cluster) bool {
+	var meta metav1.ObjectMeta
+	switch {
+	case np.MachineDeployment != nil:
+		meta = np.MachineDeployment.ObjectMeta
+	case np.MachinePool != nil:
+		meta = np.MachinePool.ObjectMeta
+	default:
+		return false
+	}
+
+	clusterName := meta.Labels[capiv1alpha3.ClusterLabelName]
+	if len(clusterName) < 1 {
+		clusterName = meta.Labels[label.Cluster]
+	}
+
+	return meta.Namespace == c.Cluster.Namespace && clusterName == c.Cluster.Name
+}
+
+// machineBelongsTo returns true if the given machine is one of the
+// machines of the given cluster.
+func machineBelongsTo(m machine.Machine, c cluster.Cluster) bool {
+	return m.Machine.Namespace == c.Cluster.Namespace &&
+		m.Machine.Labels[capiv1alpha3.ClusterLabelName] == c.Cluster.Name
+}
+
+// appBelongsTo returns true if the given app is deployed to the given
+// cluster. Apps of a cluster are either labelled with the name of the
+// cluster, or placed in the namespace named after it.
+func appBelongsTo(a app.App, c cluster.Cluster) bool {
+	name := c.Cluster.GetName()
+
+	return a.CR.GetNamespace() == name ||
+		a.CR.Labels[label.Cluster] == name ||
+		a.CR.Labels[capiv1alpha3.ClusterLabelName] == name
+}
+
+func clusterLabel(c cluster.Cluster, key string) string {
+	if value := c.Cluster.Labels[key]; len(value) > 0 {
+		return value
+	}
+	if c.AWSCluster != nil {
+		return c.AWSCluster.Labels[key]
+	}
+
+	return ""
+}
+
+func desiredReplicas(spec *int32, status int32) int32 {
+	if spec != nil {
+		return *spec
+	}
+
+	return status
+}
+
+func getCondition(conditions capiv1alpha3.Conditions, t capiv1alpha3.ConditionType) *capiv1alpha3.Condition {
+	for i := range conditions {
+		if conditions[i].Type == t {
+			return &conditions[i]
+		}
+	}
+
+	return nil
+}
+
+func formatConditionReason(c *capiv1alpha3.Condition) string {
+	if len(c.Reason) < 1 {
+		return ""
+	}
+
+	return ": " + c.Reason
+}
+
+func formatFailingApp(a app.App) string {
+	status := a.CR.Status.Release.Status
+	if len(status) < 1 {
+		return fmt.Sprintf("app %s is not deployed", a.CR.GetName())
+	}
+
+	return fmt.Sprintf("app %s has release status %s", a.CR.GetName(), status)
+}
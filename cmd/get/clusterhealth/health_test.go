@@ -0,0 +1,217 @@
+package clusterhealth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capiexpv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/cluster"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/machine"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/nodepool"
+)
+
+func Test_getClusterReport(t *testing.T) {
+	now := time.Now()
+	failureMessage := "cannot create the load balancer"
+
+	testCases := []struct {
+		name            string
+		resources       clusterResources
+		expectedStatus  string
+		expectedReasons []string
+	}{
+		{
+			name: "case 0: ready cluster and control plane",
+			resources: clusterResources{
+				Cluster: newAzureCluster(func(c *capiv1alpha3.Cluster) {
+					c.Status.Conditions = capiv1alpha3.Conditions{
+						{Type: capiv1alpha3.ReadyCondition, Status: corev1.ConditionTrue},
+						{Type: capiv1alpha3.ControlPlaneReadyCondition, Status: corev1.ConditionTrue},
+					}
+				}),
+				Nodepools: []nodepool.Nodepool{
+					newMachinePool("np001", 3, 3),
+				},
+			},
+			expectedStatus: statusHealthy,
+		},
+		{
+			name: "case 1: cluster not ready, with warning severity",
+			resources: clusterResources{
+				Cluster: newAzureCluster(func(c *capiv1alpha3.Cluster) {
+					c.Status.Conditions = capiv1alpha3.Conditions{
+						{Type: capiv1alpha3.ReadyCondition, Status: corev1.ConditionFalse, Severity: capiv1alpha3.ConditionSeverityWarning, Reason: "ScalingUp"},
+					}
+				}),
+			},
+			expectedStatus:  statusDegraded,
+			expectedReasons: []string{"cluster is not ready: ScalingUp"},
+		},
+		{
+			name: "case 2: cluster not ready, with error severity",
+			resources: clusterResources{
+				Cluster: newAzureCluster(func(c *capiv1alpha3.Cluster) {
+					c.Status.Conditions = capiv1alpha3.Conditions{
+						{Type: capiv1alpha3.ReadyCondition, Status: corev1.ConditionFalse, Severity: capiv1alpha3.ConditionSeverityError, Reason: "VNetFailed"},
+					}
+				}),
+			},
+			expectedStatus:  statusUnhealthy,
+			expectedReasons: []string{"cluster is not ready: VNetFailed"},
+		},
+		{
+			name: "case 3: failed cluster",
+			resources: clusterResources{
+				Cluster: newAzureCluster(func(c *capiv1alpha3.Cluster) {
+					c.Status.FailureMessage = &failureMessage
+				}),
+			},
+			expectedStatus:  statusUnhealthy,
+			expectedReasons: []string{"cluster failed: cannot create the load balancer"},
+		},
+		{
+			name: "case 4: cluster being deleted",
+			resources: clusterResources{
+				Cluster: newAzureCluster(func(c *capiv1alpha3.Cluster) {
+					deletionTimestamp := metav1.NewTime(now)
+					c.DeletionTimestamp = &deletionTimestamp
+				}),
+			},
+			expectedStatus:  statusDegraded,
+			expectedReasons: []string{"cluster is being deleted"},
+		},
+		{
+			name: "case 5: cluster provisioning, by its phase",
+			resources: clusterResources{
+				Cluster: newAzureCluster(func(c *capiv1alpha3.Cluster) {
+					c.Status.Phase = string(capiv1alpha3.ClusterPhaseProvisioning)
+				}),
+			},
+			expectedStatus:  statusDegraded,
+			expectedReasons: []string{"cluster is provisioning"},
+		},
+		{
+			name: "case 6: control plane not ready, by the cluster status",
+			resources: clusterResources{
+				Cluster: newAzureCluster(func(c *capiv1alpha3.Cluster) {
+					c.Status.Phase = string(capiv1alpha3.ClusterPhaseProvisioned)
+					c.Spec.ControlPlaneRef = &corev1.ObjectReference{Kind: "KubeadmControlPlane", Name: "a2wax"}
+				}),
+			},
+			expectedStatus:  statusUnhealthy,
+			expectedReasons: []string{"control plane is not ready"},
+		},
+		{
+			name: "case 7: control plane missing a ready node, by its machines",
+			resources: clusterResources{
+				Cluster: newAzureCluster(func(c *capiv1alpha3.Cluster) {
+					c.Status.Phase = string(capiv1alpha3.ClusterPhaseProvisioned)
+				}),
+				Machines: []machine.Machine{
+					newControlPlaneMachine("a2wax-cp-0", true, now),
+					newControlPlaneMachine("a2wax-cp-1", true, now),
+					newControlPlaneMachine("a2wax-cp-2", false, now),
+				},
+			},
+			expectedStatus:  statusDegraded,
+			expectedReasons: []string{"control plane has 2 of 3 nodes ready"},
+		},
+		{
+			name: "case 8: node pool without desired nodes",
+			resources: clusterResources{
+				Cluster: newAzureCluster(func(c *capiv1alpha3.Cluster) {
+					c.Status.Phase = string(capiv1alpha3.ClusterPhaseProvisioned)
+				}),
+				Nodepools: []nodepool.Nodepool{
+					newMachinePool("np001", 0, 0),
+				},
+			},
+			expectedStatus: statusHealthy,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cr := getClusterReport(tc.resources, defaultStuckThreshold, now)
+
+			if cr.Status != tc.expectedStatus {
+				t.Fatalf("expected status %#q, got %#q", tc.expectedStatus, cr.Status)
+			}
+
+			diff := cmp.Diff(tc.expectedReasons, cr.Reasons)
+			if diff != "" {
+				t.Fatalf("reasons not expected, got:\n %s", diff)
+			}
+		})
+	}
+}
+
+func newAzureCluster(modify func(c *capiv1alpha3.Cluster)) cluster.Cluster {
+	c := &capiv1alpha3.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "a2wax",
+			Namespace: "org-giantswarm",
+		},
+	}
+	modify(c)
+
+	return cluster.Cluster{
+		Cluster: c,
+		AzureCluster: &capzv1alpha3.AzureCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "a2wax",
+				Namespace: "org-giantswarm",
+			},
+		},
+	}
+}
+
+func newMachinePool(name string, desired, ready int32) nodepool.Nodepool {
+	return nodepool.Nodepool{
+		MachinePool: &capiexpv1alpha3.MachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "org-giantswarm",
+			},
+			Spec: capiexpv1alpha3.MachinePoolSpec{
+				Replicas: &desired,
+			},
+			Status: capiexpv1alpha3.MachinePoolStatus{
+				Replicas:      desired,
+				ReadyReplicas: ready,
+			},
+		},
+	}
+}
+
+func newControlPlaneMachine(name string, ready bool, now time.Time) machine.Machine {
+	m := &capiv1alpha3.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "org-giantswarm",
+			CreationTimestamp: metav1.NewTime(now),
+			Labels: map[string]string{
+				capiv1alpha3.ClusterLabelName:             "a2wax",
+				capiv1alpha3.MachineControlPlaneLabelName: "",
+			},
+		},
+		Status: capiv1alpha3.MachineStatus{
+			Phase: string(capiv1alpha3.MachinePhaseProvisioning),
+		},
+	}
+
+	if ready {
+		m.Status.Phase = string(capiv1alpha3.MachinePhaseRunning)
+		m.Status.NodeRef = &corev1.ObjectReference{Kind: "Node", Name: name}
+	}
+
+	return machine.Machine{
+		Machine: m,
+	}
+}
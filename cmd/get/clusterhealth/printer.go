@@ -0,0 +1,168 @@
+package clusterhealth
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/giantswarm/microerror"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+)
+
+const (
+	naValue = "n/a"
+)
+
+func (r *runner) printOutput(rep report) error {
+	var err error
+
+	switch {
+	case output.IsOutputTable(r.flag.print.OutputFormat):
+		table := getTable(rep)
+
+		err = output.PrintTable(r.stdout, table, output.TableOptions{
+			OutputFormat:  r.flag.print.OutputFormat,
+			SortBy:        r.flag.SortBy,
+			WithNamespace: r.flag.AllNamespaces,
+		})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+	case *r.flag.print.OutputFormat == output.TypeJSON:
+		data, err := json.MarshalIndent(rep, "", "    ")
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		_, err = fmt.Fprintln(r.stdout, string(data))
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+	case *r.flag.print.OutputFormat == output.TypeYAML:
+		data, err := yaml.Marshal(rep)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		_, err = r.stdout.Write(data)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *runner) printNoResourcesOutput() error {
+	fmt.Fprintf(r.stderr, "No clusters found.\n")
+	fmt.Fprintf(r.stderr, "To create a cluster, please check\n\n")
+	fmt.Fprintf(r.stderr, "  kubectl gs template cluster --help\n")
+
+	if output.IsOutputTable(r.flag.print.OutputFormat) {
+		return nil
+	}
+
+	return r.printOutput(report{Clusters: []clusterReport{}})
+}
+
+func getTable(rep report) *metav1.Table {
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Organization", Type: "string", Priority: 1},
+			{Name: "Release", Type: "string", Priority: 1},
+			{Name: "Status", Type: "string"},
+			{Name: "Cluster", Type: "string"},
+			{Name: "Control Plane", Type: "string"},
+			{Name: "Node Pools", Type: "string"},
+			{Name: "Nodes", Type: "string"},
+			{Name: "Failing Apps", Type: "integer"},
+			{Name: "Stuck Machines", Type: "integer"},
+			{Name: "Reasons", Type: "string"},
+		},
+	}
+
+	for _, cr := range rep.Clusters {
+		var readyNodepools int
+		var desiredNodes, readyNodes int32
+		for _, np := range cr.Nodepools {
+			if np.Status == statusHealthy {
+				readyNodepools++
+			}
+			desiredNodes += np.DesiredNodes
+			readyNodes += np.ReadyNodes
+		}
+
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells: []interface{}{
+				cr.Name,
+				formatOptional(cr.Organization),
+				formatOptional(cr.Release),
+				formatStatus(cr.Status),
+				formatComponent(cr.Cluster),
+				formatComponent(cr.ControlPlane),
+				fmt.Sprintf("%d/%d", readyNodepools, len(cr.Nodepools)),
+				fmt.Sprintf("%d/%d", readyNodes, desiredNodes),
+				len(cr.FailingApps),
+				len(cr.StuckMachines),
+				formatReasons(cr.Reasons),
+			},
+			Object: runtime.RawExtension{
+				Object: &metav1.PartialObjectMetadata{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      cr.Name,
+						Namespace: cr.Namespace,
+					},
+				},
+			},
+		})
+	}
+
+	return table
+}
+
+// formatStatus colors the given status, if the output
+// is a terminal supporting colors.
+func formatStatus(status string) string {
+	switch status {
+	case statusHealthy:
+		return color.GreenString(status)
+	case statusDegraded:
+		return color.YellowString(status)
+	case statusUnhealthy:
+		return color.RedString(status)
+	}
+
+	return status
+}
+
+func formatComponent(c componentReport) string {
+	if c.Status == statusUnknown {
+		return naValue
+	}
+
+	return formatOptional(c.Condition)
+}
+
+func formatReasons(reasons []string) string {
+	if len(reasons) < 1 {
+		return naValue
+	}
+
+	return strings.Join(reasons, ", ")
+}
+
+func formatOptional(value string) string {
+	if len(value) < 1 {
+		return naValue
+	}
+
+	return value
+}
@@ -0,0 +1,71 @@
+package clusterhealth
+
+const (
+	// statusHealthy is set for components working as expected.
+	statusHealthy = "Healthy"
+	// statusDegraded is set for components that work, but need attention,
+	// e.g. a node pool missing some of its nodes, or a failing app.
+	statusDegraded = "Degraded"
+	// statusUnhealthy is set for components that don't work, e.g. a
+	// control plane that is not ready, or a node pool without ready nodes.
+	statusUnhealthy = "Unhealthy"
+	// statusUnknown is set for components whose status can't be
+	// determined. It doesn't affect the status of the cluster.
+	statusUnknown = "Unknown"
+)
+
+// report is the result of checking the health of the clusters.
+type report struct {
+	Clusters []clusterReport `json:"clusters"`
+}
+
+// clusterReport is the health of a single cluster. Its status is the
+// worst status of its components.
+type clusterReport struct {
+	Name         string `json:"name"`
+	Namespace    string `json:"namespace"`
+	Organization string `json:"organization,omitempty"`
+	Release      string `json:"release,omitempty"`
+	Status       string `json:"status"`
+	// Reasons explain why the cluster is not healthy.
+	Reasons []string `json:"reasons,omitempty"`
+
+	Cluster       componentReport  `json:"cluster"`
+	ControlPlane  componentReport  `json:"controlPlane"`
+	Nodepools     []nodepoolReport `json:"nodepools,omitempty"`
+	FailingApps   []string         `json:"failingApps,omitempty"`
+	StuckMachines []string         `json:"stuckMachines,omitempty"`
+}
+
+type componentReport struct {
+	Status string `json:"status"`
+	// Condition is the latest condition of the
+	// component, or its readiness.
+	Condition string `json:"condition,omitempty"`
+}
+
+type nodepoolReport struct {
+	Name         string `json:"name"`
+	Status       string `json:"status"`
+	DesiredNodes int32  `json:"desiredNodes"`
+	ReadyNodes   int32  `json:"readyNodes"`
+}
+
+// worstStatus returns the most severe of the given statuses.
+func worstStatus(statuses ...string) string {
+	severity := map[string]int{
+		statusUnknown:   0,
+		statusHealthy:   1,
+		statusDegraded:  2,
+		statusUnhealthy: 3,
+	}
+
+	worst := statusHealthy
+	for _, s := range statuses {
+		if severity[s] > severity[worst] {
+			worst = s
+		}
+	}
+
+	return worst
+}
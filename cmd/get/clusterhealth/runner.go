@@ -0,0 +1,377 @@
+package clusterhealth
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/kubectl-gs/pkg/commonconfig"
+	dataClient "github.com/giantswarm/kubectl-gs/pkg/data/client"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/app"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/cluster"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/machine"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/nodepool"
+)
+
+type runner struct {
+	flag   *flag
+	logger micrologger.Logger
+	fs     afero.Fs
+
+	provider string
+
+	// client is shared by all the services, so that they
+	// read from the same informers. It is nil in tests.
+	client          *dataClient.Client
+	appService      app.Interface
+	clusterService  cluster.Interface
+	machineService  machine.Interface
+	nodepoolService nodepool.Interface
+
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func (r *runner) Run(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	err := r.flag.Validate()
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = r.run(ctx, cmd, args)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) error {
+	var err error
+
+	config := commonconfig.New(r.flag.config)
+	{
+		if r.provider == "" {
+			r.provider, err = config.GetProvider()
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+
+		err = r.getServices(config)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		if r.client != nil {
+			defer r.client.Stop()
+		}
+	}
+
+	var clusterName, namespace string
+	{
+		if len(args) > 0 {
+			clusterName = strings.ToLower(args[0])
+		}
+
+		if r.flag.AllNamespaces {
+			namespace = metav1.NamespaceAll
+		} else {
+			namespace, _, err = r.flag.config.ToRawKubeConfigLoader().Namespace()
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	}
+
+	clusters, err := r.getClusters(ctx, namespace, clusterName)
+	if cluster.IsNotFound(err) {
+		return microerror.Maskf(notFoundError, fmt.Sprintf("A cluster with name '%s' cannot be found.\n", clusterName))
+	} else if cluster.IsNoResources(err) {
+		err = r.printNoResourcesOutput()
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	resources, err := r.getClusterResources(ctx, namespace, clusterName, clusters)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	rep := report{
+		Clusters: []clusterReport{},
+	}
+	now := time.Now()
+	for _, res := range resources {
+		rep.Clusters = append(rep.Clusters, getClusterReport(res, r.flag.StuckThreshold, now))
+	}
+
+	err = r.printOutput(rep)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	var notHealthy int
+	for _, cr := range rep.Clusters {
+		if cr.Status != statusHealthy {
+			notHealthy++
+		}
+	}
+	if notHealthy > 0 {
+		return microerror.Maskf(notHealthyError, "%d of %d clusters are not healthy", notHealthy, len(rep.Clusters))
+	}
+
+	return nil
+}
+
+func (r *runner) getClusters(ctx context.Context, namespace, name string) ([]cluster.Cluster, error) {
+	options := cluster.GetOptions{
+		Name:      name,
+		Namespace: namespace,
+		Provider:  r.provider,
+	}
+
+	resource, err := r.clusterService.Get(ctx, options)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var clusters []cluster.Cluster
+	switch c := resource.(type) {
+	case *cluster.Cluster:
+		clusters = append(clusters, *c)
+	case *cluster.Collection:
+		clusters = append(clusters, c.Items...)
+	}
+
+	sort.Slice(clusters, func(i, j int) bool {
+		a, b := clusters[i].Cluster, clusters[j].Cluster
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+
+		return a.Name < b.Name
+	})
+
+	return clusters, nil
+}
+
+// getClusterResources fetches the node pools, apps and machines of the
+// given clusters concurrently, and groups them by cluster.
+func (r *runner) getClusterResources(ctx context.Context, namespace, clusterName string, clusters []cluster.Cluster) ([]clusterResources, error) {
+	var (
+		apps      []app.App
+		machines  []machine.Machine
+		nodepools []nodepool.Nodepool
+	)
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		apps, err = r.getApps(gctx, appNamespaces(namespace, clusters))
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	})
+	g.Go(func() error {
+		var err error
+		machines, err = r.getMachines(gctx, namespace, clusterName)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	})
+	g.Go(func() error {
+		var err error
+		nodepools, err = r.getNodepools(gctx, namespace, clusterName)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	})
+
+	err := g.Wait()
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	resources := make([]clusterResources, 0, len(clusters))
+	for _, c := range clusters {
+		res := clusterResources{
+			Cluster: c,
+		}
+
+		for _, a := range apps {
+			if appBelongsTo(a, c) {
+				res.Apps = append(res.Apps, a)
+			}
+		}
+		for _, m := range machines {
+			if machineBelongsTo(m, c) {
+				res.Machines = append(res.Machines, m)
+			}
+		}
+		for _, np := range nodepools {
+			if nodepoolBelongsTo(np, c) {
+				res.Nodepools = append(res.Nodepools, np)
+			}
+		}
+
+		resources = append(resources, res)
+	}
+
+	return resources, nil
+}
+
+// getApps lists the apps in the given namespaces concurrently. Namespaces
+// that can't be read are skipped, since the apps of a cluster are only
+// optionally placed in the namespace named after it.
+func (r *runner) getApps(ctx context.Context, namespaces []string) ([]app.App, error) {
+	found := make([][]app.App, len(namespaces))
+
+	g, gctx := errgroup.WithContext(ctx)
+	for i := range namespaces {
+		i := i
+		g.Go(func() error {
+			resource, err := r.appService.Get(gctx, app.GetOptions{Namespace: namespaces[i]})
+			if app.IsNoResources(err) || app.IsNoMatch(err) {
+				return nil
+			} else if apierrors.IsForbidden(microerror.Cause(err)) {
+				return nil
+			} else if err != nil {
+				return microerror.Mask(err)
+			}
+
+			if c, ok := resource.(*app.Collection); ok {
+				found[i] = c.Items
+			}
+
+			return nil
+		})
+	}
+
+	err := g.Wait()
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var apps []app.App
+	for _, items := range found {
+		apps = append(apps, items...)
+	}
+
+	return apps, nil
+}
+
+func (r *runner) getMachines(ctx context.Context, namespace, clusterName string) ([]machine.Machine, error) {
+	options := machine.GetOptions{
+		ClusterName: clusterName,
+		Namespace:   namespace,
+		Provider:    r.provider,
+	}
+
+	resource, err := r.machineService.Get(ctx, options)
+	if machine.IsNoResources(err) || machine.IsUnsupportedCluster(err) {
+		// Giant Swarm AWS clusters have no machines to check.
+		return nil, nil
+	} else if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	if c, ok := resource.(*machine.Collection); ok {
+		return c.Items, nil
+	}
+
+	return nil, nil
+}
+
+func (r *runner) getNodepools(ctx context.Context, namespace, clusterName string) ([]nodepool.Nodepool, error) {
+	options := nodepool.GetOptions{
+		ClusterName: clusterName,
+		Namespace:   namespace,
+		Provider:    r.provider,
+	}
+
+	resource, err := r.nodepoolService.Get(ctx, options)
+	if nodepool.IsNoResources(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	if c, ok := resource.(*nodepool.Collection); ok {
+		return c.Items, nil
+	}
+
+	return nil, nil
+}
+
+func (r *runner) getServices(config *commonconfig.CommonConfig) error {
+	if r.clusterService != nil {
+		return nil
+	}
+
+	client, err := config.GetCachedClient(r.logger)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	r.client = client
+
+	r.appService, err = app.New(app.Config{Client: client})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	r.clusterService, err = cluster.New(cluster.Config{Client: client})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	r.machineService, err = machine.New(machine.Config{Client: client})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	r.nodepoolService, err = nodepool.New(nodepool.Config{Client: client})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// appNamespaces returns the namespaces to list the apps of the given
+// clusters in, i.e. the namespace of the clusters, and the namespaces
+// named after them.
+func appNamespaces(namespace string, clusters []cluster.Cluster) []string {
+	if namespace == metav1.NamespaceAll {
+		return []string{metav1.NamespaceAll}
+	}
+
+	namespaces := []string{namespace}
+	for _, c := range clusters {
+		if c.Cluster.Name != namespace {
+			namespaces = append(namespaces, c.Cluster.Name)
+		}
+	}
+
+	return namespaces
+}
@@ -0,0 +1,346 @@
+package clusterhealth
+
+import (
+	"bytes"
+	"context"
+	goflag "flag"
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+	applicationv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/application/v1alpha1"
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/internal/label"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/app"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/cluster"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/machine"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/nodepool"
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+	"github.com/giantswarm/kubectl-gs/test/goldenfile"
+	"github.com/giantswarm/kubectl-gs/test/kubeconfig"
+)
+
+var update = goflag.Bool("update", false, "update .golden reference test files")
+
+// Test_run uses golden files.
+//
+//  go test ./cmd/get/clusterhealth -run Test_run -update
+//
+func Test_run(t *testing.T) {
+	// The golden files are written without colors.
+	color.NoColor = true
+
+	storage := []runtime.Object{
+		// Cluster f83ir is healthy.
+		newCAPICluster("f83ir"),
+		newAWSCluster("f83ir", infrastructurev1alpha3.ClusterStatusConditionCreated),
+		newMachineDeployment("a7k3e", "f83ir", 3, 3),
+		newAWSMachineDeployment("a7k3e", "f83ir"),
+		newApp("ingress-nginx", "f83ir", "deployed"),
+
+		// Cluster s921a is degraded, by a node pool missing nodes,
+		// a failing app and a stuck machine.
+		newCAPICluster("s921a"),
+		newAWSCluster("s921a", infrastructurev1alpha3.ClusterStatusConditionUpdated),
+		newMachineDeployment("q9wx1", "s921a", 3, 2),
+		newAWSMachineDeployment("q9wx1", "s921a"),
+		newApp("cert-manager", "s921a", "failed"),
+		newApp("coredns", "s921a", "deployed"),
+		newMachine("s921a-q9wx1-2", "s921a", "Provisioning", false, 2*time.Hour),
+
+		// Cluster k2m5x is unhealthy, by its control plane and
+		// a node pool without ready nodes.
+		newCAPICluster("k2m5x"),
+		newAWSCluster("k2m5x", infrastructurev1alpha3.ClusterStatusConditionCreating),
+		newMachineDeployment("z0n3p", "k2m5x", 2, 0),
+		newAWSMachineDeployment("z0n3p", "k2m5x"),
+		newMachine("k2m5x-cp-0", "k2m5x", "Provisioning", true, 10*time.Minute),
+	}
+
+	testCases := []struct {
+		name                  string
+		storage               []runtime.Object
+		args                  []string
+		outputType            string
+		expectedGoldenFile    string
+		expectedErrGoldenFile string
+		errorMatcher          func(error) bool
+	}{
+		{
+			name:               "case 0: get cluster health",
+			storage:            storage,
+			expectedGoldenFile: "run_get_cluster_health.golden",
+			errorMatcher:       IsNotHealthy,
+		},
+		{
+			name:               "case 1: get cluster health, with wide output",
+			storage:            storage,
+			outputType:         output.TypeWide,
+			expectedGoldenFile: "run_get_cluster_health_wide.golden",
+			errorMatcher:       IsNotHealthy,
+		},
+		{
+			name:               "case 2: get cluster health, with JSON output",
+			storage:            storage,
+			outputType:         output.TypeJSON,
+			expectedGoldenFile: "run_get_cluster_health_json_output.golden",
+			errorMatcher:       IsNotHealthy,
+		},
+		{
+			name:               "case 3: get health of a healthy cluster",
+			storage:            storage,
+			args:               []string{"f83ir"},
+			expectedGoldenFile: "run_get_cluster_health_by_name.golden",
+		},
+		{
+			name:               "case 4: get health of a degraded cluster, with YAML output",
+			storage:            storage,
+			args:               []string{"s921a"},
+			outputType:         output.TypeYAML,
+			expectedGoldenFile: "run_get_cluster_health_by_name_yaml_output.golden",
+			errorMatcher:       IsNotHealthy,
+		},
+		{
+			name:         "case 5: get health of a cluster, not found",
+			storage:      storage,
+			args:         []string{"unknown"},
+			errorMatcher: IsNotFound,
+		},
+		{
+			name:                  "case 6: get cluster health, with empty storage",
+			storage:               nil,
+			expectedErrGoldenFile: "run_get_cluster_health_empty_storage.golden",
+		},
+		{
+			name:                  "case 7: get cluster health, with empty storage, and JSON output",
+			storage:               nil,
+			outputType:            output.TypeJSON,
+			expectedGoldenFile:    "run_get_cluster_health_empty_storage_json_output.golden",
+			expectedErrGoldenFile: "run_get_cluster_health_empty_storage.golden",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			outputType := output.TypeDefault
+			if len(tc.outputType) > 0 {
+				outputType = tc.outputType
+			}
+
+			fakeKubeConfig := kubeconfig.CreateFakeKubeConfig()
+			flag := &flag{
+				print:  genericclioptions.NewPrintFlags("").WithDefaultOutput(outputType),
+				config: genericclioptions.NewTestConfigFlags().WithClientConfig(fakeKubeConfig),
+
+				StuckThreshold: defaultStuckThreshold,
+			}
+			out := new(bytes.Buffer)
+			errOut := new(bytes.Buffer)
+			runner := &runner{
+				appService:      app.NewFakeService(tc.storage),
+				clusterService:  cluster.NewFakeService(tc.storage),
+				machineService:  machine.NewFakeService(tc.storage),
+				nodepoolService: nodepool.NewFakeService(tc.storage),
+				flag:            flag,
+				stdout:          out,
+				stderr:          errOut,
+				provider:        key.ProviderAWS,
+			}
+
+			err := runner.run(ctx, nil, tc.args)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			assertGoldenFile(t, tc.expectedGoldenFile, out.Bytes())
+			assertGoldenFile(t, tc.expectedErrGoldenFile, errOut.Bytes())
+		})
+	}
+}
+
+func assertGoldenFile(t *testing.T, name string, actual []byte) {
+	var err error
+
+	if len(name) < 1 {
+		if len(actual) > 0 {
+			t.Fatalf("unexpected output, got:\n %s", actual)
+		}
+
+		return
+	}
+
+	var expectedResult []byte
+	{
+		gf := goldenfile.New("testdata", name)
+		if *update {
+			err = gf.Update(actual)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			expectedResult = actual
+		} else {
+			expectedResult, err = gf.Read()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+		}
+	}
+
+	diff := cmp.Diff(string(expectedResult), string(actual))
+	if diff != "" {
+		t.Fatalf("value not expected, got:\n %s", diff)
+	}
+}
+
+func newCAPICluster(name string) *capiv1alpha3.Cluster {
+	return &capiv1alpha3.Cluster{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "cluster.x-k8s.io/v1alpha3",
+			Kind:       "Cluster",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				label.Cluster:                 name,
+				label.Organization:            "giantswarm",
+				label.ReleaseVersion:          "14.1.0",
+				capiv1alpha3.ClusterLabelName: name,
+			},
+		},
+	}
+}
+
+func newAWSCluster(name, condition string) *infrastructurev1alpha3.AWSCluster {
+	c := &infrastructurev1alpha3.AWSCluster{
+		TypeMeta: infrastructurev1alpha3.NewAWSClusterTypeMeta(),
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				label.Cluster:                 name,
+				label.Organization:            "giantswarm",
+				label.ReleaseVersion:          "14.1.0",
+				capiv1alpha3.ClusterLabelName: name,
+			},
+		},
+	}
+	c.Status.Cluster.Conditions = []infrastructurev1alpha3.CommonClusterStatusCondition{
+		{Condition: condition},
+	}
+
+	return c
+}
+
+func newMachineDeployment(name, clusterName string, desired, ready int32) *capiv1alpha3.MachineDeployment {
+	return &capiv1alpha3.MachineDeployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "cluster.x-k8s.io/v1alpha3",
+			Kind:       "MachineDeployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				label.Cluster:                 clusterName,
+				label.MachineDeployment:       name,
+				capiv1alpha3.ClusterLabelName: clusterName,
+			},
+		},
+		Spec: capiv1alpha3.MachineDeploymentSpec{
+			Replicas: &desired,
+		},
+		Status: capiv1alpha3.MachineDeploymentStatus{
+			Replicas:      desired,
+			ReadyReplicas: ready,
+		},
+	}
+}
+
+func newAWSMachineDeployment(name, clusterName string) *infrastructurev1alpha3.AWSMachineDeployment {
+	return &infrastructurev1alpha3.AWSMachineDeployment{
+		TypeMeta: infrastructurev1alpha3.NewAWSMachineDeploymentTypeMeta(),
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				label.Cluster:           clusterName,
+				label.MachineDeployment: name,
+			},
+		},
+	}
+}
+
+func newApp(name, namespace, status string) *applicationv1alpha1.App {
+	return &applicationv1alpha1.App{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "application.giantswarm.io/v1alpha1",
+			Kind:       "App",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: applicationv1alpha1.AppSpec{
+			Catalog:   "default",
+			Name:      name,
+			Namespace: "kube-system",
+			Version:   "1.0.0",
+		},
+		Status: applicationv1alpha1.AppStatus{
+			Release: applicationv1alpha1.AppStatusRelease{
+				Status: status,
+			},
+			Version: "1.0.0",
+		},
+	}
+}
+
+func newMachine(name, clusterName, phase string, controlPlane bool, age time.Duration) *capiv1alpha3.Machine {
+	m := &capiv1alpha3.Machine{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "cluster.x-k8s.io/v1alpha3",
+			Kind:       "Machine",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+			Labels: map[string]string{
+				capiv1alpha3.ClusterLabelName: clusterName,
+			},
+		},
+		Spec: capiv1alpha3.MachineSpec{
+			ClusterName: clusterName,
+			InfrastructureRef: corev1.ObjectReference{
+				APIVersion: "infrastructure.cluster.x-k8s.io/v1alpha3",
+				Kind:       "AWSMachine",
+				Name:       name,
+			},
+		},
+		Status: capiv1alpha3.MachineStatus{
+			Phase: phase,
+		},
+	}
+
+	if controlPlane {
+		m.Labels[capiv1alpha3.MachineControlPlaneLabelName] = ""
+	}
+
+	return m
+}
@@ -24,17 +24,35 @@ const (
 Output columns:
 
 - NAME: Unique identifier of the cluster.
-- CREATED: Date and time of the Cluster CR creation.
 - CONDITION: Latest condition reported for the cluster. Can be "CREATING", "CREATED", "UPDATING", "UPDATED", "DELETING".
 - RELEASE: Workload cluster release used by the cluster.
 - ORGANIZATION: Organization owning the cluster.
-- DESCRIPTION: User friendly description for the cluster.`
+- DESCRIPTION: User friendly description for the cluster.
+- AGE: How long ago the Cluster CR was created.
+
+With -o wide, the region and who the cluster was CREATED BY are shown
+as well.`
 
 	examples = `  # List all clusters you have access to
   kubectl gs get clusters
   
   # Get one specific cluster by its name
-  kubectl gs get clusters f83ir`
+  kubectl gs get clusters f83ir
+
+  # List all clusters of one organization, running a 14.x release
+  kubectl gs get clusters -A --organization acme --release 14.x
+
+  # Watch all clusters for changes
+  kubectl gs get clusters --watch
+
+  # List all clusters with additional details, oldest first
+  kubectl gs get clusters -o wide --sort-by age
+
+  # List all clusters with the dates they were created at, instead of their age
+  kubectl gs get clusters --timestamps absolute
+
+  # List the names and releases of all clusters
+  kubectl gs get clusters -o custom-columns=NAME:.metadata.name,RELEASE:.metadata.labels.release\.giantswarm\.io/version`
 )
 
 type Config struct {
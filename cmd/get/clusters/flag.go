@@ -1,16 +1,40 @@
 package clusters
 
 import (
+	semver "github.com/blang/semver/v4"
+	"github.com/giantswarm/microerror"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"strings"
+
+	"github.com/giantswarm/kubectl-gs/pkg/output"
 )
 
 const (
 	flagAllNamespaces = "all-namespaces"
+	flagChunkSize     = "chunk-size"
+	flagCondition     = "condition"
+	flagOrganization  = "organization"
+	flagRelease       = "release"
+	flagSelector      = "selector"
+	flagSortBy        = "sort-by"
+	flagTimestamps    = "timestamps"
+	flagWatch         = "watch"
+	flagWatchOnly     = "watch-only"
 )
 
 type flag struct {
 	AllNamespaces bool
+	ChunkSize     int64
+	Condition     string
+	Organization  string
+	Release       string
+	Selector      string
+	SortBy        string
+	Timestamps    string
+	Watch         bool
+	WatchOnly     bool
 
 	config genericclioptions.RESTClientGetter
 	print  *genericclioptions.PrintFlags
@@ -18,6 +42,15 @@ type flag struct {
 
 func (f *flag) Init(cmd *cobra.Command) {
 	cmd.Flags().BoolVarP(&f.AllNamespaces, flagAllNamespaces, "A", false, "If present, list the requested object(s) across all namespaces. Namespace in current context is ignored even if specified with --namespace.")
+	cmd.Flags().Int64Var(&f.ChunkSize, flagChunkSize, 500, "Return large lists in chunks rather than all at once. Pass 0 to disable.")
+	cmd.Flags().StringVarP(&f.Selector, flagSelector, "l", "", "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	cmd.Flags().StringVar(&f.Organization, flagOrganization, "", "Only show clusters owned by this organization.")
+	cmd.Flags().StringVar(&f.Release, flagRelease, "", "Only show clusters with a release version in this range, e.g. '14.x' or '>=14.1.0 <15.0.0'.")
+	cmd.Flags().StringVar(&f.Condition, flagCondition, "", "Only show clusters with this latest condition, e.g. 'created'.")
+	cmd.Flags().BoolVarP(&f.Watch, flagWatch, "w", false, "After listing/getting the requested object(s), watch for changes.")
+	cmd.Flags().BoolVar(&f.WatchOnly, flagWatchOnly, false, "Watch for changes to the requested object(s), without listing/getting first.")
+	cmd.Flags().StringVar(&f.SortBy, flagSortBy, "", "If non-empty, sort table output using this column name or JSONPath expression, e.g. 'age' or '.metadata.creationTimestamp'.")
+	cmd.Flags().StringVar(&f.Timestamps, flagTimestamps, output.TimestampsRelative, "Print timestamps in table output as the time elapsed since then (relative) or as dates in UTC (absolute).")
 
 	f.config = genericclioptions.NewConfigFlags(true)
 	f.print = genericclioptions.NewPrintFlags("")
@@ -26,8 +59,34 @@ func (f *flag) Init(cmd *cobra.Command) {
 	// to be able to override kubectl-specific ones.
 	f.config.(*genericclioptions.ConfigFlags).AddFlags(cmd.Flags())
 	f.print.AddFlags(cmd)
+	output.AddTableFormatsUsage(cmd)
 }
 
 func (f *flag) Validate() error {
+	if len(f.Selector) > 0 {
+		_, err := labels.Parse(f.Selector)
+		if err != nil {
+			return microerror.Maskf(invalidFlagError, "--%s must be a valid label selector: %s", flagSelector, err)
+		}
+	}
+	if len(f.Release) > 0 {
+		_, err := semver.ParseRange(f.Release)
+		if err != nil {
+			return microerror.Maskf(invalidFlagError, "--%s must be a valid version range: %s", flagRelease, err)
+		}
+	}
+	if f.ChunkSize < 0 {
+		return microerror.Maskf(invalidFlagError, "--%s must not be negative", flagChunkSize)
+	}
+	if !output.IsTimestampFormat(f.Timestamps) {
+		return microerror.Maskf(invalidFlagError, "--%s must be one of %s", flagTimestamps, strings.Join(output.TimestampFormats, ", "))
+	}
+
 	return nil
 }
+
+// hasFilters is true if any of the flags narrowing
+// down the list of clusters has been set.
+func (f *flag) hasFilters() bool {
+	return len(f.Selector) > 0 || len(f.Organization) > 0 || len(f.Release) > 0 || len(f.Condition) > 0
+}
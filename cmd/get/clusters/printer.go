@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/giantswarm/microerror"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/printers"
 
@@ -21,18 +22,31 @@ func (r *runner) printOutput(clusterResource cluster.Resource) error {
 	)
 
 	switch {
-	case output.IsOutputDefault(r.flag.print.OutputFormat):
+	case output.IsOutputTable(r.flag.print.OutputFormat):
+		var table *metav1.Table
 		switch r.provider {
 		case key.ProviderAWS:
-			resource = provider.GetAWSTable(clusterResource)
+			table = provider.GetAWSTable(clusterResource, r.flag.Timestamps)
 		case key.ProviderAzure:
-			resource = provider.GetAzureTable(clusterResource)
+			table = provider.GetAzureTable(clusterResource, r.flag.Timestamps)
+		case key.ProviderOpenStack:
+			table = provider.GetOpenStackTable(clusterResource, r.flag.Timestamps)
 		}
 
-		printOptions := printers.PrintOptions{
+		tableOptions := output.TableOptions{
+			OutputFormat:  r.flag.print.OutputFormat,
+			SortBy:        r.flag.SortBy,
 			WithNamespace: r.flag.AllNamespaces,
 		}
-		printer = printers.NewTablePrinter(printOptions)
+		if r.tablePrinter == nil {
+			r.tablePrinter = output.NewTablePrinter(r.stdout)
+		}
+		err = r.tablePrinter.PrintTable(table, tableOptions)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
 
 	case output.IsOutputName(r.flag.print.OutputFormat):
 		resource = clusterResource.Object()
@@ -59,8 +73,15 @@ func (r *runner) printOutput(clusterResource cluster.Resource) error {
 	return nil
 }
 
-func (r *runner) printNoResourcesOutput() {
-	fmt.Fprintf(r.stdout, "No clusters found.\n")
-	fmt.Fprintf(r.stdout, "To create a cluster, please check\n\n")
-	fmt.Fprintf(r.stdout, "  kubectl gs template cluster --help\n")
+func (r *runner) printNoResourcesOutput() error {
+	fmt.Fprintf(r.stderr, "No clusters found.\n")
+	fmt.Fprintf(r.stderr, "To create a cluster, please check\n\n")
+	fmt.Fprintf(r.stderr, "  kubectl gs template cluster --help\n")
+
+	err := output.PrintEmptyList(r.stdout, r.flag.print)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
 }
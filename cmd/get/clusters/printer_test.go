@@ -15,6 +15,7 @@ import (
 	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
 	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 
+	capov1alpha3 "github.com/giantswarm/kubectl-gs/internal/capo/api/v1alpha3"
 	"github.com/giantswarm/kubectl-gs/internal/key"
 	"github.com/giantswarm/kubectl-gs/internal/label"
 	"github.com/giantswarm/kubectl-gs/pkg/data/domain/cluster"
@@ -204,12 +205,37 @@ func Test_printOutput(t *testing.T) {
 			outputType:         output.TypeName,
 			expectedGoldenFile: "print_single_azure_cluster_name_output.golden",
 		},
+		{
+			name: "case 16: print list of OpenStack clusters, with table output",
+			clusterRes: newClusterCollection(
+				*newOpenStackCluster("1sad2", "2021-01-02T15:04:32Z", "20.0.0", "test", "test cluster 1", nil),
+				*newOpenStackCluster("2a03f", "2021-01-02T15:04:32Z", "20.0.0", "test", "test cluster 2", []string{string(capiv1alpha3.ReadyCondition)}),
+			),
+			provider:           key.ProviderOpenStack,
+			outputType:         output.TypeDefault,
+			expectedGoldenFile: "print_list_of_openstack_clusters_table_output.golden",
+		},
+		{
+			name:               "case 17: print single OpenStack cluster, with wide table output",
+			clusterRes:         newOpenStackCluster("f930q", "2021-01-02T15:04:32Z", "20.0.0", "some-other", "test cluster 4", []string{string(capiv1alpha3.ReadyCondition)}),
+			provider:           key.ProviderOpenStack,
+			outputType:         output.TypeWide,
+			expectedGoldenFile: "print_single_openstack_cluster_wide_output.golden",
+		},
+		{
+			name:               "case 18: print single OpenStack cluster, with YAML output",
+			clusterRes:         newOpenStackCluster("f930q", "2021-01-02T15:04:32Z", "20.0.0", "some-other", "test cluster 4", []string{string(capiv1alpha3.ReadyCondition)}),
+			provider:           key.ProviderOpenStack,
+			outputType:         output.TypeYAML,
+			expectedGoldenFile: "print_single_openstack_cluster_yaml_output.golden",
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			flag := &flag{
-				print: genericclioptions.NewPrintFlags("").WithDefaultOutput(tc.outputType),
+				print:      genericclioptions.NewPrintFlags("").WithDefaultOutput(tc.outputType),
+				Timestamps: output.TimestampsAbsolute,
 			}
 			out := new(bytes.Buffer)
 			runner := &runner{
@@ -360,6 +386,39 @@ func newAzureCluster(id, created, release, org, description string, conditions [
 	return c
 }
 
+func newOpenStackClusterResource(id, namespace string) *capov1alpha3.OpenStackCluster {
+	c := &capov1alpha3.OpenStackCluster{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "infrastructure.cluster.x-k8s.io/v1alpha3",
+			Kind:       "OpenStackCluster",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      id,
+			Namespace: namespace,
+			Labels: map[string]string{
+				capiv1alpha3.ClusterLabelName: id,
+			}},
+		Spec: capov1alpha3.OpenStackClusterSpec{
+			CloudName:         "openstack",
+			ExternalNetworkID: "4c9f2bb1-1a52-4d6e-9f2e-0a1c9f3b7d21",
+		},
+	}
+
+	return c
+}
+
+func newOpenStackCluster(id, created, release, org, description string, conditions []string) *cluster.Cluster {
+	openStackCluster := newOpenStackClusterResource(id, "default")
+	capiCluster := newCAPIV1alpha3Cluster(id, created, release, org, description, conditions)
+
+	c := &cluster.Cluster{
+		OpenStackCluster: openStackCluster,
+		Cluster:          capiCluster,
+	}
+
+	return c
+}
+
 func newClusterCollection(clusters ...cluster.Cluster) *cluster.Collection {
 	collection := &cluster.Collection{
 		Items: clusters,
@@ -375,12 +434,23 @@ To create a cluster, please check
   kubectl gs template cluster --help
 `
 	out := new(bytes.Buffer)
+	errOut := new(bytes.Buffer)
 	runner := &runner{
+		flag: &flag{
+			print: genericclioptions.NewPrintFlags("").WithDefaultOutput(output.TypeDefault),
+		},
 		stdout: out,
+		stderr: errOut,
+	}
+	err := runner.printNoResourcesOutput()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
 	}
-	runner.printNoResourcesOutput()
 
-	if out.String() != expected {
-		t.Fatalf("value not expected, got:\n %s", out.String())
+	if errOut.String() != expected {
+		t.Fatalf("value not expected, got:\n %s", errOut.String())
+	}
+	if out.Len() > 0 {
+		t.Fatalf("unexpected output, got:\n %s", out.String())
 	}
 }
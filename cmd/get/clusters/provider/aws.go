@@ -5,36 +5,41 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/giantswarm/kubectl-gs/internal/key"
 	"github.com/giantswarm/kubectl-gs/internal/label"
 	"github.com/giantswarm/kubectl-gs/pkg/data/domain/cluster"
+	"github.com/giantswarm/kubectl-gs/pkg/output"
 )
 
-func GetAWSTable(clusterResource cluster.Resource) *metav1.Table {
+func GetAWSTable(clusterResource cluster.Resource, timestamps string) *metav1.Table {
 	// Creating a custom table resource.
 	table := &metav1.Table{}
 
 	table.ColumnDefinitions = []metav1.TableColumnDefinition{
 		{Name: "Name", Type: "string"},
-		{Name: "Created", Type: "string", Format: "date-time"},
 		{Name: "Condition", Type: "string"},
 		{Name: "Release", Type: "string"},
 		{Name: "Organization", Type: "string"},
 		{Name: "Description", Type: "string"},
+		{Name: "Age", Type: "string"},
+		{Name: "Region", Type: "string", Priority: 1},
+		{Name: "Availability Zone", Type: "string", Priority: 1},
+		{Name: "Created By", Type: "string", Priority: 1},
 	}
 
 	switch c := clusterResource.(type) {
 	case *cluster.Cluster:
-		table.Rows = append(table.Rows, getAWSClusterRow(*c))
+		table.Rows = append(table.Rows, getAWSClusterRow(*c, timestamps))
 	case *cluster.Collection:
 		for _, clusterItem := range c.Items {
-			table.Rows = append(table.Rows, getAWSClusterRow(clusterItem))
+			table.Rows = append(table.Rows, getAWSClusterRow(clusterItem, timestamps))
 		}
 	}
 
 	return table
 }
 
-func getAWSClusterRow(c cluster.Cluster) metav1.TableRow {
+func getAWSClusterRow(c cluster.Cluster, timestamps string) metav1.TableRow {
 	if c.Cluster == nil || c.AWSCluster == nil {
 		return metav1.TableRow{}
 	}
@@ -42,11 +47,14 @@ func getAWSClusterRow(c cluster.Cluster) metav1.TableRow {
 	return metav1.TableRow{
 		Cells: []interface{}{
 			c.AWSCluster.GetName(),
-			c.AWSCluster.CreationTimestamp.UTC(),
 			getLatestAWSCondition(c.AWSCluster.Status.Cluster.Conditions),
 			c.AWSCluster.Labels[label.ReleaseVersion],
-			c.AWSCluster.Labels[label.Organization],
+			formatOptional(key.Organization(c.AWSCluster)),
 			c.AWSCluster.Spec.Cluster.Description,
+			output.NewTimestamp(c.AWSCluster.CreationTimestamp, timestamps),
+			formatOptional(c.AWSCluster.Spec.Provider.Region),
+			formatOptional(c.AWSCluster.Spec.Provider.Master.AvailabilityZone),
+			formatOptional(key.CreatedBy(c.AWSCluster)),
 		},
 		Object: runtime.RawExtension{
 			Object: c.AWSCluster,
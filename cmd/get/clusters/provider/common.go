@@ -2,12 +2,42 @@ package provider
 
 import (
 	"strings"
+
+	"github.com/giantswarm/apiextensions/v3/pkg/annotation"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 )
 
 const (
 	naValue = "n/a"
 )
 
+func formatOptional(value string) string {
+	if len(value) < 1 {
+		return naValue
+	}
+
+	return value
+}
+
 func formatCondition(condition string) string {
 	return strings.ToUpper(condition)
 }
+
+func getCAPIClusterDescription(res *capiv1alpha3.Cluster) string {
+	description := naValue
+
+	annotations := res.GetAnnotations()
+	if annotations != nil && annotations[annotation.ClusterDescription] != "" {
+		description = annotations[annotation.ClusterDescription]
+	}
+
+	return description
+}
+
+func getLatestCAPICondition(conditions []capiv1alpha3.Condition) string {
+	if len(conditions) < 1 {
+		return naValue
+	}
+
+	return formatCondition(string(conditions[0].Type))
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/internal/label"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/cluster"
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+)
+
+func GetOpenStackTable(clusterResource cluster.Resource, timestamps string) *metav1.Table {
+	// Creating a custom table resource.
+	table := &metav1.Table{}
+
+	table.ColumnDefinitions = []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string"},
+		{Name: "Condition", Type: "string"},
+		{Name: "Release", Type: "string"},
+		{Name: "Organization", Type: "string"},
+		{Name: "Description", Type: "string"},
+		{Name: "Age", Type: "string"},
+		{Name: "Cloud", Type: "string", Priority: 1},
+		{Name: "External Network", Type: "string", Priority: 1},
+		{Name: "Created By", Type: "string", Priority: 1},
+	}
+
+	switch c := clusterResource.(type) {
+	case *cluster.Cluster:
+		table.Rows = append(table.Rows, getOpenStackClusterRow(*c, timestamps))
+	case *cluster.Collection:
+		for _, clusterItem := range c.Items {
+			table.Rows = append(table.Rows, getOpenStackClusterRow(clusterItem, timestamps))
+		}
+	}
+
+	return table
+}
+
+func getOpenStackClusterRow(c cluster.Cluster, timestamps string) metav1.TableRow {
+	if c.Cluster == nil || c.OpenStackCluster == nil {
+		return metav1.TableRow{}
+	}
+
+	return metav1.TableRow{
+		Cells: []interface{}{
+			c.Cluster.GetName(),
+			getLatestCAPICondition(c.Cluster.GetConditions()),
+			c.Cluster.Labels[label.ReleaseVersion],
+			formatOptional(key.Organization(c.Cluster)),
+			getCAPIClusterDescription(c.Cluster),
+			output.NewTimestamp(c.Cluster.CreationTimestamp, timestamps),
+			formatOptional(c.OpenStackCluster.Spec.CloudName),
+			formatOptional(c.OpenStackCluster.Spec.ExternalNetworkID),
+			formatOptional(key.CreatedBy(c.Cluster)),
+		},
+		Object: runtime.RawExtension{
+			Object: c.Cluster,
+		},
+	}
+}
@@ -6,11 +6,13 @@ import (
 	"io"
 	"strings"
 
+	semver "github.com/blang/semver/v4"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/giantswarm/kubectl-gs/pkg/commonconfig"
 	"github.com/giantswarm/kubectl-gs/pkg/data/domain/cluster"
@@ -25,6 +27,10 @@ type runner struct {
 	provider string
 	service  cluster.Interface
 
+	// tablePrinter prints all the tables of the command, so that further chunks and watch events
+	// only add new rows, aligned with the headers.
+	tablePrinter *output.TablePrinter
+
 	stdout io.Writer
 	stderr io.Writer
 }
@@ -63,38 +69,90 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 		}
 	}
 
-	var resource cluster.Resource
+	var options cluster.GetOptions
 	{
-		options := cluster.GetOptions{
+		options = cluster.GetOptions{
 			Provider: r.provider,
 		}
-		{
-			if len(args) > 0 {
-				options.Name = strings.ToLower(args[0])
+
+		if len(args) > 0 {
+			if r.flag.hasFilters() {
+				return microerror.Maskf(invalidFlagError, "--%s, --%s, --%s and --%s cannot be used when getting a cluster by name", flagSelector, flagOrganization, flagRelease, flagCondition)
 			}
 
-			if r.flag.AllNamespaces {
-				options.Namespace = metav1.NamespaceAll
-			} else {
-				options.Namespace, _, err = r.flag.config.ToRawKubeConfigLoader().Namespace()
-				if err != nil {
-					return microerror.Mask(err)
-				}
+			options.Name = strings.ToLower(args[0])
+		}
+
+		if len(r.flag.Selector) > 0 {
+			options.LabelSelector, err = labels.Parse(r.flag.Selector)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+		if len(r.flag.Release) > 0 {
+			options.Release, err = semver.ParseRange(r.flag.Release)
+			if err != nil {
+				return microerror.Mask(err)
 			}
 		}
+		options.Organization = r.flag.Organization
+		options.Condition = r.flag.Condition
+		options.ChunkSize = r.flag.ChunkSize
+
+		if r.flag.AllNamespaces {
+			options.Namespace = metav1.NamespaceAll
+		} else {
+			options.Namespace, _, err = r.flag.config.ToRawKubeConfigLoader().Namespace()
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	}
 
-		resource, err = r.service.Get(ctx, options)
+	if !r.flag.WatchOnly {
+		err = r.printClusters(ctx, options)
 		if cluster.IsNotFound(err) {
 			return microerror.Maskf(notFoundError, fmt.Sprintf("A cluster with name '%s' cannot be found.\n", options.Name))
-		} else if cluster.IsNoResources(err) && output.IsOutputDefault(r.flag.print.OutputFormat) {
-			r.printNoResourcesOutput()
-
-			return nil
+		} else if cluster.IsNoResources(err) {
+			err = r.printNoResourcesOutput()
+			if err != nil {
+				return microerror.Mask(err)
+			}
 		} else if err != nil {
 			return microerror.Mask(err)
 		}
 	}
 
+	if r.flag.Watch || r.flag.WatchOnly {
+		err = r.service.Watch(ctx, options, func(e cluster.Event) error {
+			return r.printOutput(e.Resource)
+		})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	return nil
+}
+
+// printClusters prints the clusters matching the given options. Tables that
+// don't have to be sorted are printed chunk by chunk, as soon as the
+// clusters are listed.
+func (r *runner) printClusters(ctx context.Context, options cluster.GetOptions) error {
+	if output.IsOutputTable(r.flag.print.OutputFormat) && len(r.flag.SortBy) < 1 {
+		err := r.service.Stream(ctx, options, r.printOutput)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	}
+
+	resource, err := r.service.Get(ctx, options)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	err = r.printOutput(resource)
 	if err != nil {
 		return microerror.Mask(err)
@@ -4,12 +4,16 @@ import (
 	"bytes"
 	"context"
 	"testing"
+	"time"
 
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
+	"github.com/giantswarm/kubectl-gs/internal/annotation"
 	"github.com/giantswarm/kubectl-gs/internal/key"
 	"github.com/giantswarm/kubectl-gs/pkg/data/domain/cluster"
 	"github.com/giantswarm/kubectl-gs/pkg/output"
@@ -23,11 +27,20 @@ import (
 //
 func Test_run(t *testing.T) {
 	testCases := []struct {
-		name               string
-		storage            []runtime.Object
-		args               []string
-		expectedGoldenFile string
-		errorMatcher       func(error) bool
+		name                  string
+		storage               []runtime.Object
+		args                  []string
+		selector              string
+		organization          string
+		release               string
+		condition             string
+		watch                 bool
+		watchOnly             bool
+		outputFormat          string
+		sortBy                string
+		expectedGoldenFile    string
+		expectedErrGoldenFile string
+		errorMatcher          func(error) bool
 	}{
 		{
 			name: "case 0: get clusters",
@@ -41,10 +54,10 @@ func Test_run(t *testing.T) {
 			expectedGoldenFile: "run_get_clusters.golden",
 		},
 		{
-			name:               "case 1: get clusters, with empty storage",
-			storage:            nil,
-			args:               nil,
-			expectedGoldenFile: "run_get_clusters_empty_storage.golden",
+			name:                  "case 1: get clusters, with empty storage",
+			storage:               nil,
+			args:                  nil,
+			expectedErrGoldenFile: "run_get_clusters_empty_storage.golden",
 		},
 		{
 			name: "case 2: get cluster by id",
@@ -73,22 +86,238 @@ func Test_run(t *testing.T) {
 			args:         []string{"f930q"},
 			errorMatcher: IsNotFound,
 		},
+		{
+			name: "case 5: get clusters, and watch for changes",
+			storage: []runtime.Object{
+				newCAPIV1alpha3Cluster("1sad2", "default", "10.5.0", "some-org", "test cluster 3", nil),
+				newAWSClusterResource("1sad2", "2021-01-01T15:04:32Z", "10.5.0", "some-org", "test cluster 3", nil),
+				newCAPIV1alpha3Cluster("f930q", "default", "11.0.0", "some-other", "test cluster 4", nil),
+				newAWSClusterResource("f930q", "2021-01-02T15:04:32Z", "11.0.0", "some-other", "test cluster 4", nil),
+			},
+			args:               nil,
+			watch:              true,
+			expectedGoldenFile: "run_get_clusters_watch.golden",
+		},
+		{
+			name: "case 6: watch clusters, without listing first",
+			storage: []runtime.Object{
+				newCAPIV1alpha3Cluster("1sad2", "default", "10.5.0", "some-org", "test cluster 3", nil),
+				newAWSClusterResource("1sad2", "2021-01-01T15:04:32Z", "10.5.0", "some-org", "test cluster 3", nil),
+				newCAPIV1alpha3Cluster("f930q", "default", "11.0.0", "some-other", "test cluster 4", nil),
+				newAWSClusterResource("f930q", "2021-01-02T15:04:32Z", "11.0.0", "some-other", "test cluster 4", nil),
+			},
+			args:               nil,
+			watchOnly:          true,
+			expectedGoldenFile: "run_get_clusters_watch_only.golden",
+		},
+		{
+			name: "case 7: get clusters, filtered by organization",
+			storage: []runtime.Object{
+				newCAPIV1alpha3Cluster("1sad2", "default", "10.5.0", "some-org", "test cluster 3", nil),
+				newAWSClusterResource("1sad2", "2021-01-01T15:04:32Z", "10.5.0", "some-org", "test cluster 3", nil),
+				newCAPIV1alpha3Cluster("f930q", "default", "11.0.0", "some-other", "test cluster 4", nil),
+				newAWSClusterResource("f930q", "2021-01-02T15:04:32Z", "11.0.0", "some-other", "test cluster 4", []string{infrastructurev1alpha3.ClusterStatusConditionCreated}),
+				newCAPIV1alpha3Cluster("a2b3c", "default", "11.1.2", "some-org", "test cluster 5", nil),
+				newAWSClusterResource("a2b3c", "2021-01-03T15:04:32Z", "11.1.2", "some-org", "test cluster 5", []string{infrastructurev1alpha3.ClusterStatusConditionCreating}),
+			},
+			args:               nil,
+			organization:       "some-org",
+			expectedGoldenFile: "run_get_clusters_by_organization.golden",
+		},
+		{
+			name: "case 8: get clusters, filtered by release range",
+			storage: []runtime.Object{
+				newCAPIV1alpha3Cluster("1sad2", "default", "10.5.0", "some-org", "test cluster 3", nil),
+				newAWSClusterResource("1sad2", "2021-01-01T15:04:32Z", "10.5.0", "some-org", "test cluster 3", nil),
+				newCAPIV1alpha3Cluster("f930q", "default", "11.0.0", "some-other", "test cluster 4", nil),
+				newAWSClusterResource("f930q", "2021-01-02T15:04:32Z", "11.0.0", "some-other", "test cluster 4", []string{infrastructurev1alpha3.ClusterStatusConditionCreated}),
+				newCAPIV1alpha3Cluster("a2b3c", "default", "11.1.2", "some-org", "test cluster 5", nil),
+				newAWSClusterResource("a2b3c", "2021-01-03T15:04:32Z", "11.1.2", "some-org", "test cluster 5", []string{infrastructurev1alpha3.ClusterStatusConditionCreating}),
+			},
+			args:               nil,
+			release:            "11.x",
+			expectedGoldenFile: "run_get_clusters_by_release.golden",
+		},
+		{
+			name: "case 9: get clusters, filtered by label selector",
+			storage: []runtime.Object{
+				newCAPIV1alpha3Cluster("1sad2", "default", "10.5.0", "some-org", "test cluster 3", nil),
+				newAWSClusterResource("1sad2", "2021-01-01T15:04:32Z", "10.5.0", "some-org", "test cluster 3", nil),
+				newCAPIV1alpha3Cluster("f930q", "default", "11.0.0", "some-other", "test cluster 4", nil),
+				newAWSClusterResource("f930q", "2021-01-02T15:04:32Z", "11.0.0", "some-other", "test cluster 4", []string{infrastructurev1alpha3.ClusterStatusConditionCreated}),
+				newCAPIV1alpha3Cluster("a2b3c", "default", "11.1.2", "some-org", "test cluster 5", nil),
+				newAWSClusterResource("a2b3c", "2021-01-03T15:04:32Z", "11.1.2", "some-org", "test cluster 5", []string{infrastructurev1alpha3.ClusterStatusConditionCreating}),
+			},
+			args:               nil,
+			selector:           "release.giantswarm.io/version!=11.0.0",
+			expectedGoldenFile: "run_get_clusters_by_selector.golden",
+		},
+		{
+			name: "case 10: get clusters, filtered by condition",
+			storage: []runtime.Object{
+				newCAPIV1alpha3Cluster("1sad2", "default", "10.5.0", "some-org", "test cluster 3", nil),
+				newAWSClusterResource("1sad2", "2021-01-01T15:04:32Z", "10.5.0", "some-org", "test cluster 3", nil),
+				newCAPIV1alpha3Cluster("f930q", "default", "11.0.0", "some-other", "test cluster 4", nil),
+				newAWSClusterResource("f930q", "2021-01-02T15:04:32Z", "11.0.0", "some-other", "test cluster 4", []string{infrastructurev1alpha3.ClusterStatusConditionCreated}),
+				newCAPIV1alpha3Cluster("a2b3c", "default", "11.1.2", "some-org", "test cluster 5", nil),
+				newAWSClusterResource("a2b3c", "2021-01-03T15:04:32Z", "11.1.2", "some-org", "test cluster 5", []string{infrastructurev1alpha3.ClusterStatusConditionCreating}),
+			},
+			args:               nil,
+			condition:          "created",
+			expectedGoldenFile: "run_get_clusters_by_condition.golden",
+		},
+		{
+			name: "case 11: get clusters, filtered by organization and release",
+			storage: []runtime.Object{
+				newCAPIV1alpha3Cluster("1sad2", "default", "10.5.0", "some-org", "test cluster 3", nil),
+				newAWSClusterResource("1sad2", "2021-01-01T15:04:32Z", "10.5.0", "some-org", "test cluster 3", nil),
+				newCAPIV1alpha3Cluster("f930q", "default", "11.0.0", "some-other", "test cluster 4", nil),
+				newAWSClusterResource("f930q", "2021-01-02T15:04:32Z", "11.0.0", "some-other", "test cluster 4", []string{infrastructurev1alpha3.ClusterStatusConditionCreated}),
+				newCAPIV1alpha3Cluster("a2b3c", "default", "11.1.2", "some-org", "test cluster 5", nil),
+				newAWSClusterResource("a2b3c", "2021-01-03T15:04:32Z", "11.1.2", "some-org", "test cluster 5", []string{infrastructurev1alpha3.ClusterStatusConditionCreating}),
+			},
+			args:               nil,
+			organization:       "some-org",
+			release:            ">=11.0.0",
+			expectedGoldenFile: "run_get_clusters_by_organization_and_release.golden",
+		},
+		{
+			name: "case 12: get clusters, with filters not matching any cluster",
+			storage: []runtime.Object{
+				newCAPIV1alpha3Cluster("1sad2", "default", "10.5.0", "some-org", "test cluster 3", nil),
+				newAWSClusterResource("1sad2", "2021-01-01T15:04:32Z", "10.5.0", "some-org", "test cluster 3", nil),
+				newCAPIV1alpha3Cluster("f930q", "default", "11.0.0", "some-other", "test cluster 4", nil),
+				newAWSClusterResource("f930q", "2021-01-02T15:04:32Z", "11.0.0", "some-other", "test cluster 4", []string{infrastructurev1alpha3.ClusterStatusConditionCreated}),
+				newCAPIV1alpha3Cluster("a2b3c", "default", "11.1.2", "some-org", "test cluster 5", nil),
+				newAWSClusterResource("a2b3c", "2021-01-03T15:04:32Z", "11.1.2", "some-org", "test cluster 5", []string{infrastructurev1alpha3.ClusterStatusConditionCreating}),
+			},
+			args:                  nil,
+			organization:          "unknown",
+			expectedErrGoldenFile: "run_get_clusters_empty_storage.golden",
+		},
+		{
+			name: "case 13: get cluster by id, with filters",
+			storage: []runtime.Object{
+				newCAPIV1alpha3Cluster("1sad2", "default", "10.5.0", "some-org", "test cluster 3", nil),
+				newAWSClusterResource("1sad2", "2021-01-01T15:04:32Z", "10.5.0", "some-org", "test cluster 3", nil),
+				newCAPIV1alpha3Cluster("f930q", "default", "11.0.0", "some-other", "test cluster 4", nil),
+				newAWSClusterResource("f930q", "2021-01-02T15:04:32Z", "11.0.0", "some-other", "test cluster 4", []string{infrastructurev1alpha3.ClusterStatusConditionCreated}),
+				newCAPIV1alpha3Cluster("a2b3c", "default", "11.1.2", "some-org", "test cluster 5", nil),
+				newAWSClusterResource("a2b3c", "2021-01-03T15:04:32Z", "11.1.2", "some-org", "test cluster 5", []string{infrastructurev1alpha3.ClusterStatusConditionCreating}),
+			},
+			args:         []string{"f930q"},
+			organization: "some-org",
+			errorMatcher: IsInvalidFlag,
+		},
+		{
+			name: "case 14: get clusters, sorted by release",
+			storage: []runtime.Object{
+				newCAPIV1alpha3Cluster("1sad2", "default", "12.0.0", "some-org", "test cluster 3", nil),
+				newAWSClusterResource("1sad2", "2021-01-01T15:04:32Z", "12.0.0", "some-org", "test cluster 3", nil),
+				newCAPIV1alpha3Cluster("f930q", "default", "11.0.0", "some-other", "test cluster 4", nil),
+				newAWSClusterResource("f930q", "2021-01-02T15:04:32Z", "11.0.0", "some-other", "test cluster 4", nil),
+			},
+			args:               nil,
+			sortBy:             "release",
+			expectedGoldenFile: "run_get_clusters_sorted_by_release.golden",
+		},
+		{
+			name: "case 15: get clusters, with wide output",
+			storage: []runtime.Object{
+				newCAPIV1alpha3Cluster("1sad2", "default", "10.5.0", "some-org", "test cluster 3", nil),
+				newAWSClusterResource("1sad2", "2021-01-01T15:04:32Z", "10.5.0", "some-org", "test cluster 3", nil),
+				newCAPIV1alpha3Cluster("f930q", "default", "11.0.0", "some-other", "test cluster 4", nil),
+				newAWSClusterResource("f930q", "2021-01-02T15:04:32Z", "11.0.0", "some-other", "test cluster 4", nil),
+			},
+			args:               nil,
+			outputFormat:       output.TypeWide,
+			expectedGoldenFile: "run_get_clusters_wide.golden",
+		},
+		{
+			name: "case 16: get clusters, sorted by unknown column",
+			storage: []runtime.Object{
+				newCAPIV1alpha3Cluster("1sad2", "default", "10.5.0", "some-org", "test cluster 3", nil),
+				newAWSClusterResource("1sad2", "2021-01-01T15:04:32Z", "10.5.0", "some-org", "test cluster 3", nil),
+			},
+			args:         nil,
+			sortBy:       "unknown",
+			errorMatcher: output.IsInvalidColumn,
+		},
+		{
+			name:                  "case 17: get clusters, with empty storage, and JSON output",
+			storage:               nil,
+			args:                  nil,
+			outputFormat:          output.TypeJSON,
+			expectedGoldenFile:    "run_get_clusters_empty_storage_json_output.golden",
+			expectedErrGoldenFile: "run_get_clusters_empty_storage.golden",
+		},
+		{
+			name:                  "case 18: get clusters, with empty storage, and name output",
+			storage:               nil,
+			args:                  nil,
+			outputFormat:          output.TypeName,
+			expectedErrGoldenFile: "run_get_clusters_empty_storage.golden",
+		},
+		{
+			name: "case 19: get clusters, sorted by age",
+			storage: []runtime.Object{
+				newCAPIV1alpha3Cluster("f930q", "default", "11.0.0", "some-other", "test cluster 4", nil),
+				newAWSClusterResource("f930q", "2021-01-02T15:04:32Z", "11.0.0", "some-other", "test cluster 4", nil),
+				newCAPIV1alpha3Cluster("9k2l1", "default", "12.0.0", "some-org", "test cluster 5", nil),
+				newAWSClusterResource("9k2l1", "2021-01-03T15:04:32Z", "12.0.0", "some-org", "test cluster 5", nil),
+				newCAPIV1alpha3Cluster("1sad2", "default", "10.5.0", "some-org", "test cluster 3", nil),
+				newAWSClusterResource("1sad2", "2021-01-01T15:04:32Z", "10.5.0", "some-org", "test cluster 3", nil),
+			},
+			args:               nil,
+			sortBy:             "age",
+			expectedGoldenFile: "run_get_clusters_sorted_by_age.golden",
+		},
+		{
+			name: "case 20: get clusters, with wide output, and creators",
+			storage: []runtime.Object{
+				newCAPIV1alpha3Cluster("1sad2", "default", "10.5.0", "some-org", "test cluster 3", nil),
+				withCreatedBy(newAWSClusterResource("1sad2", "2021-01-01T15:04:32Z", "10.5.0", "some-org", "test cluster 3", nil), "jane@example.com", ""),
+				newCAPIV1alpha3Cluster("f930q", "default", "11.0.0", "some-other", "test cluster 4", nil),
+				withCreatedBy(newAWSClusterResource("f930q", "2021-01-02T15:04:32Z", "11.0.0", "some-other", "test cluster 4", nil), "", "kubectl-client-side-apply"),
+				newCAPIV1alpha3Cluster("9k2l1", "default", "12.0.0", "", "test cluster 5", nil),
+				newAWSClusterResource("9k2l1", "2021-01-03T15:04:32Z", "12.0.0", "", "test cluster 5", nil),
+			},
+			args:               nil,
+			outputFormat:       output.TypeWide,
+			expectedGoldenFile: "run_get_clusters_wide_with_creators.golden",
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.TODO()
 
+			outputFormat := output.TypeDefault
+			if len(tc.outputFormat) > 0 {
+				outputFormat = tc.outputFormat
+			}
+
 			fakeKubeConfig := kubeconfig.CreateFakeKubeConfig()
 			flag := &flag{
-				print:  genericclioptions.NewPrintFlags("").WithDefaultOutput(output.TypeDefault),
+				print:  genericclioptions.NewPrintFlags("").WithDefaultOutput(outputFormat),
 				config: genericclioptions.NewTestConfigFlags().WithClientConfig(fakeKubeConfig),
+
+				Selector:     tc.selector,
+				Organization: tc.organization,
+				Release:      tc.release,
+				Condition:    tc.condition,
+				Timestamps:   output.TimestampsAbsolute,
+				Watch:        tc.watch,
+				WatchOnly:    tc.watchOnly,
+				SortBy:       tc.sortBy,
 			}
 			out := new(bytes.Buffer)
+			errOut := new(bytes.Buffer)
 			runner := &runner{
 				service:  cluster.NewFakeService(tc.storage),
 				flag:     flag,
 				stdout:   out,
+				stderr:   errOut,
 				provider: key.ProviderAWS,
 			}
 
@@ -103,27 +332,59 @@ func Test_run(t *testing.T) {
 				t.Fatalf("unexpected error: %s", err.Error())
 			}
 
-			var expectedResult []byte
-			{
-				gf := goldenfile.New("testdata", tc.expectedGoldenFile)
-				if *update {
-					err = gf.Update(out.Bytes())
-					if err != nil {
-						t.Fatalf("unexpected error: %s", err.Error())
-					}
-					expectedResult = out.Bytes()
-				} else {
-					expectedResult, err = gf.Read()
-					if err != nil {
-						t.Fatalf("unexpected error: %s", err.Error())
-					}
-				}
-			}
+			assertGoldenFile(t, tc.expectedGoldenFile, out.Bytes())
+			assertGoldenFile(t, tc.expectedErrGoldenFile, errOut.Bytes())
+		})
+	}
+}
+
+// withCreatedBy records who created the cluster, either
+// in the created-by annotation or in its managed fields.
+func withCreatedBy(c *infrastructurev1alpha3.AWSCluster, createdBy, manager string) *infrastructurev1alpha3.AWSCluster {
+	if len(createdBy) > 0 {
+		c.Annotations = map[string]string{annotation.CreatedBy: createdBy}
+	}
+	if len(manager) > 0 {
+		created := c.CreationTimestamp
+		c.ManagedFields = []metav1.ManagedFieldsEntry{
+			{Manager: "cluster-operator", Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: created.Add(time.Minute)}},
+			{Manager: manager, Operation: metav1.ManagedFieldsOperationUpdate, Time: &created},
+		}
+	}
+
+	return c
+}
+
+func assertGoldenFile(t *testing.T, name string, actual []byte) {
+	var err error
+
+	if len(name) < 1 {
+		if len(actual) > 0 {
+			t.Fatalf("unexpected output, got:\n %s", actual)
+		}
 
-			diff := cmp.Diff(string(expectedResult), out.String())
-			if diff != "" {
-				t.Fatalf("value not expected, got:\n %s", diff)
+		return
+	}
+
+	var expectedResult []byte
+	{
+		gf := goldenfile.New("testdata", name)
+		if *update {
+			err = gf.Update(actual)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
 			}
-		})
+			expectedResult = actual
+		} else {
+			expectedResult, err = gf.Read()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+		}
+	}
+
+	diff := cmp.Diff(string(expectedResult), string(actual))
+	if diff != "" {
+		t.Fatalf("value not expected, got:\n %s", diff)
 	}
 }
@@ -12,8 +12,13 @@ import (
 
 	"github.com/giantswarm/kubectl-gs/cmd/get/apps"
 	"github.com/giantswarm/kubectl-gs/cmd/get/capi"
+	"github.com/giantswarm/kubectl-gs/cmd/get/catalogentries"
 	"github.com/giantswarm/kubectl-gs/cmd/get/catalogs"
+	"github.com/giantswarm/kubectl-gs/cmd/get/clusterhealth"
 	"github.com/giantswarm/kubectl-gs/cmd/get/clusters"
+	"github.com/giantswarm/kubectl-gs/cmd/get/events"
+	"github.com/giantswarm/kubectl-gs/cmd/get/kubeconfig"
+	"github.com/giantswarm/kubectl-gs/cmd/get/machines"
 	"github.com/giantswarm/kubectl-gs/cmd/get/nodepools"
 )
 
@@ -69,6 +74,24 @@ func New(config Config) (*cobra.Command, error) {
 		}
 	}
 
+	var catalogEntriesCmd *cobra.Command
+	{
+		c := catalogentries.Config{
+			Logger:     config.Logger,
+			FileSystem: config.FileSystem,
+
+			K8sConfigAccess: config.K8sConfigAccess,
+
+			Stderr: config.Stderr,
+			Stdout: config.Stdout,
+		}
+
+		catalogEntriesCmd, err = catalogentries.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var catalogsCmd *cobra.Command
 	{
 		c := catalogs.Config{
@@ -101,6 +124,24 @@ func New(config Config) (*cobra.Command, error) {
 		}
 	}
 
+	var clusterHealthCmd *cobra.Command
+	{
+		c := clusterhealth.Config{
+			Logger:     config.Logger,
+			FileSystem: config.FileSystem,
+
+			K8sConfigAccess: config.K8sConfigAccess,
+
+			Stderr: config.Stderr,
+			Stdout: config.Stdout,
+		}
+
+		clusterHealthCmd, err = clusterhealth.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var clustersCmd *cobra.Command
 	{
 		c := clusters.Config{
@@ -119,6 +160,60 @@ func New(config Config) (*cobra.Command, error) {
 		}
 	}
 
+	var eventsCmd *cobra.Command
+	{
+		c := events.Config{
+			Logger:     config.Logger,
+			FileSystem: config.FileSystem,
+
+			K8sConfigAccess: config.K8sConfigAccess,
+
+			Stderr: config.Stderr,
+			Stdout: config.Stdout,
+		}
+
+		eventsCmd, err = events.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var kubeconfigCmd *cobra.Command
+	{
+		c := kubeconfig.Config{
+			Logger:     config.Logger,
+			FileSystem: config.FileSystem,
+
+			K8sConfigAccess: config.K8sConfigAccess,
+
+			Stderr: config.Stderr,
+			Stdout: config.Stdout,
+		}
+
+		kubeconfigCmd, err = kubeconfig.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var machinesCmd *cobra.Command
+	{
+		c := machines.Config{
+			Logger:     config.Logger,
+			FileSystem: config.FileSystem,
+
+			K8sConfigAccess: config.K8sConfigAccess,
+
+			Stderr: config.Stderr,
+			Stdout: config.Stdout,
+		}
+
+		machinesCmd, err = machines.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var nodepoolsCmd *cobra.Command
 	{
 		c := nodepools.Config{
@@ -156,9 +251,14 @@ func New(config Config) (*cobra.Command, error) {
 	f.Init(c)
 
 	c.AddCommand(appsCmd)
+	c.AddCommand(catalogEntriesCmd)
 	c.AddCommand(catalogsCmd)
 	c.AddCommand(clusterApiCmd)
+	c.AddCommand(clusterHealthCmd)
 	c.AddCommand(clustersCmd)
+	c.AddCommand(eventsCmd)
+	c.AddCommand(kubeconfigCmd)
+	c.AddCommand(machinesCmd)
 	c.AddCommand(nodepoolsCmd)
 
 	return c, nil
@@ -0,0 +1,101 @@
+package events
+
+import (
+	"io"
+	"os"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/giantswarm/kubectl-gs/pkg/middleware"
+	"github.com/giantswarm/kubectl-gs/pkg/middleware/renewtoken"
+)
+
+const (
+	name  = "events"
+	alias = "event"
+
+	shortDescription = "Display the events of a cluster"
+	longDescription  = `Display the events of a cluster
+
+Collects the resources that belong to a workload cluster, such as its
+cluster, control plane, node pool and machine resources, and its apps, and
+lists the events about any of them, oldest first.
+
+Output columns:
+
+- LAST SEEN: How long ago the event last occurred.
+- TYPE: Type of the event, e.g. Normal or Warning.
+- KIND: Kind of the resource the event is about.
+- NAME: Name of the resource the event is about.
+- REASON: Short reason of the event.
+- MESSAGE: Description of the event.`
+
+	examples = `  # List the events of a cluster
+  kubectl gs get events --cluster-name f83ir
+
+  # List the events of a cluster that occurred within the last 30 minutes
+  kubectl gs get events --cluster-name f83ir --since 30m
+
+  # List the events of a cluster, and keep watching for new ones
+  kubectl gs get events --cluster-name f83ir --watch`
+)
+
+type Config struct {
+	Logger     micrologger.Logger
+	FileSystem afero.Fs
+
+	K8sConfigAccess clientcmd.ConfigAccess
+
+	Stderr io.Writer
+	Stdout io.Writer
+}
+
+func New(config Config) (*cobra.Command, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.FileSystem == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.FileSystem must not be empty", config)
+	}
+	if config.K8sConfigAccess == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sConfigAccess must not be empty", config)
+	}
+	if config.Stderr == nil {
+		config.Stderr = os.Stderr
+	}
+	if config.Stdout == nil {
+		config.Stdout = os.Stdout
+	}
+
+	f := &flag{}
+
+	r := &runner{
+		flag:   f,
+		logger: config.Logger,
+		fs:     config.FileSystem,
+
+		stderr: config.Stderr,
+		stdout: config.Stdout,
+	}
+
+	c := &cobra.Command{
+		Use:     name,
+		Short:   shortDescription,
+		Long:    longDescription,
+		Example: examples,
+		Aliases: []string{alias},
+		Args:    cobra.NoArgs,
+		RunE:    r.Run,
+		PreRunE: middleware.Compose(
+			renewtoken.Middleware(config.K8sConfigAccess),
+		),
+	}
+
+	f.Init(c)
+
+	return c, nil
+}
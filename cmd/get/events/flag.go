@@ -0,0 +1,62 @@
+package events
+
+import (
+	"strings"
+	"time"
+
+	"github.com/giantswarm/microerror"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+)
+
+const (
+	flagAllNamespaces = "all-namespaces"
+	flagClusterName   = "cluster-name"
+	flagSince         = "since"
+	flagTimestamps    = "timestamps"
+	flagWatch         = "watch"
+)
+
+type flag struct {
+	AllNamespaces bool
+	Cluster       string
+	Since         time.Duration
+	Timestamps    string
+	Watch         bool
+
+	config genericclioptions.RESTClientGetter
+	print  *genericclioptions.PrintFlags
+}
+
+func (f *flag) Init(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&f.AllNamespaces, flagAllNamespaces, "A", false, "If present, look for the resources of the cluster across all namespaces. Namespace in current context is ignored even if specified with --namespace.")
+	cmd.Flags().StringVarP(&f.Cluster, flagClusterName, "c", "", "Name of the cluster to show the events of.")
+	cmd.Flags().DurationVar(&f.Since, flagSince, 0, "Only show events that occurred within this duration, e.g. '30m' or '2h'. All events are shown if not set.")
+	cmd.Flags().BoolVarP(&f.Watch, flagWatch, "w", false, "After listing the events, watch for new ones.")
+	cmd.Flags().StringVar(&f.Timestamps, flagTimestamps, output.TimestampsRelative, "Print timestamps in table output as the time elapsed since then (relative) or as dates in UTC (absolute).")
+
+	f.config = genericclioptions.NewConfigFlags(true)
+	f.print = genericclioptions.NewPrintFlags("")
+
+	// Merging current command flags and config flags,
+	// to be able to override kubectl-specific ones.
+	f.config.(*genericclioptions.ConfigFlags).AddFlags(cmd.Flags())
+	f.print.AddFlags(cmd)
+	output.AddTableFormatsUsage(cmd)
+}
+
+func (f *flag) Validate() error {
+	if len(f.Cluster) < 1 {
+		return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagClusterName)
+	}
+	if f.Since < 0 {
+		return microerror.Maskf(invalidFlagError, "--%s must not be negative", flagSince)
+	}
+	if !output.IsTimestampFormat(f.Timestamps) {
+		return microerror.Maskf(invalidFlagError, "--%s must be one of %s", flagTimestamps, strings.Join(output.TimestampFormats, ", "))
+	}
+
+	return nil
+}
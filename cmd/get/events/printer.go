@@ -0,0 +1,142 @@
+package events
+
+import (
+	"fmt"
+
+	"github.com/giantswarm/microerror"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/event"
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+)
+
+func (r *runner) printOutput(eventResource event.Resource) error {
+	var (
+		err      error
+		printer  printers.ResourcePrinter
+		resource runtime.Object
+	)
+
+	switch {
+	case output.IsOutputTable(r.flag.print.OutputFormat):
+		tableOptions := output.TableOptions{
+			OutputFormat:  r.flag.print.OutputFormat,
+			WithNamespace: r.flag.AllNamespaces,
+		}
+		if r.tablePrinter == nil {
+			r.tablePrinter = output.NewTablePrinter(r.stdout)
+		}
+		err = r.tablePrinter.PrintTable(getTable(eventResource, r.flag.Timestamps), tableOptions)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+
+	case output.IsOutputName(r.flag.print.OutputFormat):
+		resource = eventResource.Object()
+		err = output.PrintResourceNames(r.stdout, resource)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+
+	default:
+		resource = eventResource.Object()
+		printer, err = r.flag.print.ToPrinter()
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	err = printer.PrintObj(resource, r.stdout)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *runner) printNoResourcesOutput() error {
+	fmt.Fprintf(r.stderr, "No events found.\n")
+	fmt.Fprintf(r.stderr, "Events are only kept for a limited time, usually one hour.\n")
+
+	err := output.PrintEmptyList(r.stdout, r.flag.print)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func getTable(eventResource event.Resource, timestamps string) *metav1.Table {
+	// Creating a custom table resource.
+	table := &metav1.Table{}
+
+	table.ColumnDefinitions = []metav1.TableColumnDefinition{
+		{Name: "Last Seen", Type: "string"},
+		{Name: "Type", Type: "string"},
+		{Name: "Kind", Type: "string"},
+		{Name: "Name", Type: "string"},
+		{Name: "Reason", Type: "string"},
+		{Name: "Message", Type: "string"},
+		{Name: "Count", Type: "integer", Priority: 1},
+		{Name: "Source", Type: "string", Priority: 1},
+	}
+
+	switch e := eventResource.(type) {
+	case *event.Event:
+		table.Rows = append(table.Rows, getEventRow(*e, timestamps))
+	case *event.Collection:
+		for _, eventItem := range e.Items {
+			table.Rows = append(table.Rows, getEventRow(eventItem, timestamps))
+		}
+	}
+
+	return table
+}
+
+func getEventRow(e event.Event, timestamps string) metav1.TableRow {
+	if e.Event == nil {
+		return metav1.TableRow{}
+	}
+
+	count := e.Event.Count
+	if count < 1 {
+		count = 1
+	}
+
+	return metav1.TableRow{
+		Cells: []interface{}{
+			output.NewTimestamp(metav1.NewTime(event.LastSeen(e.Event)), timestamps),
+			e.Event.Type,
+			e.Event.InvolvedObject.Kind,
+			e.Event.InvolvedObject.Name,
+			e.Event.Reason,
+			e.Event.Message,
+			count,
+			formatSource(e),
+		},
+		Object: runtime.RawExtension{
+			Object: e.Event,
+		},
+	}
+}
+
+func formatSource(e event.Event) string {
+	source := e.Event.Source.Component
+	if len(source) < 1 {
+		source = e.Event.ReportingController
+	}
+	if len(source) < 1 {
+		return "n/a"
+	}
+	if len(e.Event.Source.Host) > 0 {
+		source += ", " + e.Event.Source.Host
+	}
+
+	return source
+}
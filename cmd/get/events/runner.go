@@ -0,0 +1,140 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/kubectl-gs/pkg/commonconfig"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/event"
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+)
+
+type runner struct {
+	flag   *flag
+	logger micrologger.Logger
+	fs     afero.Fs
+
+	provider string
+	service  event.Interface
+
+	// tablePrinter prints all the tables of the command, so that watch events
+	// only add new rows, aligned with the headers.
+	tablePrinter *output.TablePrinter
+
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func (r *runner) Run(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	err := r.flag.Validate()
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = r.run(ctx, cmd, args)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) error {
+	var err error
+
+	config := commonconfig.New(r.flag.config)
+	{
+		if r.provider == "" {
+			r.provider, err = config.GetProvider()
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+
+		err = r.getService(config)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	var options event.GetOptions
+	{
+		options = event.GetOptions{
+			ClusterName: strings.ToLower(r.flag.Cluster),
+			Provider:    r.provider,
+		}
+
+		if r.flag.Since > 0 {
+			options.Since = time.Now().Add(-r.flag.Since)
+		}
+
+		if r.flag.AllNamespaces {
+			options.Namespace = metav1.NamespaceAll
+		} else {
+			options.Namespace, _, err = r.flag.config.ToRawKubeConfigLoader().Namespace()
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	}
+
+	eventResource, err := r.service.Get(ctx, options)
+	if event.IsNotFound(err) {
+		return microerror.Maskf(notFoundError, fmt.Sprintf("A cluster with name '%s' cannot be found.\n", options.ClusterName))
+	} else if event.IsNoResources(err) {
+		err = r.printNoResourcesOutput()
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	} else if err != nil {
+		return microerror.Mask(err)
+	} else {
+		err = r.printOutput(eventResource)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	if r.flag.Watch {
+		err = r.service.Watch(ctx, options, func(e *event.Event) error {
+			return r.printOutput(e)
+		})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *runner) getService(config *commonconfig.CommonConfig) error {
+	if r.service != nil {
+		return nil
+	}
+
+	client, err := config.GetClient(r.logger)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	serviceConfig := event.Config{
+		Client: client,
+	}
+	r.service, err = event.New(serviceConfig)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	goflag "flag"
+	"testing"
+	"time"
+
+	applicationv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/application/v1alpha1"
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	"github.com/giantswarm/apiextensions/v3/pkg/label"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/event"
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+	"github.com/giantswarm/kubectl-gs/test/goldenfile"
+	"github.com/giantswarm/kubectl-gs/test/kubeconfig"
+)
+
+var update = goflag.Bool("update", false, "update .golden reference test files")
+
+// Test_run uses golden files.
+//
+//  go test ./cmd/get/events -run Test_run -update
+//
+func Test_run(t *testing.T) {
+	now := time.Now()
+
+	storage := []runtime.Object{
+		&capiv1alpha3.Cluster{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "cluster.x-k8s.io/v1alpha3", Kind: "Cluster"},
+			ObjectMeta: newObjectMeta("s921a", "default", capiv1alpha3.ClusterLabelName, "s921a"),
+		},
+		&infrastructurev1alpha3.AWSCluster{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "infrastructure.giantswarm.io/v1alpha3", Kind: "AWSCluster"},
+			ObjectMeta: newObjectMeta("s921a", "default", label.Cluster, "s921a"),
+		},
+		&capiv1alpha3.MachineDeployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "cluster.x-k8s.io/v1alpha3", Kind: "MachineDeployment"},
+			ObjectMeta: newObjectMeta("a7k3e", "default", capiv1alpha3.ClusterLabelName, "s921a"),
+		},
+		&applicationv1alpha1.App{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "application.giantswarm.io/v1alpha1", Kind: "App"},
+			ObjectMeta: newObjectMeta("cert-manager", "s921a", label.Cluster, "s921a"),
+		},
+		&capiv1alpha3.Cluster{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "cluster.x-k8s.io/v1alpha3", Kind: "Cluster"},
+			ObjectMeta: newObjectMeta("f930q", "default", capiv1alpha3.ClusterLabelName, "f930q"),
+		},
+		newEvent("s921a.1", "default", "Cluster", "s921a", "Normal", "ClusterCreated", "Cluster has been created", now.Add(-2*time.Hour)),
+		newEvent("s921a.2", "default", "AWSCluster", "s921a", "Warning", "CFStackFailed", "CloudFormation stack failed to update", now.Add(-45*time.Minute)),
+		newEvent("a7k3e.1", "default", "MachineDeployment", "a7k3e", "Normal", "ScalingUp", "Scaling up node pool to 3 nodes", now.Add(-20*time.Minute)),
+		newEvent("cert-manager.1", "s921a", "App", "cert-manager", "Warning", "InstallFailed", "Chart installation failed", now.Add(-10*time.Minute)),
+		newEvent("f930q.1", "default", "Cluster", "f930q", "Normal", "ClusterCreated", "Cluster has been created", now.Add(-15*time.Minute)),
+		newEvent("web-0.1", "default", "Pod", "web-0", "Normal", "Pulled", "Image pulled", now.Add(-15*time.Minute)),
+	}
+
+	testCases := []struct {
+		name                  string
+		storage               []runtime.Object
+		cluster               string
+		since                 time.Duration
+		watch                 bool
+		outputType            string
+		expectedGoldenFile    string
+		expectedErrGoldenFile string
+		errorMatcher          func(error) bool
+	}{
+		{
+			name:               "case 0: get events of a cluster",
+			storage:            storage,
+			cluster:            "s921a",
+			expectedGoldenFile: "run_get_events.golden",
+		},
+		{
+			name:               "case 1: get events of a cluster, since a duration",
+			storage:            storage,
+			cluster:            "s921a",
+			since:              30 * time.Minute,
+			expectedGoldenFile: "run_get_events_since.golden",
+		},
+		{
+			name:               "case 2: get events of a cluster, with wide output",
+			storage:            storage,
+			cluster:            "s921a",
+			outputType:         output.TypeWide,
+			expectedGoldenFile: "run_get_events_wide.golden",
+		},
+		{
+			name:                  "case 3: get events of a cluster without recent events, and watch",
+			storage:               storage,
+			cluster:               "s921a",
+			since:                 5 * time.Minute,
+			watch:                 true,
+			expectedGoldenFile:    "run_get_events_watch.golden",
+			expectedErrGoldenFile: "run_get_events_empty.golden",
+		},
+		{
+			name:         "case 4: get events of a cluster that doesn't exist",
+			storage:      storage,
+			cluster:      "unknown",
+			errorMatcher: IsNotFound,
+		},
+		{
+			name:                  "case 5: get events of a cluster without recent events, with JSON output",
+			storage:               storage,
+			cluster:               "s921a",
+			since:                 5 * time.Minute,
+			outputType:            output.TypeJSON,
+			expectedGoldenFile:    "run_get_events_empty_json_output.golden",
+			expectedErrGoldenFile: "run_get_events_empty.golden",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			outputType := output.TypeDefault
+			if len(tc.outputType) > 0 {
+				outputType = tc.outputType
+			}
+
+			fakeKubeConfig := kubeconfig.CreateFakeKubeConfig()
+			flag := &flag{
+				print:  genericclioptions.NewPrintFlags("").WithDefaultOutput(outputType),
+				config: genericclioptions.NewTestConfigFlags().WithClientConfig(fakeKubeConfig),
+
+				Cluster: tc.cluster,
+				Since:   tc.since,
+				Watch:   tc.watch,
+			}
+			out := new(bytes.Buffer)
+			errOut := new(bytes.Buffer)
+			runner := &runner{
+				service:  event.NewFakeService(tc.storage),
+				flag:     flag,
+				stdout:   out,
+				stderr:   errOut,
+				provider: key.ProviderAWS,
+			}
+
+			err := runner.run(ctx, nil, nil)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			assertGoldenFile(t, tc.expectedGoldenFile, out.Bytes())
+			assertGoldenFile(t, tc.expectedErrGoldenFile, errOut.Bytes())
+		})
+	}
+}
+
+func assertGoldenFile(t *testing.T, name string, actual []byte) {
+	var err error
+
+	if len(name) < 1 {
+		if len(actual) > 0 {
+			t.Fatalf("unexpected output, got:\n %s", actual)
+		}
+
+		return
+	}
+
+	var expectedResult []byte
+	{
+		gf := goldenfile.New("testdata", name)
+		if *update {
+			err = gf.Update(actual)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			expectedResult = actual
+		} else {
+			expectedResult, err = gf.Read()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+		}
+	}
+
+	diff := cmp.Diff(string(expectedResult), string(actual))
+	if diff != "" {
+		t.Fatalf("value not expected, got:\n %s", diff)
+	}
+}
+
+var apiVersions = map[string]string{
+	"App":               "application.giantswarm.io/v1alpha1",
+	"AWSCluster":        "infrastructure.giantswarm.io/v1alpha3",
+	"Cluster":           "cluster.x-k8s.io/v1alpha3",
+	"MachineDeployment": "cluster.x-k8s.io/v1alpha3",
+	"Pod":               "v1",
+}
+
+func newObjectMeta(name, namespace, labelName, clusterName string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: namespace,
+		Labels: map[string]string{
+			labelName: clusterName,
+		},
+	}
+}
+
+func newEvent(name, namespace, kind, objectName, eventType, reason, message string, lastSeen time.Time) *corev1.Event {
+	return &corev1.Event{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Event",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: apiVersions[kind],
+			Kind:       kind,
+			Namespace:  namespace,
+			Name:       objectName,
+		},
+		Type:          eventType,
+		Reason:        reason,
+		Message:       message,
+		Count:         1,
+		LastTimestamp: metav1.NewTime(lastSeen),
+		Source: corev1.EventSource{
+			Component: "cluster-operator",
+		},
+	}
+}
@@ -0,0 +1,100 @@
+package kubeconfig
+
+import (
+	"io"
+	"os"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/giantswarm/kubectl-gs/pkg/middleware"
+	"github.com/giantswarm/kubectl-gs/pkg/middleware/renewtoken"
+)
+
+const (
+	name = "kubeconfig <cluster-name>"
+
+	shortDescription = "Get the kubeconfig of a workload cluster"
+	longDescription  = `Get the kubeconfig of a workload cluster
+
+Reads the kubeconfig that Cluster API stores in the <cluster-name>-kubeconfig
+secret on the management cluster. The secret is looked up in the namespace
+of the current context, and in the namespace named after the cluster.
+
+The context, cluster and user of the kubeconfig are renamed after the
+management cluster's installation and the workload cluster, e.g.
+'gs-gauss-f83ir', so that they don't collide with the ones of other clusters.
+
+By default the kubeconfig is printed. It can also be written to a file, or
+merged into your kubeconfig. As these kubeconfigs usually hold admin
+credentials, merging them requires the --allow-admin flag.`
+
+	examples = `  # Print the kubeconfig of a workload cluster
+  kubectl gs get kubeconfig f83ir
+
+  # Write the kubeconfig of a workload cluster in another namespace to a file
+  kubectl gs get kubeconfig f83ir --namespace org-acme --output f83ir.yaml
+
+  # Merge the kubeconfig of a workload cluster, holding admin credentials, into yours
+  kubectl gs get kubeconfig f83ir --merge --allow-admin`
+)
+
+type Config struct {
+	Logger     micrologger.Logger
+	FileSystem afero.Fs
+
+	K8sConfigAccess clientcmd.ConfigAccess
+
+	Stderr io.Writer
+	Stdout io.Writer
+}
+
+func New(config Config) (*cobra.Command, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.FileSystem == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.FileSystem must not be empty", config)
+	}
+	if config.K8sConfigAccess == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sConfigAccess must not be empty", config)
+	}
+	if config.Stderr == nil {
+		config.Stderr = os.Stderr
+	}
+	if config.Stdout == nil {
+		config.Stdout = os.Stdout
+	}
+
+	f := &flag{}
+
+	r := &runner{
+		flag:   f,
+		logger: config.Logger,
+		fs:     config.FileSystem,
+
+		k8sConfigAccess: config.K8sConfigAccess,
+
+		stderr: config.Stderr,
+		stdout: config.Stdout,
+	}
+
+	c := &cobra.Command{
+		Use:     name,
+		Short:   shortDescription,
+		Long:    longDescription,
+		Example: examples,
+		Args:    cobra.ExactArgs(1),
+		RunE:    r.Run,
+		PreRunE: middleware.Compose(
+			renewtoken.Middleware(config.K8sConfigAccess),
+		),
+	}
+
+	f.Init(c)
+
+	return c, nil
+}
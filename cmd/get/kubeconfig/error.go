@@ -0,0 +1,57 @@
+package kubeconfig
+
+import "github.com/giantswarm/microerror"
+
+var invalidConfigError = &microerror.Error{
+	Kind: "invalidConfigError",
+}
+
+// IsInvalidConfig asserts invalidConfigError.
+func IsInvalidConfig(err error) bool {
+	return microerror.Cause(err) == invalidConfigError
+}
+
+var invalidFlagError = &microerror.Error{
+	Kind: "invalidFlagError",
+}
+
+// IsInvalidFlag asserts invalidFlagError.
+func IsInvalidFlag(err error) bool {
+	return microerror.Cause(err) == invalidFlagError
+}
+
+var notFoundError = &microerror.Error{
+	Kind: "notFoundError",
+}
+
+// IsNotFound asserts notFoundError.
+func IsNotFound(err error) bool {
+	return microerror.Cause(err) == notFoundError
+}
+
+var invalidKubeconfigError = &microerror.Error{
+	Kind: "invalidKubeconfigError",
+}
+
+// IsInvalidKubeconfig asserts invalidKubeconfigError.
+func IsInvalidKubeconfig(err error) bool {
+	return microerror.Cause(err) == invalidKubeconfigError
+}
+
+var adminCredentialsError = &microerror.Error{
+	Kind: "adminCredentialsError",
+}
+
+// IsAdminCredentials asserts adminCredentialsError.
+func IsAdminCredentials(err error) bool {
+	return microerror.Cause(err) == adminCredentialsError
+}
+
+var unknownInstallationError = &microerror.Error{
+	Kind: "unknownInstallationError",
+}
+
+// IsUnknownInstallation asserts unknownInstallationError.
+func IsUnknownInstallation(err error) bool {
+	return microerror.Cause(err) == unknownInstallationError
+}
@@ -0,0 +1,41 @@
+package kubeconfig
+
+import (
+	"github.com/giantswarm/microerror"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+const (
+	flagAllowAdmin = "allow-admin"
+	flagMerge      = "merge"
+	flagOutput     = "output"
+)
+
+type flag struct {
+	AllowAdmin bool
+	Merge      bool
+	Output     string
+
+	config genericclioptions.RESTClientGetter
+}
+
+func (f *flag) Init(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&f.AllowAdmin, flagAllowAdmin, false, "Allow merging a kubeconfig that holds admin credentials into yours.")
+	cmd.Flags().BoolVar(&f.Merge, flagMerge, false, "Merge the kubeconfig into yours, instead of printing it.")
+	cmd.Flags().StringVar(&f.Output, flagOutput, "", "Write the kubeconfig to this file, instead of printing it.")
+
+	f.config = genericclioptions.NewConfigFlags(true)
+
+	// Merging current command flags and config flags,
+	// to be able to override kubectl-specific ones.
+	f.config.(*genericclioptions.ConfigFlags).AddFlags(cmd.Flags())
+}
+
+func (f *flag) Validate() error {
+	if f.Merge && len(f.Output) > 0 {
+		return microerror.Maskf(invalidFlagError, "--%s and --%s cannot be used together", flagMerge, flagOutput)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package kubeconfig
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/giantswarm/microerror"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// adminGroup is the group that grants
+	// unrestricted access to a cluster.
+	adminGroup = "system:masters"
+)
+
+// rename returns a copy of the given kubeconfig, only holding its current
+// context, and the cluster and user it refers to, all named after the
+// given context name. It also returns whether the user is an admin.
+func rename(config *clientcmdapi.Config, contextName string) (*clientcmdapi.Config, bool, error) {
+	currentContext := config.CurrentContext
+	if len(currentContext) < 1 && len(config.Contexts) == 1 {
+		for name := range config.Contexts {
+			currentContext = name
+		}
+	}
+
+	context, exists := config.Contexts[currentContext]
+	if !exists {
+		return nil, false, microerror.Maskf(invalidKubeconfigError, "the kubeconfig has no current context")
+	}
+	cluster, exists := config.Clusters[context.Cluster]
+	if !exists {
+		return nil, false, microerror.Maskf(invalidKubeconfigError, "the kubeconfig has no cluster named %#q", context.Cluster)
+	}
+	authInfo, exists := config.AuthInfos[context.AuthInfo]
+	if !exists {
+		return nil, false, microerror.Maskf(invalidKubeconfigError, "the kubeconfig has no user named %#q", context.AuthInfo)
+	}
+
+	admin, err := isAdmin(authInfo)
+	if err != nil {
+		return nil, false, microerror.Mask(err)
+	}
+	userName := contextName + "-user"
+	if admin {
+		userName = contextName + "-admin"
+	}
+
+	renamedContext := context.DeepCopy()
+	renamedContext.Cluster = contextName
+	renamedContext.AuthInfo = userName
+
+	renamed := clientcmdapi.NewConfig()
+	renamed.Clusters[contextName] = cluster.DeepCopy()
+	renamed.AuthInfos[userName] = authInfo.DeepCopy()
+	renamed.Contexts[contextName] = renamedContext
+	renamed.CurrentContext = contextName
+
+	return renamed, admin, nil
+}
+
+// isAdmin is true if the given user authenticates with a client
+// certificate of the group granting unrestricted access.
+func isAdmin(authInfo *clientcmdapi.AuthInfo) (bool, error) {
+	if len(authInfo.ClientCertificateData) < 1 {
+		return false, nil
+	}
+
+	block, _ := pem.Decode(authInfo.ClientCertificateData)
+	if block == nil {
+		return false, microerror.Maskf(invalidKubeconfigError, "the client certificate of the kubeconfig is not PEM encoded")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return false, microerror.Maskf(invalidKubeconfigError, "the client certificate of the kubeconfig cannot be parsed: %s", err)
+	}
+
+	for _, o := range cert.Subject.Organization {
+		if o == adminGroup {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// merge adds the current context of the given kubeconfig, and the
+// cluster and user it refers to, to the target kubeconfig, replacing
+// any existing entries with the same names.
+func merge(target, config *clientcmdapi.Config) {
+	context := config.Contexts[config.CurrentContext]
+
+	target.Clusters[context.Cluster] = config.Clusters[context.Cluster]
+	target.AuthInfos[context.AuthInfo] = config.AuthInfos[context.AuthInfo]
+	target.Contexts[config.CurrentContext] = context
+}
@@ -0,0 +1,207 @@
+package kubeconfig
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/tools/clientcmd"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/pkg/commonconfig"
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
+	gskubeconfig "github.com/giantswarm/kubectl-gs/pkg/kubeconfig"
+)
+
+type runner struct {
+	flag   *flag
+	logger micrologger.Logger
+	fs     afero.Fs
+
+	k8sConfigAccess clientcmd.ConfigAccess
+
+	client   *client.Client
+	codename string
+
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func (r *runner) Run(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	err := r.flag.Validate()
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = r.run(ctx, cmd, args)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) error {
+	var err error
+
+	clusterName := strings.ToLower(args[0])
+
+	config := commonconfig.New(r.flag.config)
+	{
+		if r.codename == "" {
+			r.codename, err = r.getCodename()
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+
+		err = r.getClient(config)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	namespace, _, err := r.flag.config.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	secret, err := r.getSecret(ctx, clusterName, namespace)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	wcConfig, err := clientcmd.Load(secret.Data[key.KubeconfigSecretKey])
+	if err != nil {
+		return microerror.Maskf(invalidKubeconfigError, "The kubeconfig in secret '%s/%s' cannot be parsed: %s", secret.Namespace, secret.Name, err)
+	}
+
+	contextName := gskubeconfig.GenerateWCKubeContextName(r.codename, clusterName)
+	wcConfig, admin, err := rename(wcConfig, contextName)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	switch {
+	case r.flag.Merge:
+		if admin && !r.flag.AllowAdmin {
+			return microerror.Maskf(adminCredentialsError, "The kubeconfig of cluster '%s' holds admin credentials. Use --%s to merge it anyway.", clusterName, flagAllowAdmin)
+		}
+
+		target, err := r.k8sConfigAccess.GetStartingConfig()
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		merge(target, wcConfig)
+
+		err = clientcmd.ModifyConfig(r.k8sConfigAccess, *target, false)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		fmt.Fprintf(r.stdout, "Context '%s' has been added to your kubeconfig.\n", contextName)
+		fmt.Fprintf(r.stdout, "To switch to it, please run\n\n")
+		fmt.Fprintf(r.stdout, "  kubectl config use-context %s\n", contextName)
+
+	case len(r.flag.Output) > 0:
+		data, err := clientcmd.Write(*wcConfig)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = afero.WriteFile(r.fs, r.flag.Output, data, 0600)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		fmt.Fprintf(r.stdout, "The kubeconfig of cluster '%s' has been written to '%s'.\n", clusterName, r.flag.Output)
+
+	default:
+		data, err := clientcmd.Write(*wcConfig)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		_, err = r.stdout.Write(data)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *runner) getClient(config *commonconfig.CommonConfig) error {
+	if r.client != nil {
+		return nil
+	}
+
+	var err error
+	r.client, err = config.GetClient(r.logger)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// getCodename returns the code name of the installation
+// that the current context points to.
+func (r *runner) getCodename() (string, error) {
+	rawConfig, err := r.flag.config.ToRawKubeConfigLoader().RawConfig()
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	contextName := rawConfig.CurrentContext
+	if configFlags, ok := r.flag.config.(*genericclioptions.ConfigFlags); ok && configFlags.Context != nil && len(*configFlags.Context) > 0 {
+		contextName = *configFlags.Context
+	}
+
+	// The context may also be the one of a workload cluster,
+	// named after the installation and the cluster.
+	codename := strings.SplitN(gskubeconfig.GetCodeNameFromKubeContext(contextName), "-", 2)[0]
+	if !gskubeconfig.IsKubeContext(contextName) || !gskubeconfig.IsCodeName(codename) {
+		return "", microerror.Maskf(unknownInstallationError, "The current context '%s' does not belong to a Giant Swarm installation. Please log in using 'kubectl gs login' first.", contextName)
+	}
+
+	return codename, nil
+}
+
+// getSecret fetches the kubeconfig secret of the given cluster from the
+// given namespace, or from the namespace named after the cluster.
+func (r *runner) getSecret(ctx context.Context, clusterName, namespace string) (*corev1.Secret, error) {
+	namespaces := []string{namespace}
+	if namespace != clusterName {
+		namespaces = append(namespaces, clusterName)
+	}
+
+	secretName := key.KubeconfigSecretName(clusterName)
+	for _, ns := range namespaces {
+		secret := &corev1.Secret{}
+		err := r.client.K8sClient.CtrlClient().Get(ctx, runtimeclient.ObjectKey{
+			Namespace: ns,
+			Name:      secretName,
+		}, secret)
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		return secret, nil
+	}
+
+	return nil, microerror.Maskf(notFoundError, "A kubeconfig secret '%s' cannot be found in namespace(s) %s.\n", secretName, strings.Join(namespaces, ", "))
+}
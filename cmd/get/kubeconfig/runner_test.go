@@ -0,0 +1,241 @@
+package kubeconfig
+
+import (
+	"bytes"
+	"context"
+	goflag "flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/giantswarm/micrologger/microloggertest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
+	"github.com/giantswarm/kubectl-gs/test/goldenfile"
+	"github.com/giantswarm/kubectl-gs/test/kubeconfig"
+)
+
+var update = goflag.Bool("update", false, "update .golden reference test files")
+
+// Test_run uses golden files.
+//
+//  go test ./cmd/get/kubeconfig -run Test_run -update
+//
+func Test_run(t *testing.T) {
+	adminCert, err := ioutil.ReadFile(filepath.Join("testdata", "admin.crt"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	testCases := []struct {
+		name                   string
+		secret                 *corev1.Secret
+		codename               string
+		merge                  bool
+		allowAdmin             bool
+		output                 string
+		expectedGoldenFile     string
+		expectedFileGoldenFile string
+		errorMatcher           func(error) bool
+	}{
+		{
+			name:               "case 0: print kubeconfig",
+			secret:             newSecret("f83ir", "default", newTokenKubeconfig("f83ir")),
+			codename:           "gauss",
+			expectedGoldenFile: "run_get_kubeconfig.golden",
+		},
+		{
+			name:               "case 1: print kubeconfig, from the namespace named after the cluster",
+			secret:             newSecret("f83ir", "f83ir", newTokenKubeconfig("f83ir")),
+			codename:           "gauss",
+			expectedGoldenFile: "run_get_kubeconfig.golden",
+		},
+		{
+			name:                   "case 2: write kubeconfig to a file",
+			secret:                 newSecret("f83ir", "default", newTokenKubeconfig("f83ir")),
+			codename:               "gauss",
+			output:                 "f83ir.yaml",
+			expectedGoldenFile:     "run_get_kubeconfig_output.golden",
+			expectedFileGoldenFile: "run_get_kubeconfig.golden",
+		},
+		{
+			name:         "case 3: merge kubeconfig with admin credentials",
+			secret:       newSecret("f83ir", "default", newCertKubeconfig("f83ir", adminCert)),
+			codename:     "gauss",
+			merge:        true,
+			errorMatcher: IsAdminCredentials,
+		},
+		{
+			name:                   "case 4: merge kubeconfig with admin credentials, allowing admin credentials",
+			secret:                 newSecret("f83ir", "default", newCertKubeconfig("f83ir", adminCert)),
+			codename:               "gauss",
+			merge:                  true,
+			allowAdmin:             true,
+			expectedGoldenFile:     "run_get_kubeconfig_merge.golden",
+			expectedFileGoldenFile: "run_get_kubeconfig_merge_config.golden",
+		},
+		{
+			name:         "case 5: kubeconfig secret not found",
+			secret:       newSecret("s921a", "default", newTokenKubeconfig("s921a")),
+			codename:     "gauss",
+			errorMatcher: IsNotFound,
+		},
+		{
+			name:         "case 6: current context not belonging to an installation",
+			secret:       newSecret("f83ir", "default", newTokenKubeconfig("f83ir")),
+			errorMatcher: IsUnknownInstallation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			fakeClient, err := client.NewFakeClient(client.Config{Logger: microloggertest.New()})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			err = fakeClient.K8sClient.CtrlClient().Create(ctx, tc.secret)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			// The user's kubeconfig, which merged kubeconfigs are written to.
+			k8sConfigAccess := clientcmd.NewDefaultPathOptions()
+			k8sConfigAccess.GlobalFile = filepath.Join(t.TempDir(), "config")
+			k8sConfigAccess.EnvVar = ""
+			err = clientcmd.WriteToFile(*newTokenKubeconfig("gs-gauss"), k8sConfigAccess.GlobalFile)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			fakeKubeConfig := kubeconfig.CreateFakeKubeConfig()
+			flag := &flag{
+				config: genericclioptions.NewTestConfigFlags().WithClientConfig(fakeKubeConfig),
+
+				AllowAdmin: tc.allowAdmin,
+				Merge:      tc.merge,
+				Output:     tc.output,
+			}
+			fs := afero.NewMemMapFs()
+			out := new(bytes.Buffer)
+			runner := &runner{
+				flag:            flag,
+				fs:              fs,
+				k8sConfigAccess: k8sConfigAccess,
+				client:          fakeClient,
+				codename:        tc.codename,
+				stdout:          out,
+			}
+
+			err = runner.run(ctx, nil, []string{"f83ir"})
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			assertGoldenFile(t, tc.expectedGoldenFile, out.Bytes())
+
+			if len(tc.expectedFileGoldenFile) > 0 {
+				var written []byte
+				if tc.merge {
+					written, err = ioutil.ReadFile(k8sConfigAccess.GlobalFile)
+				} else {
+					written, err = afero.ReadFile(fs, tc.output)
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+
+				assertGoldenFile(t, tc.expectedFileGoldenFile, written)
+			}
+		})
+	}
+}
+
+func assertGoldenFile(t *testing.T, name string, actual []byte) {
+	var err error
+
+	var expectedResult []byte
+	{
+		gf := goldenfile.New("testdata", name)
+		if *update {
+			err = gf.Update(actual)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			expectedResult = actual
+		} else {
+			expectedResult, err = gf.Read()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+		}
+	}
+
+	diff := cmp.Diff(string(expectedResult), string(actual))
+	if diff != "" {
+		t.Fatalf("value not expected, got:\n %s", diff)
+	}
+}
+
+func newSecret(clusterName, namespace string, config *clientcmdapi.Config) *corev1.Secret {
+	data, _ := clientcmd.Write(*config)
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      clusterName + "-kubeconfig",
+			Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			"value": data,
+		},
+	}
+}
+
+func newTokenKubeconfig(clusterName string) *clientcmdapi.Config {
+	config := clientcmdapi.NewConfig()
+	config.Clusters[clusterName] = &clientcmdapi.Cluster{
+		Server: "https://api." + clusterName + ".k8s.gauss.eu-west-1.aws.gigantic.io",
+	}
+	config.AuthInfos[clusterName+"-user"] = &clientcmdapi.AuthInfo{
+		Token: "the-token",
+	}
+	config.Contexts[clusterName+"-user@"+clusterName] = &clientcmdapi.Context{
+		Cluster:  clusterName,
+		AuthInfo: clusterName + "-user",
+	}
+	config.CurrentContext = clusterName + "-user@" + clusterName
+
+	return config
+}
+
+func newCertKubeconfig(clusterName string, cert []byte) *clientcmdapi.Config {
+	config := clientcmdapi.NewConfig()
+	config.Clusters[clusterName] = &clientcmdapi.Cluster{
+		Server: "https://api." + clusterName + ".k8s.gauss.eu-west-1.aws.gigantic.io",
+	}
+	config.AuthInfos[clusterName+"-admin"] = &clientcmdapi.AuthInfo{
+		ClientCertificateData: cert,
+	}
+	config.Contexts[clusterName+"-admin@"+clusterName] = &clientcmdapi.Context{
+		Cluster:  clusterName,
+		AuthInfo: clusterName + "-admin",
+	}
+	config.CurrentContext = clusterName + "-admin@" + clusterName
+
+	return config
+}
@@ -0,0 +1,107 @@
+package machines
+
+import (
+	"io"
+	"os"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/giantswarm/kubectl-gs/pkg/middleware"
+	"github.com/giantswarm/kubectl-gs/pkg/middleware/renewtoken"
+)
+
+const (
+	name  = "machines [machine-name]"
+	alias = "machine"
+
+	shortDescription = "Display one or many machines"
+	longDescription  = `Display one or many machines
+
+Lists the Cluster API machines of workload clusters, i.e. the nodes of
+their control planes and node pools. The nodes of Giant Swarm AWS clusters
+that are not managed by the Cluster API provider for AWS are not represented
+as Cluster API machines. Such clusters have no machines listed, and getting
+their machines with --cluster-name fails.
+
+Output columns:
+
+- NAME: Name of the machine.
+- CLUSTER NAME: Name of the cluster that the machine belongs to.
+- NODE POOL: Name of the node pool that the machine belongs to, if any.
+- PHASE: Lifecycle phase of the machine. Machines that have been provisioning
+  or deleting for longer than --stuck-threshold are marked as stuck.
+- NODE: Name of the Kubernetes node of the machine.
+- PROVIDER ID: Identifier of the machine instance at the infrastructure provider.
+- VERSION: Kubernetes version of the machine.
+- AVAILABILITY ZONE: Failure domain the machine is placed in.
+- AGE: How long ago the machine was created.`
+
+	examples = `  # List all machines in the current namespace
+  kubectl gs get machines
+
+  # List the machines of one node pool of a cluster
+  kubectl gs get machines --cluster-name f83ir --nodepool a7k3e
+
+  # List all machines, marking the ones provisioning or deleting for more than 10 minutes as stuck
+  kubectl gs get machines -A --stuck-threshold 10m`
+)
+
+type Config struct {
+	Logger     micrologger.Logger
+	FileSystem afero.Fs
+
+	K8sConfigAccess clientcmd.ConfigAccess
+
+	Stderr io.Writer
+	Stdout io.Writer
+}
+
+func New(config Config) (*cobra.Command, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.FileSystem == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.FileSystem must not be empty", config)
+	}
+	if config.K8sConfigAccess == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sConfigAccess must not be empty", config)
+	}
+	if config.Stderr == nil {
+		config.Stderr = os.Stderr
+	}
+	if config.Stdout == nil {
+		config.Stdout = os.Stdout
+	}
+
+	f := &flag{}
+
+	r := &runner{
+		flag:   f,
+		logger: config.Logger,
+		fs:     config.FileSystem,
+
+		stderr: config.Stderr,
+		stdout: config.Stdout,
+	}
+
+	c := &cobra.Command{
+		Use:     name,
+		Short:   shortDescription,
+		Long:    longDescription,
+		Example: examples,
+		Aliases: []string{alias},
+		Args:    cobra.MaximumNArgs(1),
+		RunE:    r.Run,
+		PreRunE: middleware.Compose(
+			renewtoken.Middleware(config.K8sConfigAccess),
+		),
+	}
+
+	f.Init(c)
+
+	return c, nil
+}
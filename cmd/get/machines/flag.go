@@ -0,0 +1,66 @@
+package machines
+
+import (
+	"strings"
+	"time"
+
+	"github.com/giantswarm/microerror"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+)
+
+const (
+	flagAllNamespaces  = "all-namespaces"
+	flagClusterName    = "cluster-name"
+	flagNodepool       = "nodepool"
+	flagSortBy         = "sort-by"
+	flagStuckThreshold = "stuck-threshold"
+	flagTimestamps     = "timestamps"
+)
+
+const (
+	defaultStuckThreshold = 30 * time.Minute
+)
+
+type flag struct {
+	AllNamespaces  bool
+	Cluster        string
+	Nodepool       string
+	SortBy         string
+	StuckThreshold time.Duration
+	Timestamps     string
+
+	config genericclioptions.RESTClientGetter
+	print  *genericclioptions.PrintFlags
+}
+
+func (f *flag) Init(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&f.AllNamespaces, flagAllNamespaces, "A", false, "If present, list the requested object(s) across all namespaces. Namespace in current context is ignored even if specified with --namespace.")
+	cmd.Flags().StringVarP(&f.Cluster, flagClusterName, "c", "", "Only show machines of the cluster with this name.")
+	cmd.Flags().StringVar(&f.Nodepool, flagNodepool, "", "Only show machines of the node pool with this name.")
+	cmd.Flags().StringVar(&f.SortBy, flagSortBy, "", "If non-empty, sort table output using this column name or JSONPath expression, e.g. 'age' or '.metadata.creationTimestamp'.")
+	cmd.Flags().DurationVar(&f.StuckThreshold, flagStuckThreshold, defaultStuckThreshold, "Mark machines that have been provisioning or deleting for longer than this as stuck.")
+	cmd.Flags().StringVar(&f.Timestamps, flagTimestamps, output.TimestampsRelative, "Print timestamps in table output as the time elapsed since then (relative) or as dates in UTC (absolute).")
+
+	f.config = genericclioptions.NewConfigFlags(true)
+	f.print = genericclioptions.NewPrintFlags("")
+
+	// Merging current command flags and config flags,
+	// to be able to override kubectl-specific ones.
+	f.config.(*genericclioptions.ConfigFlags).AddFlags(cmd.Flags())
+	f.print.AddFlags(cmd)
+	output.AddTableFormatsUsage(cmd)
+}
+
+func (f *flag) Validate() error {
+	if f.StuckThreshold <= 0 {
+		return microerror.Maskf(invalidFlagError, "--%s must be a positive duration", flagStuckThreshold)
+	}
+	if !output.IsTimestampFormat(f.Timestamps) {
+		return microerror.Maskf(invalidFlagError, "--%s must be one of %s", flagTimestamps, strings.Join(output.TimestampFormats, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package machines
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/giantswarm/microerror"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/printers"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/machine"
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+)
+
+const (
+	naValue = "n/a"
+)
+
+func (r *runner) printOutput(machineResource machine.Resource) error {
+	var (
+		err      error
+		printer  printers.ResourcePrinter
+		resource runtime.Object
+	)
+
+	switch {
+	case output.IsOutputTable(r.flag.print.OutputFormat):
+		tableOptions := output.TableOptions{
+			OutputFormat:  r.flag.print.OutputFormat,
+			SortBy:        r.flag.SortBy,
+			WithNamespace: r.flag.AllNamespaces,
+		}
+		err = output.PrintTable(r.stdout, getTable(machineResource, r.flag.StuckThreshold, time.Now(), r.flag.Timestamps), tableOptions)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+
+	case output.IsOutputName(r.flag.print.OutputFormat):
+		resource = machineResource.Object()
+		err = output.PrintResourceNames(r.stdout, resource)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+
+	default:
+		resource = machineResource.Object()
+		printer, err = r.flag.print.ToPrinter()
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	err = printer.PrintObj(resource, r.stdout)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *runner) printNoResourcesOutput() error {
+	fmt.Fprintf(r.stderr, "No machines found.\n")
+	fmt.Fprintf(r.stderr, "To list the node pools of a cluster, please check\n\n")
+	fmt.Fprintf(r.stderr, "  kubectl gs get nodepools --help\n")
+
+	err := output.PrintEmptyList(r.stdout, r.flag.print)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func getTable(machineResource machine.Resource, stuckThreshold time.Duration, now time.Time, timestamps string) *metav1.Table {
+	// Creating a custom table resource.
+	table := &metav1.Table{}
+
+	table.ColumnDefinitions = []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string"},
+		{Name: "Cluster Name", Type: "string"},
+		{Name: "Node Pool", Type: "string"},
+		{Name: "Phase", Type: "string"},
+		{Name: "Node", Type: "string"},
+		{Name: "Provider ID", Type: "string"},
+		{Name: "Version", Type: "string"},
+		{Name: "Availability Zone", Type: "string"},
+		{Name: "Age", Type: "string"},
+		{Name: "Instance Type", Type: "string", Priority: 1},
+	}
+
+	switch m := machineResource.(type) {
+	case *machine.Machine:
+		table.Rows = append(table.Rows, getMachineRow(*m, stuckThreshold, now, timestamps))
+	case *machine.Collection:
+		for _, machineItem := range m.Items {
+			table.Rows = append(table.Rows, getMachineRow(machineItem, stuckThreshold, now, timestamps))
+		}
+	}
+
+	return table
+}
+
+func getMachineRow(m machine.Machine, stuckThreshold time.Duration, now time.Time, timestamps string) metav1.TableRow {
+	if m.Machine == nil {
+		return metav1.TableRow{}
+	}
+
+	return metav1.TableRow{
+		Cells: []interface{}{
+			m.Machine.GetName(),
+			formatOptional(m.Machine.Labels[capiv1alpha3.ClusterLabelName]),
+			formatOptional(machine.NodepoolName(&m)),
+			getPhase(m, stuckThreshold, now),
+			getNodeName(m),
+			getProviderID(m),
+			formatOptionalPtr(m.Machine.Spec.Version),
+			getAvailabilityZone(m),
+			output.NewTimestamp(m.Machine.CreationTimestamp, timestamps),
+			getInstanceType(m),
+		},
+		Object: runtime.RawExtension{
+			Object: m.Machine,
+		},
+	}
+}
+
+func getPhase(m machine.Machine, stuckThreshold time.Duration, now time.Time) string {
+	phase := formatOptional(m.Machine.Status.Phase)
+	if machine.IsStuck(m, stuckThreshold, now) {
+		phase += " (stuck)"
+	}
+
+	return phase
+}
+
+func getNodeName(m machine.Machine) string {
+	if m.Machine.Status.NodeRef == nil {
+		return naValue
+	}
+
+	return m.Machine.Status.NodeRef.Name
+}
+
+func getProviderID(m machine.Machine) string {
+	switch {
+	case m.Machine.Spec.ProviderID != nil:
+		return formatOptionalPtr(m.Machine.Spec.ProviderID)
+	case m.AWSMachine != nil:
+		return formatOptionalPtr(m.AWSMachine.Spec.ProviderID)
+	case m.AzureMachine != nil:
+		return formatOptionalPtr(m.AzureMachine.Spec.ProviderID)
+	case m.OpenStackMachine != nil:
+		return formatOptionalPtr(m.OpenStackMachine.Spec.ProviderID)
+	}
+
+	return naValue
+}
+
+func getAvailabilityZone(m machine.Machine) string {
+	switch {
+	case m.Machine.Spec.FailureDomain != nil:
+		return formatOptionalPtr(m.Machine.Spec.FailureDomain)
+	case m.AWSMachine != nil:
+		return formatOptionalPtr(m.AWSMachine.Spec.FailureDomain)
+	case m.AzureMachine != nil:
+		return formatOptionalPtr(m.AzureMachine.Spec.FailureDomain)
+	}
+
+	return naValue
+}
+
+func getInstanceType(m machine.Machine) string {
+	switch {
+	case m.AWSMachine != nil:
+		return formatOptional(m.AWSMachine.Spec.InstanceType)
+	case m.AzureMachine != nil:
+		return formatOptional(m.AzureMachine.Spec.VMSize)
+	case m.OpenStackMachine != nil:
+		return formatOptional(m.OpenStackMachine.Spec.Flavor)
+	}
+
+	return naValue
+}
+
+func formatOptional(value string) string {
+	if len(value) < 1 {
+		return naValue
+	}
+
+	return value
+}
+
+func formatOptionalPtr(value *string) string {
+	if value == nil {
+		return naValue
+	}
+
+	return formatOptional(*value)
+}
@@ -0,0 +1,134 @@
+package machines
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/kubectl-gs/pkg/commonconfig"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/machine"
+)
+
+type runner struct {
+	flag   *flag
+	logger micrologger.Logger
+	fs     afero.Fs
+
+	provider string
+	service  machine.Interface
+
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func (r *runner) Run(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	err := r.flag.Validate()
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = r.run(ctx, cmd, args)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) error {
+	var err error
+
+	config := commonconfig.New(r.flag.config)
+	{
+		if r.provider == "" {
+			r.provider, err = config.GetProvider()
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+
+		err = r.getService(config)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	var options machine.GetOptions
+	{
+		options = machine.GetOptions{
+			ClusterName:  r.flag.Cluster,
+			NodepoolName: r.flag.Nodepool,
+			Provider:     r.provider,
+		}
+
+		if len(args) > 0 {
+			if len(r.flag.Cluster) > 0 || len(r.flag.Nodepool) > 0 {
+				return microerror.Maskf(invalidFlagError, "--%s and --%s cannot be used when getting a machine by name", flagClusterName, flagNodepool)
+			}
+
+			options.Name = strings.ToLower(args[0])
+		}
+
+		if r.flag.AllNamespaces {
+			options.Namespace = metav1.NamespaceAll
+		} else {
+			options.Namespace, _, err = r.flag.config.ToRawKubeConfigLoader().Namespace()
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	}
+
+	machineResource, err := r.service.Get(ctx, options)
+	if machine.IsNotFound(err) {
+		return microerror.Maskf(notFoundError, fmt.Sprintf("A machine '%s/%s' cannot be found.\n", options.Namespace, options.Name))
+	} else if machine.IsUnsupportedCluster(err) {
+		return microerror.Maskf(unsupportedClusterError, fmt.Sprintf("The cluster '%s' is a Giant Swarm AWS cluster, its nodes are not represented as Cluster API machines.\nTo list its node pools, please check\n\n  kubectl gs get nodepools --help\n", options.ClusterName))
+	} else if machine.IsNoResources(err) {
+		err = r.printNoResourcesOutput()
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = r.printOutput(machineResource)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *runner) getService(config *commonconfig.CommonConfig) error {
+	if r.service != nil {
+		return nil
+	}
+
+	client, err := config.GetClient(r.logger)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	serviceConfig := machine.Config{
+		Client: client,
+	}
+	r.service, err = machine.New(serviceConfig)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,335 @@
+package machines
+
+import (
+	"bytes"
+	"context"
+	goflag "flag"
+	"testing"
+	"time"
+
+	"github.com/giantswarm/apiextensions/v3/pkg/label"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	capav1alpha3 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	capov1alpha3 "github.com/giantswarm/kubectl-gs/internal/capo/api/v1alpha3"
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/machine"
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+	"github.com/giantswarm/kubectl-gs/test/goldenfile"
+	"github.com/giantswarm/kubectl-gs/test/kubeconfig"
+)
+
+var update = goflag.Bool("update", false, "update .golden reference test files")
+
+// Test_run uses golden files.
+//
+//  go test ./cmd/get/machines -run Test_run -update
+//
+func Test_run(t *testing.T) {
+	storage := []runtime.Object{
+		newMachine("s921a-cp-0", "s921a", "", "Running", "ip-10-0-5-12.eu-west-1.compute.internal", "eu-west-1a", 5*24*time.Hour),
+		newAWSMachine("s921a-cp-0", "m5.xlarge"),
+		newMachine("s921a-a7k3e-0", "s921a", "a7k3e", "Running", "ip-10-0-6-20.eu-west-1.compute.internal", "eu-west-1b", 5*24*time.Hour),
+		newAWSMachine("s921a-a7k3e-0", "m5.2xlarge"),
+		newMachine("s921a-a7k3e-1", "s921a", "a7k3e", "Provisioning", "", "eu-west-1c", 2*24*time.Hour),
+		newAWSMachine("s921a-a7k3e-1", "m5.2xlarge"),
+		newMachine("f930q-9fk2a-0", "f930q", "9fk2a", "Running", "ip-10-1-6-20.eu-west-1.compute.internal", "eu-west-1a", 3*24*time.Hour),
+	}
+	gsAWSStorage := []runtime.Object{
+		newCluster("a8e1s", "infrastructure.giantswarm.io/v1alpha3"),
+	}
+	openStackStorage := []runtime.Object{
+		newOpenStackCAPIMachine("k2l9e-cp-0", "k2l9e", "", "Running", "k2l9e-cp-0", 4*24*time.Hour),
+		newOpenStackMachine("k2l9e-cp-0", "m1.large"),
+		newOpenStackCAPIMachine("k2l9e-b8d0w-0", "k2l9e", "b8d0w", "Provisioning", "", 5*time.Minute),
+		newOpenStackMachine("k2l9e-b8d0w-0", "m1.xlarge"),
+	}
+
+	testCases := []struct {
+		name                  string
+		storage               []runtime.Object
+		provider              string
+		args                  []string
+		cluster               string
+		nodepool              string
+		stuckThreshold        time.Duration
+		outputType            string
+		expectedGoldenFile    string
+		expectedErrGoldenFile string
+		errorMatcher          func(error) bool
+	}{
+		{
+			name:               "case 0: get machines",
+			storage:            storage,
+			expectedGoldenFile: "run_get_machines.golden",
+		},
+		{
+			name:                  "case 1: get machines, with empty storage",
+			storage:               nil,
+			expectedErrGoldenFile: "run_get_machines_empty_storage.golden",
+		},
+		{
+			name:               "case 2: get machines of a cluster",
+			storage:            storage,
+			cluster:            "f930q",
+			expectedGoldenFile: "run_get_machines_by_cluster.golden",
+		},
+		{
+			name:               "case 3: get machines of a node pool, with wide output",
+			storage:            storage,
+			cluster:            "s921a",
+			nodepool:           "a7k3e",
+			outputType:         output.TypeWide,
+			expectedGoldenFile: "run_get_machines_by_nodepool_wide.golden",
+		},
+		{
+			name:               "case 4: get machines, with a higher stuck threshold",
+			storage:            storage,
+			stuckThreshold:     72 * time.Hour,
+			expectedGoldenFile: "run_get_machines_stuck_threshold.golden",
+		},
+		{
+			name:               "case 5: get machine by name",
+			storage:            storage,
+			args:               []string{"s921a-a7k3e-1"},
+			expectedGoldenFile: "run_get_machine_by_name.golden",
+		},
+		{
+			name:         "case 6: get machine by name, with filters",
+			storage:      storage,
+			args:         []string{"s921a-a7k3e-1"},
+			nodepool:     "a7k3e",
+			errorMatcher: IsInvalidFlag,
+		},
+		{
+			name:         "case 7: get machine by name, not found",
+			storage:      storage,
+			args:         []string{"unknown"},
+			errorMatcher: IsNotFound,
+		},
+		{
+			name:                  "case 8: get machines, with empty storage, and JSON output",
+			storage:               nil,
+			outputType:            output.TypeJSON,
+			expectedGoldenFile:    "run_get_machines_empty_storage_json_output.golden",
+			expectedErrGoldenFile: "run_get_machines_empty_storage.golden",
+		},
+		{
+			name:               "case 9: get openstack machines, with wide output",
+			storage:            openStackStorage,
+			provider:           key.ProviderOpenStack,
+			outputType:         output.TypeWide,
+			expectedGoldenFile: "run_get_openstack_machines_wide.golden",
+		},
+		{
+			name:         "case 10: get machines of a Giant Swarm AWS cluster",
+			storage:      gsAWSStorage,
+			cluster:      "a8e1s",
+			errorMatcher: IsUnsupportedCluster,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			outputType := output.TypeDefault
+			if len(tc.outputType) > 0 {
+				outputType = tc.outputType
+			}
+			provider := key.ProviderAWS
+			if len(tc.provider) > 0 {
+				provider = tc.provider
+			}
+			stuckThreshold := defaultStuckThreshold
+			if tc.stuckThreshold > 0 {
+				stuckThreshold = tc.stuckThreshold
+			}
+
+			fakeKubeConfig := kubeconfig.CreateFakeKubeConfig()
+			flag := &flag{
+				print:  genericclioptions.NewPrintFlags("").WithDefaultOutput(outputType),
+				config: genericclioptions.NewTestConfigFlags().WithClientConfig(fakeKubeConfig),
+
+				Cluster:        tc.cluster,
+				Nodepool:       tc.nodepool,
+				StuckThreshold: stuckThreshold,
+			}
+			out := new(bytes.Buffer)
+			errOut := new(bytes.Buffer)
+			runner := &runner{
+				service:  machine.NewFakeService(tc.storage),
+				flag:     flag,
+				stdout:   out,
+				stderr:   errOut,
+				provider: provider,
+			}
+
+			err := runner.run(ctx, nil, tc.args)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			assertGoldenFile(t, tc.expectedGoldenFile, out.Bytes())
+			assertGoldenFile(t, tc.expectedErrGoldenFile, errOut.Bytes())
+		})
+	}
+}
+
+func assertGoldenFile(t *testing.T, name string, actual []byte) {
+	var err error
+
+	if len(name) < 1 {
+		if len(actual) > 0 {
+			t.Fatalf("unexpected output, got:\n %s", actual)
+		}
+
+		return
+	}
+
+	var expectedResult []byte
+	{
+		gf := goldenfile.New("testdata", name)
+		if *update {
+			err = gf.Update(actual)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			expectedResult = actual
+		} else {
+			expectedResult, err = gf.Read()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+		}
+	}
+
+	diff := cmp.Diff(string(expectedResult), string(actual))
+	if diff != "" {
+		t.Fatalf("value not expected, got:\n %s", diff)
+	}
+}
+
+func newCluster(name, infrastructureAPIVersion string) *capiv1alpha3.Cluster {
+	return &capiv1alpha3.Cluster{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "cluster.x-k8s.io/v1alpha3",
+			Kind:       "Cluster",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: capiv1alpha3.ClusterSpec{
+			InfrastructureRef: &corev1.ObjectReference{
+				APIVersion: infrastructureAPIVersion,
+				Kind:       "AWSCluster",
+				Name:       name,
+			},
+		},
+	}
+}
+
+func newMachine(name, clusterName, nodepoolName, phase, nodeName, az string, age time.Duration) *capiv1alpha3.Machine {
+	version := "v1.19.9"
+	providerID := "aws:///" + az + "/i-" + name
+
+	m := &capiv1alpha3.Machine{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "cluster.x-k8s.io/v1alpha3",
+			Kind:       "Machine",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+			Labels: map[string]string{
+				capiv1alpha3.ClusterLabelName: clusterName,
+			},
+		},
+		Spec: capiv1alpha3.MachineSpec{
+			ClusterName: clusterName,
+			InfrastructureRef: corev1.ObjectReference{
+				APIVersion: "infrastructure.cluster.x-k8s.io/v1alpha3",
+				Kind:       "AWSMachine",
+				Name:       name,
+			},
+			Version:       &version,
+			FailureDomain: &az,
+		},
+		Status: capiv1alpha3.MachineStatus{
+			Phase: phase,
+		},
+	}
+
+	if len(nodepoolName) > 0 {
+		m.Labels[label.MachineDeployment] = nodepoolName
+	}
+	if len(nodeName) > 0 {
+		m.Spec.ProviderID = &providerID
+		m.Status.NodeRef = &corev1.ObjectReference{
+			Kind: "Node",
+			Name: nodeName,
+		}
+	}
+
+	return m
+}
+
+func newAWSMachine(name, instanceType string) *capav1alpha3.AWSMachine {
+	return &capav1alpha3.AWSMachine{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "infrastructure.cluster.x-k8s.io/v1alpha3",
+			Kind:       "AWSMachine",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: capav1alpha3.AWSMachineSpec{
+			InstanceType: instanceType,
+		},
+	}
+}
+
+func newOpenStackCAPIMachine(name, clusterName, nodepoolName, phase, nodeName string, age time.Duration) *capiv1alpha3.Machine {
+	m := newMachine(name, clusterName, nodepoolName, phase, nodeName, "nova", age)
+	m.Spec.InfrastructureRef.Kind = "OpenStackMachine"
+	if m.Spec.ProviderID != nil {
+		providerID := "openstack:///" + name
+		m.Spec.ProviderID = &providerID
+	}
+
+	return m
+}
+
+func newOpenStackMachine(name, flavor string) *capov1alpha3.OpenStackMachine {
+	providerID := "openstack:///" + name
+
+	return &capov1alpha3.OpenStackMachine{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "infrastructure.cluster.x-k8s.io/v1alpha3",
+			Kind:       "OpenStackMachine",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: capov1alpha3.OpenStackMachineSpec{
+			ProviderID: &providerID,
+			Flavor:     flavor,
+		},
+	}
+}
@@ -25,18 +25,42 @@ Output columns:
 
 - NAME: Unique identifier of the node pool.
 - CLUSTER NAME: Unique identifier of the cluster that the node pool belongs to.
-- CREATED: Date and time of the node pool CR creation.
 - CONDITION: Latest condition reported for the node pool.
 - NODES MIN/MAX: Node pool autoscaler settings (if supported).
 - NODES DESIRED: The total number of nodes that the node pool should have.
 - NODES READY: The number of nodes in the node pool that are actually ready.
-- DESCRIPTION: User friendly description for the node pool.`
+- DESCRIPTION: User friendly description for the node pool.
+- AGE: How long ago the node pool CR was created.
+
+Additional output columns with -o wide:
+
+- INSTANCE TYPE / VM SIZE: Machine type used for the nodes.
+- AVAILABILITY ZONES: Zones the nodes are distributed across.
+- SUBNET (AWS): Subnet CIDR or size requested for the node pool.
+- ON-DEMAND BASE (AWS): Number of nodes that are always on-demand instances.
+- ON-DEMAND ABOVE BASE (AWS): Share of on-demand instances above the base.
+- SPOT INSTANCES (AWS): Number of spot instances currently running.
+- SPOT VMS (Azure): Whether the node pool uses spot VMs.
+- SPOT MAX PRICE (Azure): Maximum hourly price paid per spot VM.
+- ORGANIZATION: Organization owning the node pool.
+- CREATED BY: Who created the node pool.
+
+To see all details of a single node pool, use 'kubectl gs describe nodepool'.`
 
 	examples = `  # List all node pools you have access to
   kubectl gs get nodepools
 
   # Get one specific nodepool by its name
-  kubectl gs get nodepool 3f01a`
+  kubectl gs get nodepool 3f01a
+
+  # List all node pools with their instance details
+  kubectl gs get nodepools -o wide
+
+  # List all node pools with a certain label
+  kubectl gs get nodepools -A --selector environment=production
+
+  # Watch the node pools of one cluster for changes
+  kubectl gs get nodepools --cluster-name f83ir --watch`
 )
 
 type Config struct {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/giantswarm/microerror"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/printers"
 
@@ -24,20 +25,33 @@ func (r *runner) printOutput(npResource nodepool.Resource) error {
 	var resource runtime.Object
 
 	switch {
-	case output.IsOutputDefault(r.flag.print.OutputFormat):
+	case output.IsOutputTable(r.flag.print.OutputFormat):
+		var table *metav1.Table
 		switch r.provider {
 		case key.ProviderAWS:
 			capabilities := feature.New(feature.ProviderAWS)
-			resource = provider.GetAWSTable(npResource, capabilities)
+			table = provider.GetAWSTable(npResource, capabilities, r.flag.Timestamps)
 		case key.ProviderAzure:
 			capabilities := feature.New(feature.ProviderAzure)
-			resource = provider.GetAzureTable(npResource, capabilities)
+			table = provider.GetAzureTable(npResource, capabilities, r.flag.Timestamps)
+		case key.ProviderOpenStack:
+			table = provider.GetOpenStackTable(npResource, r.flag.Timestamps)
 		}
 
-		printOptions := printers.PrintOptions{
+		tableOptions := output.TableOptions{
+			OutputFormat:  r.flag.print.OutputFormat,
+			SortBy:        r.flag.SortBy,
 			WithNamespace: r.flag.AllNamespaces,
 		}
-		printer = printers.NewTablePrinter(printOptions)
+		if r.tablePrinter == nil {
+			r.tablePrinter = output.NewTablePrinter(r.stdout)
+		}
+		err = r.tablePrinter.PrintTable(table, tableOptions)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
 
 	case output.IsOutputName(r.flag.print.OutputFormat):
 		resource = npResource.Object()
@@ -64,8 +78,15 @@ func (r *runner) printOutput(npResource nodepool.Resource) error {
 	return nil
 }
 
-func (r *runner) printNoResourcesOutput() {
-	fmt.Fprintf(r.stdout, "No node pools found.\n")
-	fmt.Fprintf(r.stdout, "To create a node pool, please check\n\n")
-	fmt.Fprintf(r.stdout, "  kubectl gs template nodepool --help\n")
+func (r *runner) printNoResourcesOutput() error {
+	fmt.Fprintf(r.stderr, "No node pools found.\n")
+	fmt.Fprintf(r.stderr, "To create a node pool, please check\n\n")
+	fmt.Fprintf(r.stderr, "  kubectl gs template nodepool --help\n")
+
+	err := output.PrintEmptyList(r.stdout, r.flag.print)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	goflag "flag"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,13 +12,16 @@ import (
 	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
 	"github.com/giantswarm/apiextensions/v3/pkg/label"
 	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
 	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
 	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	capiexpv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
 
+	capov1alpha3 "github.com/giantswarm/kubectl-gs/internal/capo/api/v1alpha3"
 	"github.com/giantswarm/kubectl-gs/internal/key"
 	"github.com/giantswarm/kubectl-gs/pkg/data/domain/nodepool"
 	"github.com/giantswarm/kubectl-gs/pkg/output"
@@ -206,12 +210,53 @@ func Test_printOutput(t *testing.T) {
 			outputType:         output.TypeName,
 			expectedGoldenFile: "print_single_azure_nodepool_name_output.golden",
 		},
+		{
+			name: "case 16: print list of AWS nodepools, with wide output",
+			np: newNodePoolCollection(
+				*newAWSNodePool("1sad2", "s921a", "2021-01-02T15:04:32Z", "12.0.0", "test nodepool 1", 1, 3, 2, 2),
+				*withAWSInstances(newAWSNodePool("2a03f", "3a0d1", "2021-01-02T15:04:32Z", "11.0.0", "test nodepool 2", 3, 10, 5, 2), "m5.xlarge", []string{"eu-west-1a", "eu-west-1b"}, "10.1.2.0/24", 1, 50, 2),
+				*withAWSInstances(newAWSNodePool("f930q", "s921a", "2021-01-02T15:04:32Z", "11.0.0", "test nodepool 4", 3, 3, 3, 1), "m5.2xlarge", []string{"eu-west-1c"}, "", 0, -1, 0),
+			),
+			provider:           key.ProviderAWS,
+			outputType:         output.TypeWide,
+			expectedGoldenFile: "print_list_of_aws_nodepools_wide_output.golden",
+		},
+		{
+			name: "case 17: print list of Azure nodepools, with wide output",
+			np: newNodePoolCollection(
+				*newAzureNodePool("1sad2", "s921a", "2021-01-02T15:04:32Z", "13.0.0", "test nodepool 1", 1, 3, 2, 2),
+				*withAzureInstances(newAzureNodePool("2a03f", "3a0d1", "2021-01-02T15:04:32Z", "13.1.0", "test nodepool 2", 3, 10, 5, 2), "Standard_D4s_v3", []string{"1", "2"}, "0.05"),
+				*withAzureInstances(newAzureNodePool("f930q", "s921a", "2021-01-02T15:04:32Z", "13.1.0", "test nodepool 4", 3, 3, 3, 1), "Standard_D8s_v3", []string{"3"}, "-1"),
+				*withAzureInstances(newAzureNodePool("9f012", "29sa0", "2021-01-02T15:04:32Z", "13.1.0", "test nodepool 5", 0, 3, 1, 1), "Standard_D8s_v3", nil, ""),
+			),
+			provider:           key.ProviderAzure,
+			outputType:         output.TypeWide,
+			expectedGoldenFile: "print_list_of_azure_nodepools_wide_output.golden",
+		},
+		{
+			name: "case 18: print list of OpenStack nodepools, with table output",
+			np: newNodePoolCollection(
+				*newOpenStackNodePool("1sad2", "s921a", "2021-01-02T15:04:32Z", "20.0.0", "test nodepool 1", "", 1, 3, 2, 2),
+				*newOpenStackNodePool("2a03f", "3a0d1", "2021-01-02T15:04:32Z", "20.0.0", "", "nova", 3, 10, 5, 2),
+			),
+			provider:           key.ProviderOpenStack,
+			outputType:         output.TypeDefault,
+			expectedGoldenFile: "print_list_of_openstack_nodepools_table_output.golden",
+		},
+		{
+			name:               "case 19: print single OpenStack nodepool, with wide output",
+			np:                 newOpenStackNodePool("f930q", "s921a", "2021-01-02T15:04:32Z", "20.0.0", "test nodepool 4", "nova", 3, 3, 3, 1),
+			provider:           key.ProviderOpenStack,
+			outputType:         output.TypeWide,
+			expectedGoldenFile: "print_single_openstack_nodepool_wide_output.golden",
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			flag := &flag{
-				print: genericclioptions.NewPrintFlags("").WithDefaultOutput(tc.outputType),
+				print:      genericclioptions.NewPrintFlags("").WithDefaultOutput(tc.outputType),
+				Timestamps: output.TimestampsAbsolute,
 			}
 			out := new(bytes.Buffer)
 			runner := &runner{
@@ -325,6 +370,27 @@ func newAWSNodePool(name, clusterName, created, release, description string, nod
 	return np
 }
 
+// withAWSInstances sets the instance details of an AWS node pool. A subnet
+// with a leading slash is set as subnet size, and a negative percentage
+// above base capacity is left unset.
+func withAWSInstances(np *nodepool.Nodepool, instanceType string, zones []string, subnet string, onDemandBase, onDemandAboveBase, spotInstances int) *nodepool.Nodepool {
+	np.AWSMachineDeployment.Spec.Provider.Worker.InstanceType = instanceType
+	np.AWSMachineDeployment.Spec.Provider.AvailabilityZones = zones
+	np.AWSMachineDeployment.Spec.Provider.InstanceDistribution.OnDemandBaseCapacity = onDemandBase
+	if onDemandAboveBase >= 0 {
+		np.AWSMachineDeployment.Spec.Provider.InstanceDistribution.OnDemandPercentageAboveBaseCapacity = &onDemandAboveBase
+	}
+	np.AWSMachineDeployment.Status.Provider.Worker.SpotInstances = spotInstances
+
+	if strings.HasPrefix(subnet, "/") {
+		np.AWSMachineDeployment.Annotations = map[string]string{annotation.AWSSubnetSize: strings.TrimPrefix(subnet, "/")}
+	} else if len(subnet) > 0 {
+		np.AWSMachineDeployment.Annotations = map[string]string{annotation.MachineDeploymentSubnet: subnet}
+	}
+
+	return np
+}
+
 func newAzureMachinePool(name, clusterName, created, release string) *capzexpv1alpha3.AzureMachinePool {
 	location, _ := time.LoadLocation("UTC")
 	parsedCreationDate, _ := time.ParseInLocation(time.RFC3339, created, location)
@@ -394,6 +460,77 @@ func newAzureNodePool(name, clusterName, created, release, description string, n
 	return np
 }
 
+// withAzureInstances sets the VM details of an Azure node pool. An empty
+// maximum price disables spot VMs.
+func withAzureInstances(np *nodepool.Nodepool, vmSize string, zones []string, spotMaxPrice string) *nodepool.Nodepool {
+	np.AzureMachinePool.Spec.Template.VMSize = vmSize
+	np.MachinePool.Spec.FailureDomains = zones
+
+	if len(spotMaxPrice) > 0 {
+		maxPrice := resource.MustParse(spotMaxPrice)
+		np.AzureMachinePool.Spec.Template.SpotVMOptions = &capzv1alpha3.SpotVMOptions{
+			MaxPrice: &maxPrice,
+		}
+	}
+
+	return np
+}
+
+func newOpenStackMachineTemplate(name, clusterName, created, release string) *capov1alpha3.OpenStackMachineTemplate {
+	location, _ := time.LoadLocation("UTC")
+	parsedCreationDate, _ := time.ParseInLocation(time.RFC3339, created, location)
+	n := &capov1alpha3.OpenStackMachineTemplate{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "infrastructure.cluster.x-k8s.io/v1alpha3",
+			Kind:       "OpenStackMachineTemplate",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "org-giantswarm",
+			CreationTimestamp: metav1.NewTime(parsedCreationDate),
+			Labels: map[string]string{
+				label.MachineDeployment:       name,
+				label.ReleaseVersion:          release,
+				label.Organization:            "giantswarm",
+				capiv1alpha3.ClusterLabelName: clusterName,
+			},
+		},
+		Spec: capov1alpha3.OpenStackMachineTemplateSpec{
+			Template: capov1alpha3.OpenStackMachineTemplateResource{
+				Spec: capov1alpha3.OpenStackMachineSpec{
+					Flavor: "n1.medium",
+					Image:  "ubuntu-2004-kube-v1.19.9",
+				},
+			},
+		},
+	}
+
+	return n
+}
+
+func newOpenStackNodePool(name, clusterName, created, release, description, zone string, nodesMin, nodesMax, nodesDesired, nodesReady int) *nodepool.Nodepool {
+	openStackMT := newOpenStackMachineTemplate(name, clusterName, created, release)
+	md := newCAPIv1alpha3MachineDeployment(name, clusterName, created, release, nodesDesired, nodesReady)
+	md.Namespace = "org-giantswarm"
+	md.Labels[capiv1alpha3.ClusterLabelName] = clusterName
+	md.Annotations = map[string]string{
+		annotation.NodePoolMinSize: fmt.Sprintf("%d", nodesMin),
+		annotation.NodePoolMaxSize: fmt.Sprintf("%d", nodesMax),
+		annotation.MachinePoolName: description,
+	}
+	md.Status.Phase = string(capiv1alpha3.MachineDeploymentPhaseRunning)
+	if len(zone) > 0 {
+		md.Spec.Template.Spec.FailureDomain = &zone
+	}
+
+	np := &nodepool.Nodepool{
+		MachineDeployment:        md,
+		OpenStackMachineTemplate: openStackMT,
+	}
+
+	return np
+}
+
 func newNodePoolCollection(nps ...nodepool.Nodepool) *nodepool.Collection {
 	collection := &nodepool.Collection{
 		Items: nps,
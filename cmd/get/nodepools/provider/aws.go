@@ -5,31 +5,41 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/giantswarm/apiextensions/v3/pkg/annotation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/giantswarm/kubectl-gs/internal/feature"
 	"github.com/giantswarm/kubectl-gs/internal/key"
 	"github.com/giantswarm/kubectl-gs/pkg/data/domain/nodepool"
+	"github.com/giantswarm/kubectl-gs/pkg/output"
 )
 
-func GetAWSTable(npResource nodepool.Resource, capabilities *feature.Service) *metav1.Table {
+func GetAWSTable(npResource nodepool.Resource, capabilities *feature.Service, timestamps string) *metav1.Table {
 	table := &metav1.Table{
 		ColumnDefinitions: []metav1.TableColumnDefinition{
 			{Name: "Name", Type: "string"},
 			{Name: "Cluster Name", Type: "string"},
-			{Name: "Created", Type: "string", Format: "date-time"},
 			{Name: "Condition", Type: "string"},
 			{Name: "Nodes Min/Max", Type: "string"},
 			{Name: "Nodes Desired", Type: "integer"},
 			{Name: "Nodes Ready", Type: "integer"},
 			{Name: "Description", Type: "string"},
+			{Name: "Age", Type: "string"},
+			{Name: "Instance Type", Type: "string", Priority: 1},
+			{Name: "Availability Zones", Type: "string", Priority: 1},
+			{Name: "Subnet", Type: "string", Priority: 1},
+			{Name: "On-Demand Base", Type: "integer", Priority: 1},
+			{Name: "On-Demand Above Base", Type: "string", Priority: 1},
+			{Name: "Spot Instances", Type: "integer", Priority: 1},
+			{Name: "Organization", Type: "string", Priority: 1},
+			{Name: "Created By", Type: "string", Priority: 1},
 		},
 	}
 
 	switch n := npResource.(type) {
 	case *nodepool.Nodepool:
-		table.Rows = append(table.Rows, getAWSNodePoolRow(*n, capabilities))
+		table.Rows = append(table.Rows, getAWSNodePoolRow(*n, capabilities, timestamps))
 	case *nodepool.Collection:
 		// Sort ASC by Cluster name.
 		sort.Slice(n.Items, func(i, j int) bool {
@@ -45,7 +55,7 @@ func GetAWSTable(npResource nodepool.Resource, capabilities *feature.Service) *m
 			return strings.Compare(iClusterName, jClusterName) > 0
 		})
 		for _, nodePool := range n.Items {
-			table.Rows = append(table.Rows, getAWSNodePoolRow(nodePool, capabilities))
+			table.Rows = append(table.Rows, getAWSNodePoolRow(nodePool, capabilities, timestamps))
 		}
 	}
 
@@ -55,6 +65,7 @@ func GetAWSTable(npResource nodepool.Resource, capabilities *feature.Service) *m
 func getAWSNodePoolRow(
 	nodePool nodepool.Nodepool,
 	capabilities *feature.Service,
+	timestamps string,
 ) metav1.TableRow {
 	if nodePool.MachineDeployment == nil || nodePool.AWSMachineDeployment == nil {
 		return metav1.TableRow{}
@@ -64,12 +75,20 @@ func getAWSNodePoolRow(
 		Cells: []interface{}{
 			nodePool.MachineDeployment.GetName(),
 			key.ClusterID(nodePool.MachineDeployment),
-			nodePool.MachineDeployment.CreationTimestamp.UTC(),
-			getAWSLatestCondition(nodePool, capabilities),
-			getAWSAutoscaling(nodePool, capabilities),
+			AWSLatestCondition(nodePool, capabilities),
+			AWSAutoscaling(nodePool, capabilities),
 			nodePool.MachineDeployment.Status.Replicas,
 			nodePool.MachineDeployment.Status.ReadyReplicas,
-			getAWSDescription(nodePool),
+			AWSDescription(nodePool),
+			output.NewTimestamp(nodePool.MachineDeployment.CreationTimestamp, timestamps),
+			formatOptional(nodePool.AWSMachineDeployment.Spec.Provider.Worker.InstanceType),
+			formatOptional(strings.Join(nodePool.AWSMachineDeployment.Spec.Provider.AvailabilityZones, ",")),
+			AWSSubnet(nodePool),
+			nodePool.AWSMachineDeployment.Spec.Provider.InstanceDistribution.OnDemandBaseCapacity,
+			AWSOnDemandAboveBase(nodePool),
+			nodePool.AWSMachineDeployment.Status.Provider.Worker.SpotInstances,
+			formatOptional(key.Organization(nodePool.MachineDeployment)),
+			formatOptional(key.CreatedBy(nodePool.MachineDeployment)),
 		},
 		Object: runtime.RawExtension{
 			Object: nodePool.MachineDeployment,
@@ -77,7 +96,9 @@ func getAWSNodePoolRow(
 	}
 }
 
-func getAWSLatestCondition(nodePool nodepool.Nodepool, capabilities *feature.Service) string {
+// AWSLatestCondition returns the latest condition of the node pool, if
+// the release of the node pool reports conditions.
+func AWSLatestCondition(nodePool nodepool.Nodepool, capabilities *feature.Service) string {
 	releaseVersion := key.ReleaseVersion(nodePool.MachineDeployment)
 	isSupported := capabilities.Supports(feature.NodePoolConditions, releaseVersion)
 	if !isSupported {
@@ -89,7 +110,9 @@ func getAWSLatestCondition(nodePool nodepool.Nodepool, capabilities *feature.Ser
 	return naValue
 }
 
-func getAWSAutoscaling(nodePool nodepool.Nodepool, capabilities *feature.Service) string {
+// AWSAutoscaling returns the minimum and maximum number of nodes the
+// autoscaler keeps the node pool within, if the release supports it.
+func AWSAutoscaling(nodePool nodepool.Nodepool, capabilities *feature.Service) string {
 	releaseVersion := key.ReleaseVersion(nodePool.MachineDeployment)
 	isSupported := capabilities.Supports(feature.Autoscaling, releaseVersion)
 	if !isSupported {
@@ -102,7 +125,8 @@ func getAWSAutoscaling(nodePool nodepool.Nodepool, capabilities *feature.Service
 	return fmt.Sprintf("%d/%d", minScaling, maxScaling)
 }
 
-func getAWSDescription(nodePool nodepool.Nodepool) string {
+// AWSDescription returns the user friendly description of the node pool.
+func AWSDescription(nodePool nodepool.Nodepool) string {
 	description := nodePool.AWSMachineDeployment.Spec.NodePool.Description
 	if len(description) < 1 {
 		description = naValue
@@ -110,3 +134,28 @@ func getAWSDescription(nodePool nodepool.Nodepool) string {
 
 	return description
 }
+
+// AWSSubnet returns the subnet requested for the node pool, either as a
+// CIDR or, if only its size was given, as a prefix length.
+func AWSSubnet(nodePool nodepool.Nodepool) string {
+	annotations := nodePool.AWSMachineDeployment.GetAnnotations()
+	if cidr := annotations[annotation.MachineDeploymentSubnet]; len(cidr) > 0 {
+		return cidr
+	}
+	if size := annotations[annotation.AWSSubnetSize]; len(size) > 0 {
+		return "/" + size
+	}
+
+	return naValue
+}
+
+// AWSOnDemandAboveBase returns the percentage of on-demand instances above
+// the on-demand base capacity. AWS uses 100% unless told otherwise.
+func AWSOnDemandAboveBase(nodePool nodepool.Nodepool) string {
+	percentage := nodePool.AWSMachineDeployment.Spec.Provider.InstanceDistribution.OnDemandPercentageAboveBaseCapacity
+	if percentage == nil {
+		return "100%"
+	}
+
+	return fmt.Sprintf("%d%%", *percentage)
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -12,25 +13,32 @@ import (
 	"github.com/giantswarm/kubectl-gs/internal/feature"
 	"github.com/giantswarm/kubectl-gs/internal/key"
 	"github.com/giantswarm/kubectl-gs/pkg/data/domain/nodepool"
+	"github.com/giantswarm/kubectl-gs/pkg/output"
 )
 
-func GetAzureTable(npResource nodepool.Resource, capabilities *feature.Service) *metav1.Table {
+func GetAzureTable(npResource nodepool.Resource, capabilities *feature.Service, timestamps string) *metav1.Table {
 	table := &metav1.Table{
 		ColumnDefinitions: []metav1.TableColumnDefinition{
 			{Name: "Name", Type: "string"},
 			{Name: "Cluster Name", Type: "string"},
-			{Name: "Created", Type: "string", Format: "date-time"},
 			{Name: "Condition", Type: "string"},
 			{Name: "Nodes Min/Max", Type: "string"},
 			{Name: "Nodes Desired", Type: "integer"},
 			{Name: "Nodes Ready", Type: "integer"},
 			{Name: "Description", Type: "string"},
+			{Name: "Age", Type: "string"},
+			{Name: "VM Size", Type: "string", Priority: 1},
+			{Name: "Availability Zones", Type: "string", Priority: 1},
+			{Name: "Spot VMs", Type: "string", Priority: 1},
+			{Name: "Spot Max Price", Type: "string", Priority: 1},
+			{Name: "Organization", Type: "string", Priority: 1},
+			{Name: "Created By", Type: "string", Priority: 1},
 		},
 	}
 
 	switch n := npResource.(type) {
 	case *nodepool.Nodepool:
-		table.Rows = append(table.Rows, getAzureNodePoolRow(*n, capabilities))
+		table.Rows = append(table.Rows, getAzureNodePoolRow(*n, capabilities, timestamps))
 	case *nodepool.Collection:
 		// Sort ASC by Cluster name.
 		sort.Slice(n.Items, func(i, j int) bool {
@@ -47,14 +55,14 @@ func GetAzureTable(npResource nodepool.Resource, capabilities *feature.Service)
 		})
 
 		for _, nodePool := range n.Items {
-			table.Rows = append(table.Rows, getAzureNodePoolRow(nodePool, capabilities))
+			table.Rows = append(table.Rows, getAzureNodePoolRow(nodePool, capabilities, timestamps))
 		}
 	}
 
 	return table
 }
 
-func getAzureNodePoolRow(nodePool nodepool.Nodepool, capabilities *feature.Service) metav1.TableRow {
+func getAzureNodePoolRow(nodePool nodepool.Nodepool, capabilities *feature.Service, timestamps string) metav1.TableRow {
 	if nodePool.MachinePool == nil || nodePool.AzureMachinePool == nil {
 		return metav1.TableRow{}
 	}
@@ -63,12 +71,18 @@ func getAzureNodePoolRow(nodePool nodepool.Nodepool, capabilities *feature.Servi
 		Cells: []interface{}{
 			nodePool.MachinePool.GetName(),
 			nodePool.MachinePool.Labels[capiv1alpha3.ClusterLabelName],
-			nodePool.MachinePool.CreationTimestamp.UTC(),
-			getAzureLatestCondition(nodePool, capabilities),
-			getAzureAutoscaling(nodePool, capabilities),
+			AzureLatestCondition(nodePool, capabilities),
+			AzureAutoscaling(nodePool, capabilities),
 			nodePool.MachinePool.Status.Replicas,
 			nodePool.MachinePool.Status.ReadyReplicas,
-			getAzureDescription(nodePool),
+			AzureDescription(nodePool),
+			output.NewTimestamp(nodePool.MachinePool.CreationTimestamp, timestamps),
+			formatOptional(nodePool.AzureMachinePool.Spec.Template.VMSize),
+			formatOptional(strings.Join(nodePool.MachinePool.Spec.FailureDomains, ",")),
+			AzureSpotVMs(nodePool),
+			AzureSpotMaxPrice(nodePool),
+			formatOptional(key.Organization(nodePool.MachinePool)),
+			formatOptional(key.CreatedBy(nodePool.MachinePool)),
 		},
 		Object: runtime.RawExtension{
 			Object: nodePool.MachinePool,
@@ -76,7 +90,9 @@ func getAzureNodePoolRow(nodePool nodepool.Nodepool, capabilities *feature.Servi
 	}
 }
 
-func getAzureLatestCondition(nodePool nodepool.Nodepool, capabilities *feature.Service) string {
+// AzureLatestCondition returns the latest condition of the node pool, if
+// the release of the node pool reports conditions.
+func AzureLatestCondition(nodePool nodepool.Nodepool, capabilities *feature.Service) string {
 	releaseVersion := key.ReleaseVersion(nodePool.MachinePool)
 	isSupported := capabilities.Supports(feature.NodePoolConditions, releaseVersion)
 	if !isSupported {
@@ -90,7 +106,9 @@ func getAzureLatestCondition(nodePool nodepool.Nodepool, capabilities *feature.S
 	return naValue
 }
 
-func getAzureAutoscaling(nodePool nodepool.Nodepool, capabilities *feature.Service) string {
+// AzureAutoscaling returns the minimum and maximum number of nodes the
+// autoscaler keeps the node pool within, if the release supports it.
+func AzureAutoscaling(nodePool nodepool.Nodepool, capabilities *feature.Service) string {
 	releaseVersion := key.ReleaseVersion(nodePool.MachinePool)
 	isSupported := capabilities.Supports(feature.Autoscaling, releaseVersion)
 	if !isSupported {
@@ -105,7 +123,8 @@ func getAzureAutoscaling(nodePool nodepool.Nodepool, capabilities *feature.Servi
 	return naValue
 }
 
-func getAzureDescription(nodePool nodepool.Nodepool) string {
+// AzureDescription returns the user friendly name of the node pool.
+func AzureDescription(nodePool nodepool.Nodepool) string {
 	description := key.MachinePoolName(nodePool.MachinePool)
 	if len(description) < 1 {
 		description = naValue
@@ -113,3 +132,23 @@ func getAzureDescription(nodePool nodepool.Nodepool) string {
 
 	return description
 }
+
+// AzureSpotVMs returns whether the node pool uses spot VMs.
+func AzureSpotVMs(nodePool nodepool.Nodepool) string {
+	return strconv.FormatBool(nodePool.AzureMachinePool.Spec.Template.SpotVMOptions != nil)
+}
+
+// AzureSpotMaxPrice returns the maximum hourly price paid for the spot VMs
+// of the node pool. A price of -1 means that up to the on-demand price of
+// the VM size is paid.
+func AzureSpotMaxPrice(nodePool nodepool.Nodepool) string {
+	spotVMOptions := nodePool.AzureMachinePool.Spec.Template.SpotVMOptions
+	if spotVMOptions == nil || spotVMOptions.MaxPrice == nil {
+		return naValue
+	}
+	if spotVMOptions.MaxPrice.Sign() < 0 {
+		return "on-demand"
+	}
+
+	return spotVMOptions.MaxPrice.AsDec().String()
+}
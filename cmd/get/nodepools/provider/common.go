@@ -8,6 +8,14 @@ const (
 	naValue = "n/a"
 )
 
+func formatOptional(value string) string {
+	if len(value) < 1 {
+		return naValue
+	}
+
+	return value
+}
+
 func formatCondition(condition string) string {
 	return strings.ToUpper(condition)
 }
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/nodepool"
+	"github.com/giantswarm/kubectl-gs/pkg/output"
+)
+
+func GetOpenStackTable(npResource nodepool.Resource, timestamps string) *metav1.Table {
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Cluster Name", Type: "string"},
+			{Name: "Condition", Type: "string"},
+			{Name: "Nodes Min/Max", Type: "string"},
+			{Name: "Nodes Desired", Type: "integer"},
+			{Name: "Nodes Ready", Type: "integer"},
+			{Name: "Description", Type: "string"},
+			{Name: "Age", Type: "string"},
+			{Name: "Flavor", Type: "string", Priority: 1},
+			{Name: "Image", Type: "string", Priority: 1},
+			{Name: "Availability Zones", Type: "string", Priority: 1},
+			{Name: "Organization", Type: "string", Priority: 1},
+			{Name: "Created By", Type: "string", Priority: 1},
+		},
+	}
+
+	switch n := npResource.(type) {
+	case *nodepool.Nodepool:
+		table.Rows = append(table.Rows, getOpenStackNodePoolRow(*n, timestamps))
+	case *nodepool.Collection:
+		// Sort ASC by Cluster name.
+		sort.Slice(n.Items, func(i, j int) bool {
+			var iClusterName, jClusterName string
+
+			if n.Items[i].MachineDeployment != nil && n.Items[i].MachineDeployment.Labels != nil {
+				iClusterName = n.Items[i].MachineDeployment.Labels[capiv1alpha3.ClusterLabelName]
+			}
+			if n.Items[j].MachineDeployment != nil && n.Items[j].MachineDeployment.Labels != nil {
+				jClusterName = n.Items[j].MachineDeployment.Labels[capiv1alpha3.ClusterLabelName]
+			}
+
+			return strings.Compare(iClusterName, jClusterName) > 0
+		})
+
+		for _, nodePool := range n.Items {
+			table.Rows = append(table.Rows, getOpenStackNodePoolRow(nodePool, timestamps))
+		}
+	}
+
+	return table
+}
+
+func getOpenStackNodePoolRow(nodePool nodepool.Nodepool, timestamps string) metav1.TableRow {
+	if nodePool.MachineDeployment == nil || nodePool.OpenStackMachineTemplate == nil {
+		return metav1.TableRow{}
+	}
+
+	var failureDomain string
+	if nodePool.MachineDeployment.Spec.Template.Spec.FailureDomain != nil {
+		failureDomain = *nodePool.MachineDeployment.Spec.Template.Spec.FailureDomain
+	}
+
+	return metav1.TableRow{
+		Cells: []interface{}{
+			nodePool.MachineDeployment.GetName(),
+			nodePool.MachineDeployment.Labels[capiv1alpha3.ClusterLabelName],
+			OpenStackLatestCondition(nodePool),
+			OpenStackAutoscaling(nodePool),
+			nodePool.MachineDeployment.Status.Replicas,
+			nodePool.MachineDeployment.Status.ReadyReplicas,
+			OpenStackDescription(nodePool),
+			output.NewTimestamp(nodePool.MachineDeployment.CreationTimestamp, timestamps),
+			formatOptional(nodePool.OpenStackMachineTemplate.Spec.Template.Spec.Flavor),
+			formatOptional(nodePool.OpenStackMachineTemplate.Spec.Template.Spec.Image),
+			formatOptional(failureDomain),
+			formatOptional(key.Organization(nodePool.MachineDeployment)),
+			formatOptional(key.CreatedBy(nodePool.MachineDeployment)),
+		},
+		Object: runtime.RawExtension{
+			Object: nodePool.MachineDeployment,
+		},
+	}
+}
+
+// OpenStackLatestCondition returns the phase of the MachineDeployment, as
+// v1alpha3 MachineDeployments do not report conditions.
+func OpenStackLatestCondition(nodePool nodepool.Nodepool) string {
+	return formatCondition(formatOptional(nodePool.MachineDeployment.Status.Phase))
+}
+
+// OpenStackAutoscaling returns the minimum and maximum number of nodes of
+// the node pool.
+func OpenStackAutoscaling(nodePool nodepool.Nodepool) string {
+	minScaling, maxScaling := key.MachinePoolScaling(nodePool.MachineDeployment)
+	if minScaling >= 0 && maxScaling >= 0 {
+		return fmt.Sprintf("%d/%d", minScaling, maxScaling)
+	}
+
+	return naValue
+}
+
+// OpenStackDescription returns the user friendly name of the node pool.
+func OpenStackDescription(nodePool nodepool.Nodepool) string {
+	description := key.MachinePoolName(nodePool.MachineDeployment)
+	if len(description) < 1 {
+		description = naValue
+	}
+
+	return description
+}
@@ -6,11 +6,13 @@ import (
 	"io"
 	"strings"
 
+	semver "github.com/blang/semver/v4"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/giantswarm/kubectl-gs/pkg/commonconfig"
 	"github.com/giantswarm/kubectl-gs/pkg/data/domain/nodepool"
@@ -25,6 +27,10 @@ type runner struct {
 	provider string
 	service  nodepool.Interface
 
+	// tablePrinter prints all the tables of the command, so that further chunks and watch events
+	// only add new rows, aligned with the headers.
+	tablePrinter *output.TablePrinter
+
 	stdout io.Writer
 	stderr io.Writer
 }
@@ -63,39 +69,91 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 		}
 	}
 
-	var resource nodepool.Resource
+	var options nodepool.GetOptions
 	{
-		options := nodepool.GetOptions{
+		options = nodepool.GetOptions{
 			Provider:    r.provider,
 			ClusterName: r.flag.ClusterName,
 		}
-		{
-			if len(args) > 0 {
-				options.Name = strings.ToLower(args[0])
+
+		if len(args) > 0 {
+			if r.flag.hasFilters() {
+				return microerror.Maskf(invalidFlagError, "--%s, --%s, --%s and --%s cannot be used when getting a node pool by name", flagSelector, flagOrganization, flagRelease, flagCondition)
 			}
 
-			if r.flag.AllNamespaces {
-				options.Namespace = metav1.NamespaceAll
-			} else {
-				options.Namespace, _, err = r.flag.config.ToRawKubeConfigLoader().Namespace()
-				if err != nil {
-					return microerror.Mask(err)
-				}
+			options.Name = strings.ToLower(args[0])
+		}
+
+		if len(r.flag.Selector) > 0 {
+			options.LabelSelector, err = labels.Parse(r.flag.Selector)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+		if len(r.flag.Release) > 0 {
+			options.Release, err = semver.ParseRange(r.flag.Release)
+			if err != nil {
+				return microerror.Mask(err)
 			}
 		}
+		options.Organization = r.flag.Organization
+		options.Condition = r.flag.Condition
+		options.ChunkSize = r.flag.ChunkSize
+
+		if r.flag.AllNamespaces {
+			options.Namespace = metav1.NamespaceAll
+		} else {
+			options.Namespace, _, err = r.flag.config.ToRawKubeConfigLoader().Namespace()
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	}
 
-		resource, err = r.service.Get(ctx, options)
+	if !r.flag.WatchOnly {
+		err = r.printNodepools(ctx, options)
 		if nodepool.IsNotFound(err) {
 			return microerror.Maskf(notFoundError, fmt.Sprintf("A node pool with name '%s' cannot be found.\n", options.Name))
-		} else if nodepool.IsNoResources(err) && output.IsOutputDefault(r.flag.print.OutputFormat) {
-			r.printNoResourcesOutput()
-
-			return nil
+		} else if nodepool.IsNoResources(err) {
+			err = r.printNoResourcesOutput()
+			if err != nil {
+				return microerror.Mask(err)
+			}
 		} else if err != nil {
 			return microerror.Mask(err)
 		}
 	}
 
+	if r.flag.Watch || r.flag.WatchOnly {
+		err = r.service.Watch(ctx, options, func(e nodepool.Event) error {
+			return r.printOutput(e.Resource)
+		})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	return nil
+}
+
+// printNodepools prints the node pools matching the given options. Tables that
+// don't have to be sorted are printed chunk by chunk, as soon as the
+// node pools are listed.
+func (r *runner) printNodepools(ctx context.Context, options nodepool.GetOptions) error {
+	if output.IsOutputTable(r.flag.print.OutputFormat) && len(r.flag.SortBy) < 1 {
+		err := r.service.Stream(ctx, options, r.printOutput)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	}
+
+	resource, err := r.service.Get(ctx, options)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	err = r.printOutput(resource)
 	if err != nil {
 		return microerror.Mask(err)
@@ -23,12 +23,19 @@ import (
 //
 func Test_run(t *testing.T) {
 	testCases := []struct {
-		name               string
-		storage            []runtime.Object
-		args               []string
-		clusterName        string
-		expectedGoldenFile string
-		errorMatcher       func(error) bool
+		name                  string
+		storage               []runtime.Object
+		args                  []string
+		clusterName           string
+		selector              string
+		organization          string
+		release               string
+		watch                 bool
+		watchOnly             bool
+		outputFormat          string
+		expectedGoldenFile    string
+		expectedErrGoldenFile string
+		errorMatcher          func(error) bool
 	}{
 		{
 			name: "case 0: get nodepools",
@@ -42,10 +49,10 @@ func Test_run(t *testing.T) {
 			expectedGoldenFile: "run_get_nodepools.golden",
 		},
 		{
-			name:               "case 1: get nodepools, with empty storage",
-			storage:            nil,
-			args:               nil,
-			expectedGoldenFile: "run_get_nodepools_empty_storage.golden",
+			name:                  "case 1: get nodepools, with empty storage",
+			storage:               nil,
+			args:                  nil,
+			expectedErrGoldenFile: "run_get_nodepools_empty_storage.golden",
 		},
 		{
 			name: "case 2: get nodepool by name",
@@ -103,11 +110,11 @@ func Test_run(t *testing.T) {
 			expectedGoldenFile: "run_get_nodepool_by_id_and_cluster_id.golden",
 		},
 		{
-			name:               "case 7: get nodepools by cluster name, with empty storage",
-			storage:            nil,
-			args:               nil,
-			clusterName:        "s921a",
-			expectedGoldenFile: "run_get_nodepool_by_cluster_id_empty_storage.golden",
+			name:                  "case 7: get nodepools by cluster name, with empty storage",
+			storage:               nil,
+			args:                  nil,
+			clusterName:           "s921a",
+			expectedErrGoldenFile: "run_get_nodepool_by_cluster_id_empty_storage.golden",
 		},
 		{
 			name:         "case 8: get nodepools by name and cluster name, with empty storage",
@@ -116,23 +123,110 @@ func Test_run(t *testing.T) {
 			clusterName:  "s921a",
 			errorMatcher: IsNotFound,
 		},
+		{
+			name: "case 9: get nodepools, and watch for changes",
+			storage: []runtime.Object{
+				newCAPIv1alpha3MachineDeployment("1sad2", "s921a", "2021-01-02T15:04:32Z", "10.5.0", 2, 1),
+				newAWSMachineDeployment("1sad2", "s921a", "2021-01-01T15:04:32Z", "10.5.0", "test nodepool 3", 1, 3),
+				newCAPIv1alpha3MachineDeployment("f930q", "s921a", "2021-01-02T15:04:32Z", "11.0.0", 6, 6),
+				newAWSMachineDeployment("f930q", "s921a", "2021-01-02T15:04:32Z", "11.0.0", "test nodepool 4", 5, 8),
+			},
+			args:               nil,
+			watch:              true,
+			expectedGoldenFile: "run_get_nodepools_watch.golden",
+		},
+		{
+			name: "case 10: watch nodepools, without listing first",
+			storage: []runtime.Object{
+				newCAPIv1alpha3MachineDeployment("1sad2", "s921a", "2021-01-02T15:04:32Z", "10.5.0", 2, 1),
+				newAWSMachineDeployment("1sad2", "s921a", "2021-01-01T15:04:32Z", "10.5.0", "test nodepool 3", 1, 3),
+				newCAPIv1alpha3MachineDeployment("f930q", "s921a", "2021-01-02T15:04:32Z", "11.0.0", 6, 6),
+				newAWSMachineDeployment("f930q", "s921a", "2021-01-02T15:04:32Z", "11.0.0", "test nodepool 4", 5, 8),
+			},
+			args:               nil,
+			watchOnly:          true,
+			expectedGoldenFile: "run_get_nodepools_watch_only.golden",
+		},
+		{
+			name: "case 11: get nodepools, filtered by release range",
+			storage: []runtime.Object{
+				newCAPIv1alpha3MachineDeployment("1sad2", "s921a", "2021-01-02T15:04:32Z", "10.5.0", 2, 1),
+				newAWSMachineDeployment("1sad2", "s921a", "2021-01-01T15:04:32Z", "10.5.0", "test nodepool 3", 1, 3),
+				newCAPIv1alpha3MachineDeployment("f930q", "s921a", "2021-01-02T15:04:32Z", "11.0.0", 6, 6),
+				newAWSMachineDeployment("f930q", "s921a", "2021-01-02T15:04:32Z", "11.0.0", "test nodepool 4", 5, 8),
+				newCAPIv1alpha3MachineDeployment("9k2l1", "a8d2s", "2021-01-03T15:04:32Z", "11.1.0", 3, 3),
+				newAWSMachineDeployment("9k2l1", "a8d2s", "2021-01-03T15:04:32Z", "11.1.0", "test nodepool 5", 3, 5),
+			},
+			args:               nil,
+			release:            "11.x",
+			expectedGoldenFile: "run_get_nodepools_by_release.golden",
+		},
+		{
+			name: "case 12: get nodepools, filtered by label selector",
+			storage: []runtime.Object{
+				newCAPIv1alpha3MachineDeployment("1sad2", "s921a", "2021-01-02T15:04:32Z", "10.5.0", 2, 1),
+				newAWSMachineDeployment("1sad2", "s921a", "2021-01-01T15:04:32Z", "10.5.0", "test nodepool 3", 1, 3),
+				newCAPIv1alpha3MachineDeployment("f930q", "s921a", "2021-01-02T15:04:32Z", "11.0.0", 6, 6),
+				newAWSMachineDeployment("f930q", "s921a", "2021-01-02T15:04:32Z", "11.0.0", "test nodepool 4", 5, 8),
+				newCAPIv1alpha3MachineDeployment("9k2l1", "a8d2s", "2021-01-03T15:04:32Z", "11.1.0", 3, 3),
+				newAWSMachineDeployment("9k2l1", "a8d2s", "2021-01-03T15:04:32Z", "11.1.0", "test nodepool 5", 3, 5),
+			},
+			args:               nil,
+			selector:           "giantswarm.io/cluster=a8d2s",
+			expectedGoldenFile: "run_get_nodepools_by_selector.golden",
+		},
+		{
+			name: "case 13: get nodepools, with filters not matching any node pool",
+			storage: []runtime.Object{
+				newCAPIv1alpha3MachineDeployment("1sad2", "s921a", "2021-01-02T15:04:32Z", "10.5.0", 2, 1),
+				newAWSMachineDeployment("1sad2", "s921a", "2021-01-01T15:04:32Z", "10.5.0", "test nodepool 3", 1, 3),
+				newCAPIv1alpha3MachineDeployment("f930q", "s921a", "2021-01-02T15:04:32Z", "11.0.0", 6, 6),
+				newAWSMachineDeployment("f930q", "s921a", "2021-01-02T15:04:32Z", "11.0.0", "test nodepool 4", 5, 8),
+				newCAPIv1alpha3MachineDeployment("9k2l1", "a8d2s", "2021-01-03T15:04:32Z", "11.1.0", 3, 3),
+				newAWSMachineDeployment("9k2l1", "a8d2s", "2021-01-03T15:04:32Z", "11.1.0", "test nodepool 5", 3, 5),
+			},
+			args:                  nil,
+			organization:          "unknown",
+			expectedErrGoldenFile: "run_get_nodepools_empty_storage.golden",
+		},
+		{
+			name:                  "case 14: get nodepools, with empty storage, and YAML output",
+			storage:               nil,
+			args:                  nil,
+			outputFormat:          output.TypeYAML,
+			expectedGoldenFile:    "run_get_nodepools_empty_storage_yaml_output.golden",
+			expectedErrGoldenFile: "run_get_nodepools_empty_storage.golden",
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.TODO()
 
+			outputFormat := output.TypeDefault
+			if len(tc.outputFormat) > 0 {
+				outputFormat = tc.outputFormat
+			}
+
 			fakeKubeConfig := kubeconfig.CreateFakeKubeConfig()
 			flag := &flag{
-				print:       genericclioptions.NewPrintFlags("").WithDefaultOutput(output.TypeDefault),
-				config:      genericclioptions.NewTestConfigFlags().WithClientConfig(fakeKubeConfig),
-				ClusterName: tc.clusterName,
+				print:        genericclioptions.NewPrintFlags("").WithDefaultOutput(outputFormat),
+				config:       genericclioptions.NewTestConfigFlags().WithClientConfig(fakeKubeConfig),
+				ClusterName:  tc.clusterName,
+				Selector:     tc.selector,
+				Organization: tc.organization,
+				Release:      tc.release,
+				Timestamps:   output.TimestampsAbsolute,
+				Watch:        tc.watch,
+				WatchOnly:    tc.watchOnly,
 			}
 			out := new(bytes.Buffer)
+			errOut := new(bytes.Buffer)
 			runner := &runner{
 				service:  nodepool.NewFakeService(tc.storage),
 				flag:     flag,
 				stdout:   out,
+				stderr:   errOut,
 				provider: key.ProviderAWS,
 			}
 
@@ -147,27 +241,42 @@ func Test_run(t *testing.T) {
 				t.Fatalf("unexpected error: %s", err.Error())
 			}
 
-			var expectedResult []byte
-			{
-				gf := goldenfile.New("testdata", tc.expectedGoldenFile)
-				if *update {
-					err = gf.Update(out.Bytes())
-					if err != nil {
-						t.Fatalf("unexpected error: %s", err.Error())
-					}
-					expectedResult = out.Bytes()
-				} else {
-					expectedResult, err = gf.Read()
-					if err != nil {
-						t.Fatalf("unexpected error: %s", err.Error())
-					}
-				}
-			}
+			assertGoldenFile(t, tc.expectedGoldenFile, out.Bytes())
+			assertGoldenFile(t, tc.expectedErrGoldenFile, errOut.Bytes())
+		})
+	}
+}
+
+func assertGoldenFile(t *testing.T, name string, actual []byte) {
+	var err error
+
+	if len(name) < 1 {
+		if len(actual) > 0 {
+			t.Fatalf("unexpected output, got:\n %s", actual)
+		}
 
-			diff := cmp.Diff(string(expectedResult), out.String())
-			if diff != "" {
-				t.Fatalf("value not expected, got:\n %s", diff)
+		return
+	}
+
+	var expectedResult []byte
+	{
+		gf := goldenfile.New("testdata", name)
+		if *update {
+			err = gf.Update(actual)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
 			}
-		})
+			expectedResult = actual
+		} else {
+			expectedResult, err = gf.Read()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+		}
+	}
+
+	diff := cmp.Diff(string(expectedResult), string(actual))
+	if diff != "" {
+		t.Fatalf("value not expected, got:\n %s", diff)
 	}
 }
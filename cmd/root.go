@@ -11,8 +11,10 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/client-go/tools/clientcmd"
 
+	"github.com/giantswarm/kubectl-gs/cmd/describe"
 	"github.com/giantswarm/kubectl-gs/cmd/get"
 	"github.com/giantswarm/kubectl-gs/cmd/login"
+	"github.com/giantswarm/kubectl-gs/cmd/search"
 	"github.com/giantswarm/kubectl-gs/cmd/template"
 	"github.com/giantswarm/kubectl-gs/cmd/validate"
 	"github.com/giantswarm/kubectl-gs/pkg/project"
@@ -81,9 +83,10 @@ func New(config Config) (*cobra.Command, error) {
 	var templateCmd *cobra.Command
 	{
 		c := template.Config{
-			Logger: config.Logger,
-			Stderr: config.Stderr,
-			Stdout: config.Stdout,
+			Logger:     config.Logger,
+			FileSystem: config.FileSystem,
+			Stderr:     config.Stderr,
+			Stdout:     config.Stdout,
 		}
 
 		templateCmd, err = template.New(c)
@@ -110,6 +113,42 @@ func New(config Config) (*cobra.Command, error) {
 		}
 	}
 
+	var describeCmd *cobra.Command
+	{
+		c := describe.Config{
+			Logger:     config.Logger,
+			FileSystem: config.FileSystem,
+
+			K8sConfigAccess: config.K8sConfigAccess,
+
+			Stderr: config.Stderr,
+			Stdout: config.Stdout,
+		}
+
+		describeCmd, err = describe.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var searchCmd *cobra.Command
+	{
+		c := search.Config{
+			Logger:     config.Logger,
+			FileSystem: config.FileSystem,
+
+			K8sConfigAccess: config.K8sConfigAccess,
+
+			Stderr: config.Stderr,
+			Stdout: config.Stdout,
+		}
+
+		searchCmd, err = search.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var validateCmd *cobra.Command
 	{
 		c := validate.Config{
@@ -155,6 +194,8 @@ func New(config Config) (*cobra.Command, error) {
 	c.AddCommand(loginCmd)
 	c.AddCommand(templateCmd)
 	c.AddCommand(getCmd)
+	c.AddCommand(describeCmd)
+	c.AddCommand(searchCmd)
 	c.AddCommand(validateCmd)
 
 	return c, nil
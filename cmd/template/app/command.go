@@ -6,6 +6,7 @@ import (
 
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
@@ -15,7 +16,9 @@ const (
 )
 
 type Config struct {
-	Logger micrologger.Logger
+	Logger     micrologger.Logger
+	FileSystem afero.Fs
+
 	Stderr io.Writer
 	Stdout io.Writer
 }
@@ -24,6 +27,9 @@ func New(config Config) (*cobra.Command, error) {
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
+	if config.FileSystem == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.FileSystem must not be empty", config)
+	}
 	if config.Stderr == nil {
 		config.Stderr = os.Stderr
 	}
@@ -36,6 +42,7 @@ func New(config Config) (*cobra.Command, error) {
 	r := &runner{
 		flag:   f,
 		logger: config.Logger,
+		fs:     config.FileSystem,
 		stderr: config.Stderr,
 		stdout: config.Stdout,
 	}
@@ -4,6 +4,15 @@ import (
 	"github.com/giantswarm/microerror"
 )
 
+var catalogNotFoundError = &microerror.Error{
+	Kind: "catalogNotFoundError",
+}
+
+// IsCatalogNotFound asserts catalogNotFoundError.
+func IsCatalogNotFound(err error) bool {
+	return microerror.Cause(err) == catalogNotFoundError
+}
+
 var invalidConfigError = &microerror.Error{
 	Kind: "invalidConfigError",
 }
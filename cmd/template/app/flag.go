@@ -1,53 +1,92 @@
 package app
 
 import (
+	"net/url"
+	"strings"
+	"time"
+
 	"github.com/giantswarm/microerror"
 	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	"github.com/giantswarm/kubectl-gs/pkg/annotations"
 	"github.com/giantswarm/kubectl-gs/pkg/labels"
+	"github.com/giantswarm/kubectl-gs/pkg/template/apply"
+	"github.com/giantswarm/kubectl-gs/pkg/template/flux"
 )
 
 const (
 	flagAppName                    = "app-name"
+	flagApply                      = "apply"
 	flagCatalog                    = "catalog"
+	flagCatalogURL                 = "catalog-url"
 	flagCluster                    = "cluster"
 	flagDefaultingEnabled          = "defaulting-enabled"
+	flagDryRun                     = "dry-run"
+	flagFluxHelmRelease            = "flux-helm-release"
+	flagForce                      = "force"
 	flagName                       = "name"
 	flagNamespace                  = "namespace"
 	flagNamespaceConfigAnnotations = "namespace-annotations"
 	flagNamespaceConfigLabels      = "namespace-labels"
+	flagOutputDir                  = "output-dir"
 	flagUserConfigMap              = "user-configmap"
 	flagUserSecret                 = "user-secret"
 	flagVersion                    = "version"
+	flagWait                       = "wait"
+	flagWaitTimeout                = "wait-timeout"
 )
 
 type flag struct {
 	AppName                        string
+	Apply                          bool
 	Catalog                        string
+	CatalogURL                     string
 	Cluster                        string
 	DefaultingEnabled              bool
+	DryRun                         string
+	Flux                           flux.Flag
+	FluxHelmRelease                bool
+	Force                          bool
 	Name                           string
 	Namespace                      string
+	OutputDir                      string
 	Version                        string
+	Wait                           bool
+	WaitTimeout                    time.Duration
 	flagNamespaceConfigAnnotations []string
 	flagNamespaceConfigLabels      []string
 	flagUserConfigMap              string
 	flagUserSecret                 string
+
+	config genericclioptions.RESTClientGetter
 }
 
 func (f *flag) Init(cmd *cobra.Command) {
 	cmd.Flags().StringVar(&f.AppName, flagAppName, "", "Optionally set a different name for the App CR.")
+	cmd.Flags().BoolVar(&f.Apply, flagApply, false, "Create the CRs in the management cluster of the current context instead of printing them.")
 	cmd.Flags().StringVar(&f.Catalog, flagCatalog, "", "Catalog name where app is stored.")
+	cmd.Flags().StringVar(&f.CatalogURL, flagCatalogURL, "", "Storage URL of the catalog, used for the Flux HelmRepository with --flux-helm-release. Taken from the Catalog CR in the management cluster of the current context if not given.")
 	cmd.Flags().StringVar(&f.Name, flagName, "", "Name of the app in the Catalog.")
 	cmd.Flags().StringVar(&f.Namespace, flagNamespace, "", "Namespace where the app will be deployed.")
 	cmd.Flags().StringVar(&f.Cluster, flagCluster, "", "Name of the cluster the app will be deployed to.")
 	cmd.Flags().BoolVar(&f.DefaultingEnabled, flagDefaultingEnabled, true, "Don't template fields that will be defaulted.")
+	cmd.Flags().StringVar(&f.DryRun, flagDryRun, apply.DryRunNone, "With --apply, set to server to only submit the CRs for validation by the API server, without persisting them. One of none, server.")
+	cmd.Flags().BoolVar(&f.FluxHelmRelease, flagFluxHelmRelease, false, "Template a Flux HelmRelease and HelmRepository instead of the App CR.")
+	cmd.Flags().BoolVar(&f.Force, flagForce, false, "Overwrite existing files when writing into --output-dir.")
+	cmd.Flags().StringVar(&f.OutputDir, flagOutputDir, "", "Directory to write the CRs into, one file per CR named <kind>-<name>.yaml, listed in the kustomization.yaml of the directory.")
 	cmd.Flags().StringVar(&f.flagUserConfigMap, flagUserConfigMap, "", "Path to the user values configmap YAML file.")
 	cmd.Flags().StringVar(&f.flagUserSecret, flagUserSecret, "", "Path to the user secrets YAML file.")
 	cmd.Flags().StringVar(&f.Version, flagVersion, "", "App version to be installed.")
+	cmd.Flags().BoolVar(&f.Wait, flagWait, false, "With --apply, wait for the created CRs to become ready.")
+	cmd.Flags().DurationVar(&f.WaitTimeout, flagWaitTimeout, 30*time.Minute, "How long to wait for the CRs to become ready with --wait.")
 	cmd.Flags().StringSliceVar(&f.flagNamespaceConfigAnnotations, flagNamespaceConfigAnnotations, nil, "Namespace configuration annotations in form key=value.")
 	cmd.Flags().StringSliceVar(&f.flagNamespaceConfigLabels, flagNamespaceConfigLabels, nil, "Namespace configuration labels in form key=value.")
+
+	f.Flux.Init(cmd)
+
+	f.config = apply.NewConfigFlags()
+	f.config.(*genericclioptions.ConfigFlags).AddFlags(cmd.Flags())
 }
 
 func (f *flag) Validate() error {
@@ -67,7 +106,38 @@ func (f *flag) Validate() error {
 		return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagVersion)
 	}
 
-	_, err := labels.Parse(f.flagNamespaceConfigLabels)
+	if f.Apply {
+		if f.OutputDir != "" {
+			return microerror.Maskf(invalidFlagError, "--%s and --%s cannot be combined", flagApply, flagOutputDir)
+		}
+		if !apply.IsDryRunStrategy(f.DryRun) {
+			return microerror.Maskf(invalidFlagError, "--%s must be one of %s", flagDryRun, strings.Join(apply.DryRunStrategies, ", "))
+		}
+		if f.Wait && f.DryRun == apply.DryRunServer {
+			return microerror.Maskf(invalidFlagError, "--%s cannot be combined with --%s=%s", flagWait, flagDryRun, apply.DryRunServer)
+		}
+	} else if f.DryRun == apply.DryRunServer || f.Wait {
+		return microerror.Maskf(invalidFlagError, "--%s and --%s require --%s", flagDryRun, flagWait, flagApply)
+	}
+
+	err := f.Flux.Validate(f.OutputDir)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	if f.FluxHelmRelease {
+		if !f.Flux.Enabled() {
+			return microerror.Maskf(invalidFlagError, "--%s requires --gitops=%s", flagFluxHelmRelease, flux.GitOps)
+		}
+	} else if f.CatalogURL != "" {
+		return microerror.Maskf(invalidFlagError, "--%s requires --%s", flagCatalogURL, flagFluxHelmRelease)
+	}
+	if f.CatalogURL != "" {
+		if _, err := url.ParseRequestURI(f.CatalogURL); err != nil {
+			return microerror.Maskf(invalidFlagError, "--%s must be a valid URL", flagCatalogURL)
+		}
+	}
+
+	_, err = labels.Parse(f.flagNamespaceConfigLabels)
 	if err != nil {
 		return microerror.Maskf(invalidFlagError, "--%s must contain valid label definitions (%s)", flagNamespaceConfigLabels, err)
 	}
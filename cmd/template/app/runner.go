@@ -3,26 +3,34 @@ package app
 import (
 	"context"
 	"io"
-	"os"
 	"text/template"
 
+	applicationv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/application/v1alpha1"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
 	"github.com/giantswarm/kubectl-gs/internal/key"
 	"github.com/giantswarm/kubectl-gs/pkg/annotations"
+	"github.com/giantswarm/kubectl-gs/pkg/commonconfig"
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
 	"github.com/giantswarm/kubectl-gs/pkg/labels"
 	templateapp "github.com/giantswarm/kubectl-gs/pkg/template/app"
+	"github.com/giantswarm/kubectl-gs/pkg/template/apply"
+	"github.com/giantswarm/kubectl-gs/pkg/template/flux"
 )
 
 type runner struct {
 	flag   *flag
 	logger micrologger.Logger
+	fs     afero.Fs
 	stdout io.Writer
 	stderr io.Writer
+
+	client *client.Client
 }
 
 func (r *runner) Run(cmd *cobra.Command, args []string) error {
@@ -56,7 +64,7 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 	}
 
 	if r.flag.flagUserSecret != "" {
-		userConfigSecretData, err := key.ReadSecretYamlFromFile(afero.NewOsFs(), r.flag.flagUserSecret)
+		userConfigSecretData, err := key.ReadSecretYamlFromFile(r.fs, r.flag.flagUserSecret)
 		if err != nil {
 			return microerror.Mask(err)
 		}
@@ -81,7 +89,7 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 	if r.flag.flagUserConfigMap != "" {
 		var configMapData string
 		if r.flag.flagUserConfigMap != "" {
-			configMapData, err = key.ReadConfigMapYamlFromFile(afero.NewOsFs(), r.flag.flagUserConfigMap)
+			configMapData, err = key.ReadConfigMapYamlFromFile(r.fs, r.flag.flagUserConfigMap)
 			if err != nil {
 				return microerror.Mask(err)
 			}
@@ -115,9 +123,12 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 	}
 	appConfig.NamespaceConfigLabels = namespaceLabels
 
-	appCRYaml, err := templateapp.NewAppCR(appConfig)
-	if err != nil {
-		return microerror.Mask(err)
+	var appCRYaml []byte
+	if !r.flag.FluxHelmRelease {
+		appCRYaml, err = templateapp.NewAppCR(appConfig)
+		if err != nil {
+			return microerror.Mask(err)
+		}
 	}
 
 	type AppCROutput struct {
@@ -134,10 +145,116 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 
 	t := template.Must(template.New("appCR").Parse(key.AppCRTemplate))
 
-	err = t.Execute(os.Stdout, appCROutput)
+	output, err := apply.NewOutput(apply.OutputConfig{
+		Apply:       r.flag.Apply,
+		DryRun:      r.flag.DryRun,
+		GetClient:   r.getClient,
+		Wait:        r.flag.Wait,
+		WaitTimeout: r.flag.WaitTimeout,
+		Fs:          r.fs,
+		Dir:         r.flag.OutputDir,
+		Force:       r.flag.Force,
+		Stdout:      r.stdout,
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = t.Execute(output, appCROutput)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if r.flag.FluxHelmRelease {
+		name := r.flag.AppName
+		if name == "" {
+			name = r.flag.Name
+		}
+
+		catalogURL, err := r.catalogURL(ctx)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = flux.WriteHelmRelease(output, flux.HelmReleaseConfig{
+			Catalog:                 r.flag.Catalog,
+			CatalogURL:              catalogURL,
+			Chart:                   r.flag.Name,
+			Cluster:                 r.flag.Cluster,
+			Name:                    name,
+			TargetNamespace:         r.flag.Namespace,
+			UserConfigConfigMapName: appConfig.UserConfigConfigMapName,
+			UserConfigSecretName:    appConfig.UserConfigSecretName,
+			Version:                 r.flag.Version,
+		})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	if r.flag.Flux.Enabled() {
+		err = r.flag.Flux.WriteKustomization(output, r.fs, r.flag.OutputDir)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	err = output.Flush(ctx)
 	if err != nil {
 		return microerror.Mask(err)
 	}
 
 	return nil
 }
+
+// catalogURL returns the storage URL of the catalog for the Flux
+// HelmRepository. Unless given with --catalog-url, it is taken from the
+// Catalog CR of the same name in the management cluster.
+func (r *runner) catalogURL(ctx context.Context) (string, error) {
+	if r.flag.CatalogURL != "" {
+		return r.flag.CatalogURL, nil
+	}
+
+	c, err := r.getClient()
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	catalogs := &applicationv1alpha1.CatalogList{}
+	err = c.List(ctx, catalogs)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	var catalogURL string
+	for _, catalog := range catalogs.Items {
+		if catalog.Name != r.flag.Catalog {
+			continue
+		}
+
+		if catalogURL != "" && catalogURL != catalog.Spec.Storage.URL {
+			return "", microerror.Maskf(invalidFlagError, "catalog %#q exists in several namespaces with different storage URLs, use --%s to set the one to use", r.flag.Catalog, flagCatalogURL)
+		}
+		catalogURL = catalog.Spec.Storage.URL
+	}
+
+	if catalogURL == "" {
+		return "", microerror.Maskf(catalogNotFoundError, "catalog %#q was not found in the management cluster, use --%s to set its storage URL", r.flag.Catalog, flagCatalogURL)
+	}
+
+	return catalogURL, nil
+}
+
+// getClient returns the client for the management cluster, which is created
+// on first use.
+func (r *runner) getClient() (runtimeclient.Client, error) {
+	if r.client == nil {
+		var err error
+		r.client, err = commonconfig.New(r.flag.config).GetClient(r.logger)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	return r.client.K8sClient.CtrlClient(), nil
+}
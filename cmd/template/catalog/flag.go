@@ -2,39 +2,74 @@ package catalog
 
 import (
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/giantswarm/microerror"
 	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/giantswarm/kubectl-gs/pkg/template/apply"
+	"github.com/giantswarm/kubectl-gs/pkg/template/flux"
 )
 
 const (
+	flagApply       = "apply"
 	flagConfigMap   = "configmap"
 	flagDescription = "description"
+	flagDryRun      = "dry-run"
+	flagForce       = "force"
 	flagLogoURL     = "logo"
 	flagName        = "name"
 	flagNamespace   = "namespace"
+	flagOutputDir   = "output-dir"
 	flagSecret      = "secret"
+	flagSeed        = "seed"
 	flagURL         = "url"
+	flagWait        = "wait"
+	flagWaitTimeout = "wait-timeout"
 )
 
 type flag struct {
+	Apply       bool
 	ConfigMap   string
 	Description string
+	DryRun      string
+	Flux        flux.Flag
+	Force       bool
 	LogoURL     string
 	Name        string
 	Namespace   string
+	OutputDir   string
 	Secret      string
+	Seed        string
 	URL         string
+	Wait        bool
+	WaitTimeout time.Duration
+
+	config genericclioptions.RESTClientGetter
 }
 
 func (f *flag) Init(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&f.Apply, flagApply, false, "Create the CRs in the management cluster of the current context instead of printing them.")
 	cmd.Flags().StringVar(&f.ConfigMap, flagConfigMap, "", "Path to a configmap file.")
 	cmd.Flags().StringVar(&f.Description, flagDescription, "", "Catalog description.")
+	cmd.Flags().StringVar(&f.DryRun, flagDryRun, apply.DryRunNone, "With --apply, set to server to only submit the CRs for validation by the API server, without persisting them. One of none, server.")
+	cmd.Flags().BoolVar(&f.Force, flagForce, false, "Overwrite existing files when writing into --output-dir.")
 	cmd.Flags().StringVar(&f.LogoURL, flagLogoURL, "", "Catalog logo URL.")
 	cmd.Flags().StringVar(&f.Name, flagName, "", "Catalog name.")
 	cmd.Flags().StringVar(&f.Namespace, flagNamespace, "", "Namespace where the catalog will be created.")
+	cmd.Flags().StringVar(&f.OutputDir, flagOutputDir, "", "Directory to write the CRs into, one file per CR named <kind>-<name>.yaml, listed in the kustomization.yaml of the directory.")
 	cmd.Flags().StringVar(&f.Secret, flagSecret, "", "Path to a secret file.")
+	cmd.Flags().StringVar(&f.Seed, flagSeed, "", "Derive the ID in the names of the config map and secret from the given seed, so the same flags always result in the same CRs.")
 	cmd.Flags().StringVar(&f.URL, flagURL, "", "Catalog storage URL.")
+	cmd.Flags().BoolVar(&f.Wait, flagWait, false, "With --apply, wait for the created CRs to become ready.")
+	cmd.Flags().DurationVar(&f.WaitTimeout, flagWaitTimeout, 30*time.Minute, "How long to wait for the CRs to become ready with --wait.")
+
+	f.Flux.Init(cmd)
+
+	f.config = apply.NewConfigFlags()
+	f.config.(*genericclioptions.ConfigFlags).AddFlags(cmd.Flags())
 }
 
 func (f *flag) Validate() error {
@@ -61,5 +96,24 @@ func (f *flag) Validate() error {
 		return microerror.Maskf(invalidFlagError, "--%s must be a valid URL", flagURL)
 	}
 
+	if f.Apply {
+		if f.OutputDir != "" {
+			return microerror.Maskf(invalidFlagError, "--%s and --%s cannot be combined", flagApply, flagOutputDir)
+		}
+		if !apply.IsDryRunStrategy(f.DryRun) {
+			return microerror.Maskf(invalidFlagError, "--%s must be one of %s", flagDryRun, strings.Join(apply.DryRunStrategies, ", "))
+		}
+		if f.Wait && f.DryRun == apply.DryRunServer {
+			return microerror.Maskf(invalidFlagError, "--%s cannot be combined with --%s=%s", flagWait, flagDryRun, apply.DryRunServer)
+		}
+	} else if f.DryRun == apply.DryRunServer || f.Wait {
+		return microerror.Maskf(invalidFlagError, "--%s and --%s require --%s", flagDryRun, flagWait, flagApply)
+	}
+
+	err := f.Flux.Validate(f.OutputDir)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	return nil
 }
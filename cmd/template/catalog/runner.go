@@ -3,24 +3,34 @@ package catalog
 import (
 	"context"
 	"io"
-	"os"
 	"text/template"
 
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
 	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/pkg/commonconfig"
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
+	"github.com/giantswarm/kubectl-gs/pkg/template/apply"
 	templatecatalog "github.com/giantswarm/kubectl-gs/pkg/template/catalog"
 )
 
 type runner struct {
 	flag   *flag
 	logger micrologger.Logger
+	fs     afero.Fs
 	stdout io.Writer
 	stderr io.Writer
+
+	client *client.Client
+
+	// generateID returns the ID in the names of the config map and secret.
+	// With --seed, it is replaced by a generator derived from the seed.
+	generateID func() string
 }
 
 func (r *runner) Run(cmd *cobra.Command, args []string) error {
@@ -31,6 +41,10 @@ func (r *runner) Run(cmd *cobra.Command, args []string) error {
 		return microerror.Mask(err)
 	}
 
+	if r.flag.Seed != "" {
+		r.generateID = key.SeededIDGenerator(r.flag.Seed)
+	}
+
 	err = r.run(ctx, cmd, args)
 	if err != nil {
 		return microerror.Mask(err)
@@ -47,7 +61,7 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 	config := templatecatalog.Config{
 		Description: r.flag.Description,
 		LogoURL:     r.flag.LogoURL,
-		ID:          key.GenerateID(),
+		ID:          r.generateID(),
 		Name:        r.flag.Name,
 		Namespace:   r.flag.Namespace,
 		URL:         r.flag.URL,
@@ -56,7 +70,7 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 	if r.flag.ConfigMap != "" {
 		var configMapData string
 
-		configMapData, err = key.ReadConfigMapYamlFromFile(afero.NewOsFs(), r.flag.ConfigMap)
+		configMapData, err = key.ReadConfigMapYamlFromFile(r.fs, r.flag.ConfigMap)
 		if err != nil {
 			return microerror.Mask(err)
 		}
@@ -76,7 +90,7 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 	if r.flag.Secret != "" {
 		var secretData []byte
 
-		secretData, err = key.ReadSecretYamlFromFile(afero.NewOsFs(), r.flag.Secret)
+		secretData, err = key.ReadSecretYamlFromFile(r.fs, r.flag.Secret)
 		if err != nil {
 			return microerror.Mask(err)
 		}
@@ -117,10 +131,51 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 
 	t := template.Must(template.New("catalogCR").Parse(key.CatalogCRTemplate))
 
-	err = t.Execute(os.Stdout, catalogCROutput)
+	output, err := apply.NewOutput(apply.OutputConfig{
+		Apply:       r.flag.Apply,
+		DryRun:      r.flag.DryRun,
+		GetClient:   r.getClient,
+		Wait:        r.flag.Wait,
+		WaitTimeout: r.flag.WaitTimeout,
+		Fs:          r.fs,
+		Dir:         r.flag.OutputDir,
+		Force:       r.flag.Force,
+		Stdout:      r.stdout,
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = t.Execute(output, catalogCROutput)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if r.flag.Flux.Enabled() {
+		err = r.flag.Flux.WriteKustomization(output, r.fs, r.flag.OutputDir)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	err = output.Flush(ctx)
 	if err != nil {
 		return microerror.Mask(err)
 	}
 
 	return nil
 }
+
+// getClient returns the client for the management cluster, which is created
+// on first use.
+func (r *runner) getClient() (runtimeclient.Client, error) {
+	if r.client == nil {
+		var err error
+		r.client, err = commonconfig.New(r.flag.config).GetClient(r.logger)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	return r.client.K8sClient.CtrlClient(), nil
+}
@@ -4,18 +4,120 @@ import (
 	"io"
 	"os"
 
+	"github.com/giantswarm/apiextensions/v3/pkg/id"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
 const (
 	name        = "cluster"
 	description = "Template Giant Swarm clusters."
+
+	longDescription = `Template Giant Swarm clusters.
+
+Instead of flags, a cluster can be described in a versioned spec file, which
+is validated before any CRs are rendered. Besides the cluster itself, the spec
+file can list node pools and apps, whose CRs are rendered along with the
+cluster CRs. Flags given on the command line override the values from the
+file, labels are merged.
+
+  apiVersion: kubectl-gs.giantswarm.io/v1alpha1
+  kind: ClusterSpec
+  provider: azure
+  name: a1b2c
+  description: Production cluster
+  owner: acme
+  release: 16.0.1
+  labels:
+    team: storage
+  controlPlane:
+    availabilityZones: ["1"]
+  nodePools:
+  - name: np001
+    description: Workers
+    nodesMin: 3
+    nodesMax: 10
+    azure:
+      vmSize: Standard_D4s_v3
+  apps:
+  - name: nginx-ingress-controller-app
+    catalog: giantswarm
+    namespace: kube-system
+    version: 1.17.0
+
+On AWS, the cluster spec also accepts controlPlane.subnetSize, network.podsCIDR
+and network.externalSNAT, and node pools take an aws section with instanceType,
+subnetSize, onDemandBaseCapacity, onDemandPercentageAboveBaseCapacity and
+useAlikeInstanceTypes. On Azure, node pools take an azure section with vmSize,
+spotVMs and spotVMsMaxPrice. KVM clusters have no node pools, their workers
+are set in a kvm section with workers, cpus, memory and storage, the latter
+two in GB per node. Their operator versions are read from the release in the
+management cluster, so KVM clusters cannot be templated offline. vSphere
+clusters take a vsphere section with server, datacenter, datastore, network,
+resourcePool, template and controlPlaneEndpoint, which also places their node
+pools. OpenStack clusters take an openstack section with cloud,
+externalNetworkID, dnsServers, flavor and image, the latter two used by the
+control plane and the node pools. Apps can carry user values under values,
+which are rendered into a config map referenced by the App CR.
+
+Use --print-spec to get the effective spec for the given flags and file, for
+example to turn an existing set of flags into a spec file.`
+
+	examples = `  # Render the CRs for a cluster described in a spec file
+  kubectl gs template cluster --from-file cluster.yaml
+
+  # Render the CRs for a spec file, using a different release
+  kubectl gs template cluster --from-file cluster.yaml --release 16.1.0
+
+  # Write the CRs into a GitOps repository, one file per CR
+  kubectl gs template cluster --from-file cluster.yaml \
+    --output-dir ./management-clusters/gauss/organizations/acme
+
+  # Also write the Flux Kustomization reconciling the directory
+  kubectl gs template cluster --from-file cluster.yaml \
+    --output-dir ./management-clusters/gauss/organizations/acme --gitops flux
+
+  # Create the CRs in the management cluster and wait until the cluster is ready
+  kubectl gs template cluster --from-file cluster.yaml --apply --wait
+
+  # Let the management cluster validate the CRs without creating them
+  kubectl gs template cluster --from-file cluster.yaml --apply --dry-run server
+
+  # Render the same CRs on every run, without access to a management cluster
+  kubectl gs template cluster --from-file cluster.yaml --seed production
+
+  # Render the KVMConfig for an on-premises cluster with five workers
+  kubectl gs template cluster --provider kvm --owner acme --release 14.1.0 \
+    --kvm-workers 5 --kvm-worker-cpus 8 --kvm-worker-memory 32
+
+  # Render the Cluster API CRs for a cluster on vSphere
+  kubectl gs template cluster --provider vsphere --owner acme --release 20.0.0 \
+    --vsphere-server vcenter.example.com --vsphere-datacenter dc0 \
+    --vsphere-datastore ds0 --vsphere-network "VM Network" \
+    --vsphere-resource-pool "*/Resources" --vsphere-template ubuntu-2004-kube-v1.19.9 \
+    --vsphere-control-plane-endpoint 10.0.0.10
+
+  # Render the Cluster API CRs for a cluster on OpenStack
+  kubectl gs template cluster --provider openstack --owner acme --release 20.0.0 \
+    --openstack-cloud openstack --openstack-external-network-id 4c9f2bb1-1a52-4d6e-9f2e-0a1c9f3b7d21 \
+    --openstack-flavor m1.large --openstack-image ubuntu-2004-kube-v1.19.9 \
+    --openstack-dns-servers 8.8.8.8
+
+  # Be asked for the values step by step
+  kubectl gs template cluster --interactive
+
+  # Create a spec file from flags
+  kubectl gs template cluster --provider aws --owner acme \
+    --control-plane-az eu-central-1a --print-spec > cluster.yaml`
 )
 
 type Config struct {
-	Logger micrologger.Logger
+	Logger     micrologger.Logger
+	FileSystem afero.Fs
+
+	Stdin  io.Reader
 	Stderr io.Writer
 	Stdout io.Writer
 }
@@ -24,6 +126,12 @@ func New(config Config) (*cobra.Command, error) {
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
+	if config.FileSystem == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.FileSystem must not be empty", config)
+	}
+	if config.Stdin == nil {
+		config.Stdin = os.Stdin
+	}
 	if config.Stderr == nil {
 		config.Stderr = os.Stderr
 	}
@@ -36,15 +144,20 @@ func New(config Config) (*cobra.Command, error) {
 	r := &runner{
 		flag:   f,
 		logger: config.Logger,
+		fs:     config.FileSystem,
+		stdin:  config.Stdin,
 		stderr: config.Stderr,
 		stdout: config.Stdout,
+
+		generateID: id.Generate,
 	}
 
 	c := &cobra.Command{
-		Use:   name,
-		Short: description,
-		Long:  description,
-		RunE:  r.Run,
+		Use:     name,
+		Short:   description,
+		Long:    longDescription,
+		Example: examples,
+		RunE:    r.Run,
 	}
 
 	f.Init(c)
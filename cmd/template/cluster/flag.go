@@ -3,12 +3,20 @@ package cluster
 import (
 	"net"
 	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/giantswarm/microerror"
 	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	"github.com/giantswarm/kubectl-gs/internal/key"
 	"github.com/giantswarm/kubectl-gs/pkg/labels"
+	"github.com/giantswarm/kubectl-gs/pkg/template/apply"
+	"github.com/giantswarm/kubectl-gs/pkg/template/clusterspec"
+	"github.com/giantswarm/kubectl-gs/pkg/template/flux"
 )
 
 const (
@@ -19,16 +27,50 @@ const (
 	flagPodsCIDR           = "pods-cidr"
 	flagControlPlaneSubnet = "control-plane-subnet"
 
+	// KVM only.
+	flagKVMWorkers       = "kvm-workers"
+	flagKVMWorkerCPUs    = "kvm-worker-cpus"
+	flagKVMWorkerMemory  = "kvm-worker-memory"
+	flagKVMWorkerStorage = "kvm-worker-storage"
+
+	// OpenStack only.
+	flagOpenStackCloud             = "openstack-cloud"
+	flagOpenStackDNSServers        = "openstack-dns-servers"
+	flagOpenStackExternalNetworkID = "openstack-external-network-id"
+	flagOpenStackFlavor            = "openstack-flavor"
+	flagOpenStackImage             = "openstack-image"
+
+	// vSphere only.
+	flagVSphereClusterTemplate      = "vsphere-cluster-template"
+	flagVSphereControlPlaneEndpoint = "vsphere-control-plane-endpoint"
+	flagVSphereDatacenter           = "vsphere-datacenter"
+	flagVSphereDatastore            = "vsphere-datastore"
+	flagVSphereNetwork              = "vsphere-network"
+	flagVSphereResourcePool         = "vsphere-resource-pool"
+	flagVSphereServer               = "vsphere-server"
+	flagVSphereTemplate             = "vsphere-template"
+
 	// Common.
+	flagApply               = "apply"
 	flagClusterIDDeprecated = "cluster-id"
 	flagControlPlaneAZ      = "control-plane-az"
 	flagDescription         = "description"
+	flagDryRun              = "dry-run"
+	flagForce               = "force"
+	flagFromFile            = "from-file"
+	flagInteractive         = "interactive"
 	flagMasterAZ            = "master-az" // TODO: Remove some time after August 2021
 	flagName                = "name"
 	flagOutput              = "output"
+	flagOutputDir           = "output-dir"
 	flagOwner               = "owner"
+	flagPrintSpec           = "print-spec"
 	flagRelease             = "release"
 	flagLabel               = "label"
+	flagSeed                = "seed"
+	flagSSHSSOPublicKeyFile = "ssh-sso-public-key-file"
+	flagWait                = "wait"
+	flagWaitTimeout         = "wait-timeout"
 )
 
 type flag struct {
@@ -39,16 +81,53 @@ type flag struct {
 	ExternalSNAT       bool
 	PodsCIDR           string
 
+	// KVM only.
+	KVMWorkers       int
+	KVMWorkerCPUs    int
+	KVMWorkerMemory  int
+	KVMWorkerStorage int
+
+	// OpenStack only.
+	OpenStackCloud             string
+	OpenStackDNSServers        []string
+	OpenStackExternalNetworkID string
+	OpenStackFlavor            string
+	OpenStackImage             string
+
+	// vSphere only.
+	VSphereClusterTemplate      string
+	VSphereControlPlaneEndpoint string
+	VSphereDatacenter           string
+	VSphereDatastore            string
+	VSphereNetwork              string
+	VSphereResourcePool         string
+	VSphereServer               string
+	VSphereTemplate             string
+
 	// Common.
+	Apply               bool
 	ClusterIDDeprecated string
 	ControlPlaneAZ      []string
 	Description         string
+	DryRun              string
+	Flux                flux.Flag
+	Force               bool
+	FromFile            string
+	Interactive         bool
 	MasterAZ            []string
 	Name                string
 	Output              string
+	OutputDir           string
 	Owner               string
+	PrintSpec           bool
 	Release             string
 	Label               []string
+	Seed                string
+	SSHSSOPublicKeyFile string
+	Wait                bool
+	WaitTimeout         time.Duration
+
+	config genericclioptions.RESTClientGetter
 }
 
 func (f *flag) Init(cmd *cobra.Command) {
@@ -59,16 +138,55 @@ func (f *flag) Init(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&f.ExternalSNAT, flagExternalSNAT, false, "AWS CNI configuration.")
 	cmd.Flags().StringVar(&f.PodsCIDR, flagPodsCIDR, "", "CIDR used for the pods.")
 
+	// KVM only.
+	cmd.Flags().IntVar(&f.KVMWorkers, flagKVMWorkers, clusterspec.DefaultKVMWorkers, "Number of worker nodes.")
+	cmd.Flags().IntVar(&f.KVMWorkerCPUs, flagKVMWorkerCPUs, clusterspec.DefaultKVMCPUs, "Number of CPUs per worker node.")
+	cmd.Flags().IntVar(&f.KVMWorkerMemory, flagKVMWorkerMemory, clusterspec.DefaultKVMMemory, "Memory per worker node in GB.")
+	cmd.Flags().IntVar(&f.KVMWorkerStorage, flagKVMWorkerStorage, clusterspec.DefaultKVMStorage, "Disk size per worker node in GB.")
+
+	// OpenStack only.
+	cmd.Flags().StringVar(&f.OpenStackCloud, flagOpenStackCloud, "", "Name of the cloud in the clouds.yaml of the cluster, which is read from the secret <name>-cloud-config.")
+	cmd.Flags().StringSliceVar(&f.OpenStackDNSServers, flagOpenStackDNSServers, nil, "DNS servers of the subnet created for the cluster.")
+	cmd.Flags().StringVar(&f.OpenStackExternalNetworkID, flagOpenStackExternalNetworkID, "", "ID of the external network the floating IPs are allocated from.")
+	cmd.Flags().StringVar(&f.OpenStackFlavor, flagOpenStackFlavor, "", "Flavor of the control plane machines, and of the node pools of the cluster spec.")
+	cmd.Flags().StringVar(&f.OpenStackImage, flagOpenStackImage, "", "Image the machines boot from.")
+
+	// vSphere only.
+	cmd.Flags().StringVar(&f.VSphereClusterTemplate, flagVSphereClusterTemplate, "", "Path to a CAPV cluster template to use instead of the built-in one of CAPV v0.7.8.")
+	cmd.Flags().StringVar(&f.VSphereControlPlaneEndpoint, flagVSphereControlPlaneEndpoint, "", "IP address of the Kubernetes API endpoint of the cluster.")
+	cmd.Flags().StringVar(&f.VSphereDatacenter, flagVSphereDatacenter, "", "vCenter datacenter to create the machines in.")
+	cmd.Flags().StringVar(&f.VSphereDatastore, flagVSphereDatastore, "", "vCenter datastore holding the disks of the machines.")
+	cmd.Flags().StringVar(&f.VSphereNetwork, flagVSphereNetwork, "", "vCenter network the machines are attached to.")
+	cmd.Flags().StringVar(&f.VSphereResourcePool, flagVSphereResourcePool, "", "vCenter resource pool to create the machines in.")
+	cmd.Flags().StringVar(&f.VSphereServer, flagVSphereServer, "", "Address of the vCenter server.")
+	cmd.Flags().StringVar(&f.VSphereTemplate, flagVSphereTemplate, "", "VM template the machines are cloned from.")
+
 	// Common.
+	cmd.Flags().BoolVar(&f.Apply, flagApply, false, "Create the CRs in the management cluster of the current context instead of printing them.")
 	cmd.Flags().StringVar(&f.ClusterIDDeprecated, flagClusterIDDeprecated, "", "Unique identifier of the cluster (deprecated).")
 	cmd.Flags().StringSliceVar(&f.ControlPlaneAZ, flagControlPlaneAZ, nil, "Availability zone(s) to use by control plane nodes.")
 	cmd.Flags().StringSliceVar(&f.MasterAZ, flagMasterAZ, nil, "Replaced by --control-plane-az.")
 	cmd.Flags().StringVar(&f.Description, flagDescription, "", "User-friendly description of the cluster's purpose (formerly called name).")
+	cmd.Flags().StringVar(&f.DryRun, flagDryRun, apply.DryRunNone, "With --apply, set to server to only submit the CRs for validation by the API server, without persisting them. One of none, server.")
+	cmd.Flags().BoolVar(&f.Force, flagForce, false, "Overwrite existing files when writing into --output-dir.")
+	cmd.Flags().StringVar(&f.FromFile, flagFromFile, "", "Path to a cluster spec file. Flags given on the command line take precedence over the values in the file.")
+	cmd.Flags().BoolVar(&f.Interactive, flagInteractive, false, "Ask for the values of the flags not given on the command line, one at a time, and print the equivalent command line.")
 	cmd.Flags().StringVar(&f.Name, flagName, "", "Unique identifier of the cluster (formerly called ID).")
 	cmd.Flags().StringVar(&f.Output, flagOutput, "", "File path for storing CRs.")
+	cmd.Flags().StringVar(&f.OutputDir, flagOutputDir, "", "Directory to write the CRs into, one file per CR named <kind>-<name>.yaml, listed in the kustomization.yaml of the directory.")
 	cmd.Flags().StringVar(&f.Owner, flagOwner, "", "Workload cluster owner organization.")
+	cmd.Flags().BoolVar(&f.PrintSpec, flagPrintSpec, false, "Print the effective cluster spec for the given flags and spec file instead of the CRs.")
 	cmd.Flags().StringVar(&f.Release, flagRelease, "", "Workload cluster release. If not given, this remains empty for defaulting to the most recent one via the Management API.")
 	cmd.Flags().StringSliceVar(&f.Label, flagLabel, nil, "Workload cluster label.")
+	cmd.Flags().StringVar(&f.Seed, flagSeed, "", "Derive the names generated for the cluster, its control plane and node pools from the given seed, so the same flags always result in the same CRs.")
+	cmd.Flags().StringVar(&f.SSHSSOPublicKeyFile, flagSSHSSOPublicKeyFile, "", "Path to the SSH SSO public key, required for CAPA releases. If not given, the key is read from the management cluster.")
+	cmd.Flags().BoolVar(&f.Wait, flagWait, false, "With --apply, wait for the created CRs to become ready.")
+	cmd.Flags().DurationVar(&f.WaitTimeout, flagWaitTimeout, 30*time.Minute, "How long to wait for the CRs to become ready with --wait.")
+
+	f.Flux.Init(cmd)
+
+	f.config = apply.NewConfigFlags()
+	f.config.(*genericclioptions.ConfigFlags).AddFlags(cmd.Flags())
 
 	// TODO: Remove the flag completely some time after August 2021
 	_ = cmd.Flags().MarkDeprecated(flagMasterAZ, "please use --control-plane-az.")
@@ -97,72 +215,395 @@ func (f *flag) Validate() error {
 		f.ClusterIDDeprecated = ""
 	}
 
-	if f.Provider != key.ProviderAWS && f.Provider != key.ProviderAzure {
-		return microerror.Maskf(invalidFlagError, "--%s must be either aws or azure", flagProvider)
+	err = validateProvider(f.Provider)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if f.Output != "" && f.OutputDir != "" {
+		return microerror.Maskf(invalidFlagError, "--%s and --%s cannot be combined", flagOutput, flagOutputDir)
+	}
+	if f.PrintSpec && f.OutputDir != "" {
+		return microerror.Maskf(invalidFlagError, "--%s and --%s cannot be combined", flagPrintSpec, flagOutputDir)
+	}
+
+	if f.Apply {
+		if f.Output != "" {
+			return microerror.Maskf(invalidFlagError, "--%s and --%s cannot be combined", flagApply, flagOutput)
+		}
+		if f.OutputDir != "" {
+			return microerror.Maskf(invalidFlagError, "--%s and --%s cannot be combined", flagApply, flagOutputDir)
+		}
+		if f.PrintSpec {
+			return microerror.Maskf(invalidFlagError, "--%s and --%s cannot be combined", flagApply, flagPrintSpec)
+		}
+		if !apply.IsDryRunStrategy(f.DryRun) {
+			return microerror.Maskf(invalidFlagError, "--%s must be one of %s", flagDryRun, strings.Join(apply.DryRunStrategies, ", "))
+		}
+		if f.Wait && f.DryRun == apply.DryRunServer {
+			return microerror.Maskf(invalidFlagError, "--%s cannot be combined with --%s=%s", flagWait, flagDryRun, apply.DryRunServer)
+		}
+	} else if f.DryRun == apply.DryRunServer || f.Wait {
+		return microerror.Maskf(invalidFlagError, "--%s and --%s require --%s", flagDryRun, flagWait, flagApply)
+	}
+
+	err = f.Flux.Validate(f.OutputDir)
+	if err != nil {
+		return microerror.Mask(err)
 	}
 
 	if f.Name != "" {
-		if len(f.Name) != key.IDLength {
-			return microerror.Maskf(invalidFlagError, "--%s must be length of %d", flagClusterIDDeprecated, key.IDLength)
+		err = validateName(f.Name)
+		if err != nil {
+			return microerror.Mask(err)
 		}
 
-		matchedLettersOnly, err := regexp.MatchString("^[a-z]+$", f.Name)
-		if err == nil && matchedLettersOnly {
-			// strings is letters only, which we avoid
-			return microerror.Maskf(invalidFlagError, "--%s must contain at least one number", flagClusterIDDeprecated)
+		err = validateControlPlaneSubnet(f.ControlPlaneSubnet)
+		if err != nil {
+			return microerror.Mask(err)
 		}
 
-		matchedNumbersOnly, err := regexp.MatchString("^[0-9]+$", f.Name)
-		if err == nil && matchedNumbersOnly {
-			// strings is numbers only, which we avoid
-			return microerror.Maskf(invalidFlagError, "--%s must contain at least one digit", flagClusterIDDeprecated)
+		// The other settings of aws and azure clusters with a given name
+		// are not checked, the settings of the other providers always are.
+		if f.Provider == key.ProviderAWS || f.Provider == key.ProviderAzure {
+			return nil
 		}
+	}
+
+	err = validatePodsCIDR(f.PodsCIDR)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = validateOwner(f.Owner)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = validateControlPlaneAZ(f.Provider, f.ControlPlaneAZ)
+	if err != nil {
+		return microerror.Mask(err)
+	}
 
-		matched, err := regexp.MatchString("^[a-z][a-z0-9]+$", f.Name)
-		if err == nil && !matched {
-			return microerror.Maskf(invalidFlagError, "--%s must only contain alphanumeric characters, and start with a letter", flagClusterIDDeprecated)
+	err = validateRelease(f.Provider, f.Release)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if f.Provider == key.ProviderKVM {
+		for _, setting := range []struct {
+			name  string
+			value int
+		}{
+			{name: flagKVMWorkers, value: f.KVMWorkers},
+			{name: flagKVMWorkerCPUs, value: f.KVMWorkerCPUs},
+			{name: flagKVMWorkerMemory, value: f.KVMWorkerMemory},
+			{name: flagKVMWorkerStorage, value: f.KVMWorkerStorage},
+		} {
+			err = validateKVMWorkerSetting(setting.name, setting.value)
+			if err != nil {
+				return microerror.Mask(err)
+			}
 		}
+	}
 
-		if f.ControlPlaneSubnet != "" {
-			matchedSubnet, err := regexp.MatchString("^20|21|22|23|24|25$", f.ControlPlaneSubnet)
-			if err == nil && !matchedSubnet {
-				return microerror.Maskf(invalidFlagError, "--%s must be a valid subnet size (20, 21, 22, 23, 24 or 25)", flagControlPlaneSubnet)
+	if f.Provider == key.ProviderOpenStack {
+		for _, setting := range []struct {
+			name  string
+			value string
+		}{
+			{name: flagOpenStackCloud, value: f.OpenStackCloud},
+			{name: flagOpenStackExternalNetworkID, value: f.OpenStackExternalNetworkID},
+			{name: flagOpenStackFlavor, value: f.OpenStackFlavor},
+			{name: flagOpenStackImage, value: f.OpenStackImage},
+		} {
+			err = validateOpenStackSetting(setting.name, setting.value)
+			if err != nil {
+				return microerror.Mask(err)
 			}
 		}
 
-		return nil
+		err = validateOpenStackDNSServers(f.OpenStackDNSServers)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	if f.Provider == key.ProviderVSphere {
+		for _, setting := range []struct {
+			name  string
+			value string
+		}{
+			{name: flagVSphereServer, value: f.VSphereServer},
+			{name: flagVSphereDatacenter, value: f.VSphereDatacenter},
+			{name: flagVSphereDatastore, value: f.VSphereDatastore},
+			{name: flagVSphereNetwork, value: f.VSphereNetwork},
+			{name: flagVSphereResourcePool, value: f.VSphereResourcePool},
+			{name: flagVSphereTemplate, value: f.VSphereTemplate},
+		} {
+			err = validateVSphereSetting(setting.name, setting.value)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+
+		err = validateVSphereControlPlaneEndpoint(f.VSphereControlPlaneEndpoint)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	err = validateLabels(f.Label)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// applySpec takes the values of a cluster spec file for all the flags that
+// were not given on the command line. Labels are merged, with the ones given
+// as flags winning over the ones from the file.
+func (f *flag) applySpec(s *clusterspec.Spec, changed func(name string) bool) {
+	if !changed(flagProvider) {
+		f.Provider = s.Provider
+	}
+	if !changed(flagName) && !changed(flagClusterIDDeprecated) {
+		f.Name = s.Name
+	}
+	if !changed(flagDescription) {
+		f.Description = s.Description
+	}
+	if !changed(flagOwner) {
+		f.Owner = s.Owner
+	}
+	if !changed(flagRelease) {
+		f.Release = s.Release
+	}
+
+	if s.ControlPlane != nil {
+		if !changed(flagControlPlaneAZ) && !changed(flagMasterAZ) {
+			f.ControlPlaneAZ = s.ControlPlane.AvailabilityZones
+		}
+		if !changed(flagControlPlaneSubnet) && s.ControlPlane.SubnetSize != 0 {
+			f.ControlPlaneSubnet = strconv.Itoa(s.ControlPlane.SubnetSize)
+		}
+	}
+
+	if s.KVM != nil {
+		if !changed(flagKVMWorkers) && s.KVM.Workers != 0 {
+			f.KVMWorkers = s.KVM.Workers
+		}
+		if !changed(flagKVMWorkerCPUs) && s.KVM.CPUs != 0 {
+			f.KVMWorkerCPUs = s.KVM.CPUs
+		}
+		if !changed(flagKVMWorkerMemory) && s.KVM.Memory != 0 {
+			f.KVMWorkerMemory = s.KVM.Memory
+		}
+		if !changed(flagKVMWorkerStorage) && s.KVM.Storage != 0 {
+			f.KVMWorkerStorage = s.KVM.Storage
+		}
+	}
+
+	if s.OpenStack != nil {
+		if !changed(flagOpenStackCloud) {
+			f.OpenStackCloud = s.OpenStack.Cloud
+		}
+		if !changed(flagOpenStackDNSServers) {
+			f.OpenStackDNSServers = s.OpenStack.DNSServers
+		}
+		if !changed(flagOpenStackExternalNetworkID) {
+			f.OpenStackExternalNetworkID = s.OpenStack.ExternalNetworkID
+		}
+		if !changed(flagOpenStackFlavor) {
+			f.OpenStackFlavor = s.OpenStack.Flavor
+		}
+		if !changed(flagOpenStackImage) {
+			f.OpenStackImage = s.OpenStack.Image
+		}
+	}
+
+	if s.VSphere != nil {
+		if !changed(flagVSphereControlPlaneEndpoint) {
+			f.VSphereControlPlaneEndpoint = s.VSphere.ControlPlaneEndpoint
+		}
+		if !changed(flagVSphereDatacenter) {
+			f.VSphereDatacenter = s.VSphere.Datacenter
+		}
+		if !changed(flagVSphereDatastore) {
+			f.VSphereDatastore = s.VSphere.Datastore
+		}
+		if !changed(flagVSphereNetwork) {
+			f.VSphereNetwork = s.VSphere.Network
+		}
+		if !changed(flagVSphereResourcePool) {
+			f.VSphereResourcePool = s.VSphere.ResourcePool
+		}
+		if !changed(flagVSphereServer) {
+			f.VSphereServer = s.VSphere.Server
+		}
+		if !changed(flagVSphereTemplate) {
+			f.VSphereTemplate = s.VSphere.Template
+		}
+	}
+
+	if s.Network != nil {
+		if !changed(flagExternalSNAT) {
+			f.ExternalSNAT = s.Network.ExternalSNAT
+		}
+		if !changed(flagPodsCIDR) {
+			f.PodsCIDR = s.Network.PodsCIDR
+		}
+	}
+
+	if len(s.Labels) > 0 {
+		var fileLabels []string
+		for k, v := range s.Labels {
+			fileLabels = append(fileLabels, k+"="+v)
+		}
+		sort.Strings(fileLabels)
+
+		f.Label = append(fileLabels, f.Label...)
+	}
+}
+
+// The validate functions below check a single flag each. They are shared by
+// Validate and the interactive mode, which checks every answer right away.
+
+func validateProvider(provider string) error {
+	if provider != key.ProviderAWS && provider != key.ProviderAzure && provider != key.ProviderKVM && provider != key.ProviderOpenStack && provider != key.ProviderVSphere {
+		return microerror.Maskf(invalidFlagError, "--%s must be one of aws, azure, kvm, openstack, vsphere", flagProvider)
+	}
+
+	return nil
+}
+
+func validateName(name string) error {
+	if len(name) != key.IDLength {
+		return microerror.Maskf(invalidFlagError, "--%s must be length of %d", flagName, key.IDLength)
 	}
 
-	if f.PodsCIDR != "" {
-		if !validateCIDR(f.PodsCIDR) {
+	matchedLettersOnly, err := regexp.MatchString("^[a-z]+$", name)
+	if err == nil && matchedLettersOnly {
+		// strings is letters only, which we avoid
+		return microerror.Maskf(invalidFlagError, "--%s must contain at least one number", flagName)
+	}
+
+	matchedNumbersOnly, err := regexp.MatchString("^[0-9]+$", name)
+	if err == nil && matchedNumbersOnly {
+		// strings is numbers only, which we avoid
+		return microerror.Maskf(invalidFlagError, "--%s must contain at least one digit", flagName)
+	}
+
+	matched, err := regexp.MatchString("^[a-z][a-z0-9]+$", name)
+	if err == nil && !matched {
+		return microerror.Maskf(invalidFlagError, "--%s must only contain alphanumeric characters, and start with a letter", flagName)
+	}
+
+	return nil
+}
+
+func validateControlPlaneSubnet(subnet string) error {
+	if subnet != "" {
+		matchedSubnet, err := regexp.MatchString("^20|21|22|23|24|25$", subnet)
+		if err == nil && !matchedSubnet {
+			return microerror.Maskf(invalidFlagError, "--%s must be a valid subnet size (20, 21, 22, 23, 24 or 25)", flagControlPlaneSubnet)
+		}
+	}
+
+	return nil
+}
+
+func validatePodsCIDR(cidr string) error {
+	if cidr != "" {
+		if !validateCIDR(cidr) {
 			return microerror.Maskf(invalidFlagError, "--%s must be a valid CIDR", flagPodsCIDR)
 		}
 	}
 
-	if f.Owner == "" {
+	return nil
+}
+
+func validateOwner(owner string) error {
+	if owner == "" {
 		return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagOwner)
 	}
 
-	{
-		// Validate Master AZs.
-		switch f.Provider {
-		case key.ProviderAWS:
-			if len(f.ControlPlaneAZ) != 0 && len(f.ControlPlaneAZ) != 1 && len(f.ControlPlaneAZ) != 3 {
-				return microerror.Maskf(invalidFlagError, "--%s must be set to either one or three availability zone names", flagControlPlaneAZ)
-			}
-		case key.ProviderAzure:
-			if len(f.ControlPlaneAZ) > 1 {
-				return microerror.Maskf(invalidFlagError, "--%s supports one availability zone only", flagControlPlaneAZ)
-			}
+	return nil
+}
+
+func validateControlPlaneAZ(provider string, azs []string) error {
+	switch provider {
+	case key.ProviderAWS, key.ProviderOpenStack:
+		if len(azs) != 0 && len(azs) != 1 && len(azs) != 3 {
+			return microerror.Maskf(invalidFlagError, "--%s must be set to either one or three availability zone names", flagControlPlaneAZ)
+		}
+	case key.ProviderAzure:
+		if len(azs) > 1 {
+			return microerror.Maskf(invalidFlagError, "--%s supports one availability zone only", flagControlPlaneAZ)
+		}
+	case key.ProviderKVM, key.ProviderVSphere:
+		if len(azs) > 0 {
+			return microerror.Maskf(invalidFlagError, "--%s cannot be used with provider %s, which has no availability zones", flagControlPlaneAZ, provider)
 		}
 	}
 
-	// Validate release version for non-aws clusters.
-	if f.Provider != key.ProviderAWS && f.Release == "" {
+	return nil
+}
+
+// validateRelease requires the release version for non-aws clusters.
+func validateRelease(provider, release string) error {
+	if provider != key.ProviderAWS && release == "" {
 		return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagRelease)
 	}
 
-	_, err = labels.Parse(f.Label)
+	return nil
+}
+
+func validateKVMWorkerSetting(name string, value int) error {
+	if value < 1 {
+		return microerror.Maskf(invalidFlagError, "--%s must be at least 1", name)
+	}
+
+	return nil
+}
+
+func validateOpenStackSetting(name, value string) error {
+	if value == "" {
+		return microerror.Maskf(invalidFlagError, "--%s must not be empty", name)
+	}
+
+	return nil
+}
+
+func validateOpenStackDNSServers(servers []string) error {
+	for _, s := range servers {
+		if net.ParseIP(s) == nil {
+			return microerror.Maskf(invalidFlagError, "--%s must only contain valid IP addresses", flagOpenStackDNSServers)
+		}
+	}
+
+	return nil
+}
+
+func validateVSphereSetting(name, value string) error {
+	if value == "" {
+		return microerror.Maskf(invalidFlagError, "--%s must not be empty", name)
+	}
+
+	return nil
+}
+
+func validateVSphereControlPlaneEndpoint(endpoint string) error {
+	if net.ParseIP(endpoint) == nil {
+		return microerror.Maskf(invalidFlagError, "--%s must be a valid IP address", flagVSphereControlPlaneEndpoint)
+	}
+
+	return nil
+}
+
+func validateLabels(l []string) error {
+	_, err := labels.Parse(l)
 	if err != nil {
 		return microerror.Maskf(invalidFlagError, "--%s must contain valid label definitions (%s)", flagLabel, err)
 	}
@@ -0,0 +1,363 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/giantswarm/microerror"
+	"github.com/spf13/cobra"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/pkg/commonconfig"
+	"github.com/giantswarm/kubectl-gs/pkg/template/wizard"
+)
+
+// runInteractive asks for the values of the flags which were not given on
+// the command line and sets them as if they were. Every answer is checked
+// with the rules of Validate. The options for the provider, organization,
+// release and availability zones are taken from the management cluster,
+// if it can be reached. Finally, the equivalent command line is printed.
+func (r *runner) runInteractive(ctx context.Context, cmd *cobra.Command) error {
+	if r.flag.FromFile != "" {
+		return microerror.Maskf(invalidFlagError, "--%s and --%s cannot be combined", flagInteractive, flagFromFile)
+	}
+
+	p, err := wizard.New(wizard.Config{
+		In:  r.stdin,
+		Out: r.stderr,
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	flags := cmd.Flags()
+	set := func(name, value string) error {
+		if value == "" {
+			return nil
+		}
+
+		return flags.Set(name, value)
+	}
+
+	if !flags.Changed(flagProvider) {
+		// The provider is detected from the API server URL of the current
+		// context, which only works for Giant Swarm management clusters.
+		var detected string
+		{
+			provider, err := commonconfig.New(r.flag.config).GetProvider()
+			if err == nil && validateProvider(provider) == nil {
+				detected = provider
+			}
+		}
+
+		answer, err := p.Select("Provider", []string{key.ProviderAWS, key.ProviderAzure, key.ProviderKVM, key.ProviderOpenStack, key.ProviderVSphere}, detected, validateProvider)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = set(flagProvider, answer)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	var reader runtimeclient.Reader
+	{
+		c, err := r.getClient()
+		if err != nil {
+			p.Note("The management cluster cannot be reached (%s), please type in the values.", microerror.Pretty(err, false))
+		} else {
+			reader = c
+		}
+	}
+
+	if !flags.Changed(flagOwner) {
+		var organizations []string
+		if reader != nil {
+			organizations, err = wizard.Organizations(ctx, reader)
+			if err != nil {
+				p.Note("The organizations cannot be listed (%s).", microerror.Pretty(err, false))
+			}
+		}
+
+		answer, err := p.Select("Organization", organizations, "", validateOwner)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = set(flagOwner, answer)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	if !flags.Changed(flagRelease) {
+		var releases []string
+		if reader != nil {
+			releases, err = wizard.Releases(ctx, reader)
+			if err != nil {
+				p.Note("The releases cannot be listed (%s).", microerror.Pretty(err, false))
+			}
+		}
+
+		var latest string
+		if len(releases) > 0 {
+			latest = releases[0]
+		}
+
+		answer, err := p.Select("Release", releases, latest, func(release string) error {
+			return validateRelease(r.flag.Provider, release)
+		})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = set(flagRelease, answer)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	if !flags.Changed(flagName) && !flags.Changed(flagClusterIDDeprecated) {
+		answer, err := p.Ask("Name, leave empty to generate one", "", func(name string) error {
+			if name == "" {
+				return nil
+			}
+
+			return validateName(name)
+		})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = set(flagName, answer)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	if !flags.Changed(flagDescription) {
+		answer, err := p.Ask("Description", "", nil)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = set(flagDescription, answer)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	if r.flag.Provider != key.ProviderKVM && r.flag.Provider != key.ProviderVSphere && !flags.Changed(flagControlPlaneAZ) && !flags.Changed(flagMasterAZ) {
+		var region string
+		if r.flag.Provider == key.ProviderAWS && reader != nil {
+			region, err = wizard.Region(ctx, reader)
+			if err != nil {
+				p.Note("The AWS region cannot be detected (%s).", microerror.Pretty(err, false))
+			}
+		}
+
+		answer, err := p.SelectMultiple("Control plane availability zones", wizard.AvailabilityZones(r.flag.Provider, region), nil, func(azs []string) error {
+			return validateControlPlaneAZ(r.flag.Provider, azs)
+		})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = set(flagControlPlaneAZ, strings.Join(answer, ","))
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	if r.flag.Provider == key.ProviderAWS {
+		if !flags.Changed(flagPodsCIDR) {
+			answer, err := p.Ask("Pods CIDR, leave empty for the default", "", validatePodsCIDR)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			err = set(flagPodsCIDR, answer)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+
+		if !flags.Changed(flagControlPlaneSubnet) {
+			answer, err := p.Ask("Control plane subnet size, leave empty for the default", "", validateControlPlaneSubnet)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			err = set(flagControlPlaneSubnet, answer)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+
+		if !flags.Changed(flagExternalSNAT) {
+			answer, err := p.Confirm("Use external SNAT", false)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			if answer {
+				err = set(flagExternalSNAT, "true")
+				if err != nil {
+					return microerror.Mask(err)
+				}
+			}
+		}
+	}
+
+	if r.flag.Provider == key.ProviderKVM {
+		questions := []struct {
+			flag     string
+			question string
+			value    int
+		}{
+			{flag: flagKVMWorkers, question: "Number of worker nodes", value: r.flag.KVMWorkers},
+			{flag: flagKVMWorkerCPUs, question: "CPUs per worker node", value: r.flag.KVMWorkerCPUs},
+			{flag: flagKVMWorkerMemory, question: "Memory per worker node in GB", value: r.flag.KVMWorkerMemory},
+			{flag: flagKVMWorkerStorage, question: "Disk size per worker node in GB", value: r.flag.KVMWorkerStorage},
+		}
+
+		for _, q := range questions {
+			if flags.Changed(q.flag) {
+				continue
+			}
+
+			name := q.flag
+			answer, err := p.AskInt(q.question, q.value, func(n int) error {
+				return validateKVMWorkerSetting(name, n)
+			})
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			err = set(q.flag, strconv.Itoa(answer))
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	}
+
+	if r.flag.Provider == key.ProviderOpenStack {
+		questions := []struct {
+			flag     string
+			question string
+		}{
+			{flag: flagOpenStackCloud, question: "Cloud name in the clouds.yaml"},
+			{flag: flagOpenStackExternalNetworkID, question: "External network ID"},
+			{flag: flagOpenStackFlavor, question: "Control plane flavor"},
+			{flag: flagOpenStackImage, question: "Image"},
+		}
+
+		for _, q := range questions {
+			if flags.Changed(q.flag) {
+				continue
+			}
+
+			name := q.flag
+			answer, err := p.Ask(q.question, "", func(value string) error {
+				return validateOpenStackSetting(name, value)
+			})
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			err = set(q.flag, answer)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+
+		if !flags.Changed(flagOpenStackDNSServers) {
+			answer, err := p.Ask("DNS servers, separated by commas, leave empty for none", "", func(servers string) error {
+				if servers == "" {
+					return nil
+				}
+
+				return validateOpenStackDNSServers(strings.Split(servers, ","))
+			})
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			err = set(flagOpenStackDNSServers, answer)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	}
+
+	if r.flag.Provider == key.ProviderVSphere {
+		questions := []struct {
+			flag     string
+			question string
+		}{
+			{flag: flagVSphereServer, question: "vCenter server"},
+			{flag: flagVSphereDatacenter, question: "vCenter datacenter"},
+			{flag: flagVSphereDatastore, question: "vCenter datastore"},
+			{flag: flagVSphereNetwork, question: "vCenter network"},
+			{flag: flagVSphereResourcePool, question: "vCenter resource pool"},
+			{flag: flagVSphereTemplate, question: "VM template"},
+		}
+
+		for _, q := range questions {
+			if flags.Changed(q.flag) {
+				continue
+			}
+
+			name := q.flag
+			answer, err := p.Ask(q.question, "", func(value string) error {
+				return validateVSphereSetting(name, value)
+			})
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			err = set(q.flag, answer)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+
+		if !flags.Changed(flagVSphereControlPlaneEndpoint) {
+			answer, err := p.Ask("IP address of the Kubernetes API", "", validateVSphereControlPlaneEndpoint)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			err = set(flagVSphereControlPlaneEndpoint, answer)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	}
+
+	if !flags.Changed(flagLabel) {
+		answer, err := p.Ask("Labels as key=value, separated by commas", "", func(l string) error {
+			if l == "" {
+				return nil
+			}
+
+			return validateLabels(strings.Split(l, ","))
+		})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = set(flagLabel, answer)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	fmt.Fprintf(r.stderr, "\nTo template the same CRs again, run:\n\n  %s\n\n", wizard.CommandLine(cmd.CommandPath(), flags, flagInteractive))
+
+	return nil
+}
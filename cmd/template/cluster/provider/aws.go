@@ -59,12 +59,9 @@ func WriteCAPATemplate(out io.Writer, config ClusterCRsConfig) error {
 		return err
 	}
 
-	var sshSSOPublicKey string
-	{
-		sshSSOPublicKey, err = key.SSHSSOPublicKey()
-		if err != nil {
-			return microerror.Mask(err)
-		}
+	sshSSOPublicKey := config.SSHSSOPublicKey
+	if sshSSOPublicKey == "" {
+		return microerror.Maskf(invalidFlagError, "--ssh-sso-public-key-file must be given for release %v", config.ReleaseVersion)
 	}
 
 	data := struct {
@@ -190,7 +187,8 @@ func WriteGSAWSTemplate(out io.Writer, config ClusterCRsConfig) error {
 	var err error
 
 	crsConfig := v1alpha3.ClusterCRsConfig{
-		ClusterID: config.Name,
+		ClusterID:      config.Name,
+		ControlPlaneID: config.ControlPlaneID,
 
 		ExternalSNAT:   config.ExternalSNAT,
 		MasterAZ:       config.ControlPlaneAZ,
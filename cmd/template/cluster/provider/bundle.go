@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/giantswarm/microerror"
+	"sigs.k8s.io/yaml"
+
+	nodepoolprovider "github.com/giantswarm/kubectl-gs/cmd/template/nodepool/provider"
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	templateapp "github.com/giantswarm/kubectl-gs/pkg/template/app"
+	"github.com/giantswarm/kubectl-gs/pkg/template/clusterspec"
+)
+
+const (
+	nodePoolCRFileName = "nodepoolCR"
+)
+
+// WriteTemplate writes the cluster CRs for the given provider.
+func WriteTemplate(out io.Writer, provider string, config ClusterCRsConfig) error {
+	var err error
+
+	switch provider {
+	case key.ProviderAWS:
+		err = WriteAWSTemplate(out, config)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	case key.ProviderAzure:
+		err = WriteAzureTemplate(out, config)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	case key.ProviderKVM:
+		err = WriteKVMTemplate(out, config)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	case key.ProviderOpenStack:
+		err = WriteOpenStackTemplate(out, config)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	case key.ProviderVSphere:
+		err = WriteVSphereTemplate(out, config)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	default:
+		return microerror.Maskf(invalidProviderError, "provider %q is not supported", provider)
+	}
+
+	return nil
+}
+
+// WriteNodePoolTemplate writes the CRs of a node pool belonging to the
+// cluster described by config. The node pool is expected to be defaulted,
+// see clusterspec.Spec.Default, and to be named.
+func WriteNodePoolTemplate(out io.Writer, provider string, config ClusterCRsConfig, nodePool clusterspec.NodePool) error {
+	npConfig := nodepoolprovider.NodePoolCRsConfig{
+		FileName:          nodePoolCRFileName,
+		NodePoolID:        nodePool.Name,
+		AvailabilityZones: nodePool.AvailabilityZones,
+		ClusterName:       config.Name,
+		Description:       nodePool.Description,
+		NodesMax:          *nodePool.NodesMax,
+		NodesMin:          *nodePool.NodesMin,
+		Owner:             config.Owner,
+		ReleaseVersion:    config.ReleaseVersion,
+		SSHSSOPublicKey:   config.SSHSSOPublicKey,
+	}
+
+	switch provider {
+	case key.ProviderAWS:
+		npConfig.AWSInstanceType = nodePool.AWS.InstanceType
+		npConfig.OnDemandBaseCapacity = nodePool.AWS.OnDemandBaseCapacity
+		npConfig.OnDemandPercentageAboveBaseCapacity = *nodePool.AWS.OnDemandPercentageAboveBaseCapacity
+		npConfig.UseAlikeInstanceTypes = nodePool.AWS.UseAlikeInstanceTypes
+		if nodePool.AWS.SubnetSize != 0 {
+			npConfig.MachineDeploymentSubnet = strconv.Itoa(nodePool.AWS.SubnetSize)
+		}
+
+		err := nodepoolprovider.WriteAWSTemplate(out, npConfig)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	case key.ProviderAzure:
+		npConfig.VMSize = nodePool.Azure.VMSize
+		npConfig.AzureUseSpotVms = nodePool.Azure.SpotVMs
+		npConfig.AzureSpotMaxPrice = nodePool.Azure.SpotVMsMaxPrice
+		npConfig.Namespace = config.Namespace
+
+		err := nodepoolprovider.WriteAzureTemplate(out, npConfig)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	case key.ProviderOpenStack:
+		npConfig.OpenStackCloud = config.OpenStackCloud
+		npConfig.OpenStackFlavor = config.OpenStackFlavor
+		npConfig.OpenStackImage = config.OpenStackImage
+		npConfig.Namespace = config.Namespace
+
+		err := nodepoolprovider.WriteOpenStackTemplate(out, npConfig)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	case key.ProviderVSphere:
+		npConfig.VSphereClusterTemplate = config.VSphereClusterTemplate
+		npConfig.VSphereDatacenter = config.VSphereDatacenter
+		npConfig.VSphereDatastore = config.VSphereDatastore
+		npConfig.VSphereNetwork = config.VSphereNetwork
+		npConfig.VSphereResourcePool = config.VSphereResourcePool
+		npConfig.VSphereServer = config.VSphereServer
+		npConfig.VSphereTemplate = config.VSphereTemplate
+		npConfig.Namespace = config.Namespace
+
+		err := nodepoolprovider.WriteVSphereTemplate(out, npConfig)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	case key.ProviderKVM:
+		return microerror.Maskf(invalidProviderError, "KVM clusters have no node pools, their workers are part of the cluster CRs")
+	default:
+		return microerror.Maskf(invalidProviderError, "provider %q is not supported", provider)
+	}
+
+	return nil
+}
+
+// WriteAppTemplate writes the App CR of an app installed into the given
+// cluster, preceded by the config map holding its user values, if any.
+func WriteAppTemplate(out io.Writer, clusterName string, app clusterspec.App) error {
+	appConfig := templateapp.Config{
+		AppName:           app.AppName,
+		Catalog:           app.Catalog,
+		Cluster:           clusterName,
+		DefaultingEnabled: true,
+		Name:              app.Name,
+		Namespace:         app.Namespace,
+		Version:           app.Version,
+	}
+
+	if len(app.Values) > 0 {
+		values, err := yaml.Marshal(app.Values)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		appName := app.AppName
+		if appName == "" {
+			appName = app.Name
+		}
+
+		userConfigMap, err := templateapp.NewConfigMap(templateapp.ConfigMapConfig{
+			Data:      string(values),
+			Name:      key.GenerateAssetName(appName, "userconfig", clusterName),
+			Namespace: clusterName,
+		})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		appConfig.UserConfigConfigMapName = userConfigMap.GetName()
+
+		userConfigMapYaml, err := yaml.Marshal(userConfigMap)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		_, err = fmt.Fprintf(out, "%s---\n", userConfigMapYaml)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	appCRYaml, err := templateapp.NewAppCR(appConfig)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	_, err = out.Write(appCRYaml)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
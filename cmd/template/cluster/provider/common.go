@@ -12,7 +12,46 @@ type ClusterCRsConfig struct {
 	// AWS only.
 	ExternalSNAT       bool
 	ControlPlaneSubnet string
-	PodsCIDR           string
+	// ControlPlaneID is the name of the control plane CRs of releases
+	// before CAPA.
+	ControlPlaneID string
+	PodsCIDR       string
+	// SSHSSOPublicKey is the base64-encoded SSH SSO public key, required
+	// for CAPA releases.
+	SSHSSOPublicKey string
+
+	// KVM only.
+	KVMMasterID  string
+	KVMWorkerIDs []string
+	// KVMWorkerCPUs, KVMWorkerMemory and KVMWorkerStorage size every
+	// worker node, memory and storage are given in GB.
+	KVMWorkerCPUs    int
+	KVMWorkerMemory  int
+	KVMWorkerStorage int
+	// ReleaseComponents holds the versions of the components of the
+	// release, keyed by component name.
+	ReleaseComponents map[string]string
+
+	// vSphere only.
+	// VSphereClusterTemplate is the path of a local CAPV cluster template,
+	// used instead of the built-in one.
+	VSphereClusterTemplate      string
+	VSphereControlPlaneEndpoint string
+	VSphereDatacenter           string
+	VSphereDatastore            string
+	VSphereNetwork              string
+	VSphereResourcePool         string
+	VSphereServer               string
+	VSphereTemplate             string
+
+	// OpenStack only.
+	OpenStackCloud             string
+	OpenStackDNSServers        []string
+	OpenStackExternalNetworkID string
+	// OpenStackFlavor and OpenStackImage are used by the control plane
+	// and the node pools of the cluster spec.
+	OpenStackFlavor string
+	OpenStackImage  string
 
 	// Common.
 	FileName       string
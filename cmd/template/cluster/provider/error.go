@@ -12,3 +12,12 @@ var invalidFlagError = &microerror.Error{
 func IsInvalidFlag(err error) bool {
 	return microerror.Cause(err) == invalidFlagError
 }
+
+var invalidProviderError = &microerror.Error{
+	Kind: "invalidProviderError",
+}
+
+// IsInvalidProvider asserts invalidProviderError.
+func IsInvalidProvider(err error) bool {
+	return microerror.Cause(err) == invalidProviderError
+}
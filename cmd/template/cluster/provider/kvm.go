@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"fmt"
+	"io"
+	"text/template"
+
+	"github.com/giantswarm/apiextensions/v3/pkg/annotation"
+	"github.com/giantswarm/apiextensions/v3/pkg/apis/provider/v1alpha1"
+	"github.com/giantswarm/apiextensions/v3/pkg/label"
+	"github.com/giantswarm/apiextensions/v3/pkg/serialization"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/yaml"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+)
+
+const (
+	defaultKVMMasterCPUs    = 4
+	defaultKVMMasterMemory  = 16
+	defaultKVMMasterStorage = 50
+
+	// defaultKVMDockerVolumeSizeGB is the size of the volume backing the
+	// container images of every node.
+	defaultKVMDockerVolumeSizeGB = 50
+
+	defaultKVMCalicoCIDR         = 16
+	defaultKVMCalicoMTU          = 1430
+	defaultKVMCalicoSubnet       = "192.168.0.0"
+	defaultKVMDockerDaemonCIDR   = "172.17.0.1/16"
+	defaultKVMNetworkSetupImage  = "quay.io/giantswarm/k8s-setup-network-environment:1f4ffc52095ac368847ce3428ea99b257003d9b9"
+	kvmOperatorComponentName     = "kvm-operator"
+	clusterOperatorComponentName = "cluster-operator"
+)
+
+// WriteKVMTemplate writes the Cluster and KVMConfig CRs of a cluster on
+// KVM. The cluster has one master node, and the workers are listed in the
+// KVMConfig since KVM has no node pools. The operator versions are taken
+// from the components of the release.
+func WriteKVMTemplate(out io.Writer, config ClusterCRsConfig) error {
+	var err error
+
+	kvmOperatorVersion := config.ReleaseComponents[kvmOperatorComponentName]
+	if kvmOperatorVersion == "" {
+		return microerror.Maskf(invalidFlagError, "--release %s has no %s component", config.ReleaseVersion, kvmOperatorComponentName)
+	}
+	clusterOperatorVersion := config.ReleaseComponents[clusterOperatorComponentName]
+	if clusterOperatorVersion == "" {
+		return microerror.Maskf(invalidFlagError, "--release %s has no %s component", config.ReleaseVersion, clusterOperatorComponentName)
+	}
+
+	clusterCRYaml, err := yaml.Marshal(newKVMClusterCR(config, clusterOperatorVersion))
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	kvmConfigCRYaml, err := yaml.Marshal(newKVMConfigCR(config, kvmOperatorVersion))
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	data := struct {
+		ClusterCR   string
+		KVMConfigCR string
+	}{
+		ClusterCR:   string(clusterCRYaml),
+		KVMConfigCR: string(kvmConfigCRYaml),
+	}
+
+	t := template.Must(template.New(config.FileName).Parse(key.ClusterKVMCRsTemplate))
+	err = t.Execute(out, data)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func newKVMClusterCR(config ClusterCRsConfig, clusterOperatorVersion string) *capiv1alpha3.Cluster {
+	cluster := newCAPIV1Alpha3ClusterCR(config, &corev1.ObjectReference{
+		APIVersion: v1alpha1.SchemeGroupVersion.String(),
+		Kind:       "KVMConfig",
+		Name:       config.Name,
+		Namespace:  config.Namespace,
+	})
+
+	clusterLabels := map[string]string{}
+	for k, v := range config.Labels {
+		clusterLabels[k] = v
+	}
+	for k, v := range cluster.Labels {
+		clusterLabels[k] = v
+	}
+	clusterLabels[label.ClusterOperatorVersion] = clusterOperatorVersion
+	cluster.SetLabels(clusterLabels)
+
+	return cluster
+}
+
+func newKVMConfigCR(config ClusterCRsConfig, kvmOperatorVersion string) *v1alpha1.KVMConfig {
+	crLabels := map[string]string{}
+	for k, v := range config.Labels {
+		crLabels[k] = v
+	}
+	crLabels[label.Cluster] = config.Name
+	crLabels[label.KVMOperatorVersion] = kvmOperatorVersion
+	crLabels[label.Organization] = config.Owner
+	crLabels[label.ReleaseVersion] = config.ReleaseVersion
+
+	masters := []v1alpha1.KVMConfigSpecKVMNode{
+		newKVMNode(defaultKVMMasterCPUs, defaultKVMMasterMemory, defaultKVMMasterStorage),
+	}
+
+	var workers []v1alpha1.KVMConfigSpecKVMNode
+	var workerIDs []v1alpha1.ClusterNode
+	for _, id := range config.KVMWorkerIDs {
+		workers = append(workers, newKVMNode(config.KVMWorkerCPUs, config.KVMWorkerMemory, config.KVMWorkerStorage))
+		workerIDs = append(workerIDs, v1alpha1.ClusterNode{ID: id})
+	}
+
+	cr := &v1alpha1.KVMConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "KVMConfig",
+			APIVersion: "provider.giantswarm.io/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      config.Name,
+			Namespace: config.Namespace,
+			Labels:    crLabels,
+			Annotations: map[string]string{
+				annotation.ClusterDescription: config.Description,
+			},
+		},
+		Spec: v1alpha1.KVMConfigSpec{
+			Cluster: v1alpha1.Cluster{
+				Calico: v1alpha1.ClusterCalico{
+					CIDR:   defaultKVMCalicoCIDR,
+					MTU:    defaultKVMCalicoMTU,
+					Subnet: defaultKVMCalicoSubnet,
+				},
+				Customer: v1alpha1.ClusterCustomer{
+					ID: config.Owner,
+				},
+				Docker: v1alpha1.ClusterDocker{
+					Daemon: v1alpha1.ClusterDockerDaemon{
+						CIDR: defaultKVMDockerDaemonCIDR,
+					},
+				},
+				ID: config.Name,
+				Kubernetes: v1alpha1.ClusterKubernetes{
+					NetworkSetup: v1alpha1.ClusterKubernetesNetworkSetup{
+						Docker: v1alpha1.ClusterKubernetesNetworkSetupDocker{
+							Image: defaultKVMNetworkSetupImage,
+						},
+					},
+				},
+				Masters: []v1alpha1.ClusterNode{
+					{ID: config.KVMMasterID},
+				},
+				Scaling: v1alpha1.ClusterScaling{
+					Max: len(workers),
+					Min: len(workers),
+				},
+				Workers: workerIDs,
+			},
+			KVM: v1alpha1.KVMConfigSpecKVM{
+				Masters: masters,
+				Workers: workers,
+			},
+			VersionBundle: v1alpha1.KVMConfigSpecVersionBundle{
+				Version: kvmOperatorVersion,
+			},
+		},
+	}
+
+	return cr
+}
+
+func newKVMNode(cpus, memory, storage int) v1alpha1.KVMConfigSpecKVMNode {
+	return v1alpha1.KVMConfigSpecKVMNode{
+		CPUs:               cpus,
+		Disk:               serialization.NewFloat(float64(storage)),
+		Memory:             fmt.Sprintf("%dG", memory),
+		DockerVolumeSizeGB: defaultKVMDockerVolumeSizeGB,
+	}
+}
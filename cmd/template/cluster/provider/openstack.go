@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"fmt"
+	"io"
+	"text/template"
+
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	bootstrap "sigs.k8s.io/cluster-api/bootstrap/kubeadm/api/v1alpha3"
+	kubeadmtypes "sigs.k8s.io/cluster-api/bootstrap/kubeadm/types/v1beta1"
+	kubeadm "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1alpha3"
+	"sigs.k8s.io/yaml"
+
+	capov1alpha3 "github.com/giantswarm/kubectl-gs/internal/capo/api/v1alpha3"
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/internal/label"
+)
+
+const (
+	defaultOpenStackNodeCIDR = "10.6.0.0/24"
+	defaultOpenStackPodsCIDR = "192.168.0.0/16"
+)
+
+// WriteOpenStackTemplate writes the CRs of a cluster on OpenStack, following
+// the CAPO cluster template with the external cloud provider. As with
+// vSphere, only the CRs of the cluster and its control plane are written,
+// the workers are templated as node pools. The machines authenticate with
+// the clouds.yaml in the secret named by key.OpenStackCloudsSecretName.
+func WriteOpenStackTemplate(out io.Writer, config ClusterCRsConfig) error {
+	var err error
+
+	crLabels := map[string]string{
+		label.ReleaseVersion:            config.ReleaseVersion,
+		label.Cluster:                   config.Name,
+		capiv1alpha3.ClusterLabelName:   config.Name,
+		label.Organization:              config.Owner,
+		"cluster.x-k8s.io/watch-filter": "capi",
+	}
+
+	data := struct {
+		ClusterCR                  string
+		KubeadmControlPlaneCR      string
+		OpenStackClusterCR         string
+		OpenStackMachineTemplateCR string
+	}{}
+
+	for _, cr := range []struct {
+		object interface{}
+		yaml   *string
+	}{
+		{object: newOpenStackClusterCR(config, crLabels), yaml: &data.ClusterCR},
+		{object: newOpenStackClusterInfrastructureCR(config, crLabels), yaml: &data.OpenStackClusterCR},
+		{object: newOpenStackKubeadmControlPlaneCR(config, crLabels), yaml: &data.KubeadmControlPlaneCR},
+		{object: newOpenStackControlPlaneMachineTemplateCR(config, crLabels), yaml: &data.OpenStackMachineTemplateCR},
+	} {
+		b, err := yaml.Marshal(cr.object)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		*cr.yaml = string(b)
+	}
+
+	t := template.Must(template.New(config.FileName).Parse(key.ClusterCAPOCRsTemplate))
+	err = t.Execute(out, data)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func newOpenStackClusterCR(config ClusterCRsConfig, crLabels map[string]string) *capiv1alpha3.Cluster {
+	cluster := newCAPIV1Alpha3ClusterCR(config, &corev1.ObjectReference{
+		APIVersion: capov1alpha3.GroupVersion.String(),
+		Kind:       "OpenStackCluster",
+		Name:       config.Name,
+		Namespace:  config.Namespace,
+	})
+
+	clusterLabels := map[string]string{}
+	for k, v := range config.Labels {
+		clusterLabels[k] = v
+	}
+	for k, v := range crLabels {
+		clusterLabels[k] = v
+	}
+	cluster.SetLabels(clusterLabels)
+
+	cluster.Spec.ClusterNetwork = &capiv1alpha3.ClusterNetwork{
+		Pods: &capiv1alpha3.NetworkRanges{
+			CIDRBlocks: []string{defaultOpenStackPodsCIDR},
+		},
+		ServiceDomain: "cluster.local",
+	}
+	cluster.Spec.ControlPlaneRef = &corev1.ObjectReference{
+		APIVersion: kubeadm.GroupVersion.String(),
+		Kind:       "KubeadmControlPlane",
+		Name:       openStackControlPlaneName(config.Name),
+		Namespace:  config.Namespace,
+	}
+
+	return cluster
+}
+
+func newOpenStackClusterInfrastructureCR(config ClusterCRsConfig, crLabels map[string]string) *capov1alpha3.OpenStackCluster {
+	return &capov1alpha3.OpenStackCluster{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "OpenStackCluster",
+			APIVersion: capov1alpha3.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      config.Name,
+			Namespace: config.Namespace,
+			Labels:    crLabels,
+		},
+		Spec: capov1alpha3.OpenStackClusterSpec{
+			CloudName: config.OpenStackCloud,
+			CloudsSecret: &corev1.SecretReference{
+				Name:      key.OpenStackCloudsSecretName(config.Name),
+				Namespace: config.Namespace,
+			},
+			NodeCIDR:                      defaultOpenStackNodeCIDR,
+			DNSNameservers:                config.OpenStackDNSServers,
+			ExternalNetworkID:             config.OpenStackExternalNetworkID,
+			ManagedAPIServerLoadBalancer:  true,
+			ManagedSecurityGroups:         true,
+			ControlPlaneAvailabilityZones: config.ControlPlaneAZ,
+		},
+	}
+}
+
+func newOpenStackKubeadmControlPlaneCR(config ClusterCRsConfig, crLabels map[string]string) *kubeadm.KubeadmControlPlane {
+	// One control plane node is placed in every availability zone.
+	replicas := int32(1)
+	if len(config.ControlPlaneAZ) > 1 {
+		replicas = int32(len(config.ControlPlaneAZ))
+	}
+
+	cloudProviderArgs := map[string]string{
+		"cloud-provider": "external",
+	}
+	nodeRegistration := kubeadmtypes.NodeRegistrationOptions{
+		Name:             "{{ local_hostname }}",
+		KubeletExtraArgs: cloudProviderArgs,
+	}
+
+	return &kubeadm.KubeadmControlPlane{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "KubeadmControlPlane",
+			APIVersion: kubeadm.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      openStackControlPlaneName(config.Name),
+			Namespace: config.Namespace,
+			Labels:    crLabels,
+		},
+		Spec: kubeadm.KubeadmControlPlaneSpec{
+			Replicas: &replicas,
+			Version:  key.OpenStackKubernetesVersion,
+			InfrastructureTemplate: corev1.ObjectReference{
+				APIVersion: capov1alpha3.GroupVersion.String(),
+				Kind:       "OpenStackMachineTemplate",
+				Name:       openStackControlPlaneName(config.Name),
+				Namespace:  config.Namespace,
+			},
+			KubeadmConfigSpec: bootstrap.KubeadmConfigSpec{
+				ClusterConfiguration: &kubeadmtypes.ClusterConfiguration{
+					APIServer: kubeadmtypes.APIServer{
+						ControlPlaneComponent: kubeadmtypes.ControlPlaneComponent{
+							ExtraArgs: cloudProviderArgs,
+						},
+					},
+					ControllerManager: kubeadmtypes.ControlPlaneComponent{
+						ExtraArgs: cloudProviderArgs,
+					},
+				},
+				InitConfiguration: &kubeadmtypes.InitConfiguration{
+					NodeRegistration: nodeRegistration,
+				},
+				JoinConfiguration: &kubeadmtypes.JoinConfiguration{
+					NodeRegistration: nodeRegistration,
+				},
+			},
+		},
+	}
+}
+
+func newOpenStackControlPlaneMachineTemplateCR(config ClusterCRsConfig, crLabels map[string]string) *capov1alpha3.OpenStackMachineTemplate {
+	return &capov1alpha3.OpenStackMachineTemplate{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "OpenStackMachineTemplate",
+			APIVersion: capov1alpha3.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      openStackControlPlaneName(config.Name),
+			Namespace: config.Namespace,
+			Labels:    crLabels,
+		},
+		Spec: capov1alpha3.OpenStackMachineTemplateSpec{
+			Template: capov1alpha3.OpenStackMachineTemplateResource{
+				Spec: capov1alpha3.OpenStackMachineSpec{
+					CloudName: config.OpenStackCloud,
+					CloudsSecret: &corev1.SecretReference{
+						Name:      key.OpenStackCloudsSecretName(config.Name),
+						Namespace: config.Namespace,
+					},
+					Flavor: config.OpenStackFlavor,
+					Image:  config.OpenStackImage,
+				},
+			},
+		},
+	}
+}
+
+func openStackControlPlaneName(clusterName string) string {
+	return fmt.Sprintf("%s-control-plane", clusterName)
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"io"
+	"text/template"
+
+	"github.com/giantswarm/apiextensions/v3/pkg/annotation"
+	"github.com/giantswarm/microerror"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/yaml"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/internal/label"
+	"github.com/giantswarm/kubectl-gs/pkg/template/capv"
+)
+
+// WriteVSphereTemplate writes the CRs of a cluster on vSphere, taken from
+// the CAPV cluster template. Only the CRs of the cluster and its control
+// plane are kept, the workers are templated as node pools. The credentials
+// Secret and the ClusterResourceSets of the upstream template are left out,
+// as the vCenter credentials are managed in the management cluster.
+func WriteVSphereTemplate(out io.Writer, config ClusterCRsConfig) error {
+	var err error
+
+	objects, err := getCAPVClusterTemplate(config)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	data := struct {
+		ClusterCR                string
+		KubeadmControlPlaneCR    string
+		VSphereClusterCR         string
+		VSphereMachineTemplateCR string
+	}{}
+
+	crLabels := map[string]string{
+		label.ReleaseVersion:            config.ReleaseVersion,
+		label.Cluster:                   config.Name,
+		capiv1alpha3.ClusterLabelName:   config.Name,
+		label.Organization:              config.Owner,
+		"cluster.x-k8s.io/watch-filter": "capi",
+	}
+
+	for _, o := range objects {
+		var crYaml *string
+		switch o.GetKind() {
+		case "Cluster":
+			clusterLabels := map[string]string{}
+			for k, v := range config.Labels {
+				clusterLabels[k] = v
+			}
+			for k, v := range crLabels {
+				clusterLabels[k] = v
+			}
+			o.SetLabels(clusterLabels)
+			o.SetAnnotations(map[string]string{annotation.ClusterDescription: config.Description})
+			crYaml = &data.ClusterCR
+		case "VSphereCluster":
+			o.SetLabels(crLabels)
+			crYaml = &data.VSphereClusterCR
+		case "VSphereMachineTemplate":
+			o.SetLabels(crLabels)
+			crYaml = &data.VSphereMachineTemplateCR
+		case "KubeadmControlPlane":
+			o.SetLabels(crLabels)
+			// The upstream template adds a user for the SSH key given in
+			// VSPHERE_SSH_AUTHORIZED_KEY, which is left empty.
+			unstructured.RemoveNestedField(o.Object, "spec", "kubeadmConfigSpec", "users")
+			crYaml = &data.KubeadmControlPlaneCR
+		default:
+			continue
+		}
+
+		b, err := yaml.Marshal(o.Object)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		*crYaml = string(b)
+	}
+
+	t := template.Must(template.New(config.FileName).Parse(key.ClusterCAPVCRsTemplate))
+	err = t.Execute(out, data)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func getCAPVClusterTemplate(config ClusterCRsConfig) ([]unstructured.Unstructured, error) {
+	objects, err := capv.GetClusterTemplate(capv.TemplateConfig{
+		ClusterName:     config.Name,
+		Namespace:       key.OrganizationNamespaceFromName(config.Owner),
+		ClusterTemplate: config.VSphereClusterTemplate,
+
+		ControlPlaneEndpoint: config.VSphereControlPlaneEndpoint,
+		Datacenter:           config.VSphereDatacenter,
+		Datastore:            config.VSphereDatastore,
+		Network:              config.VSphereNetwork,
+		ResourcePool:         config.VSphereResourcePool,
+		Server:               config.VSphereServer,
+		Template:             config.VSphereTemplate,
+	})
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return objects, nil
+}
@@ -2,20 +2,27 @@ package cluster
 
 import (
 	"context"
+	"fmt"
 	"io"
-	"os"
 	"sort"
+	"strconv"
 	"strings"
 
-	"github.com/giantswarm/apiextensions/v3/pkg/id"
+	"github.com/spf13/afero"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
 
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	"github.com/spf13/cobra"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/giantswarm/kubectl-gs/cmd/template/cluster/provider"
+	"github.com/giantswarm/kubectl-gs/pkg/commonconfig"
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
 	"github.com/giantswarm/kubectl-gs/pkg/labels"
+	"github.com/giantswarm/kubectl-gs/pkg/template/apply"
+	"github.com/giantswarm/kubectl-gs/pkg/template/clusterspec"
 
 	"github.com/giantswarm/kubectl-gs/internal/key"
 )
@@ -27,13 +34,42 @@ const (
 type runner struct {
 	flag   *flag
 	logger micrologger.Logger
+	fs     afero.Fs
+	stdin  io.Reader
 	stdout io.Writer
 	stderr io.Writer
+
+	client *client.Client
+
+	// generateID returns the names of the cluster, its control plane and
+	// node pools when they are not given. With --seed, it is replaced by a
+	// generator derived from the seed.
+	generateID func() string
+
+	// spec is the cluster spec read from --from-file, if given.
+	spec *clusterspec.Spec
 }
 
 func (r *runner) Run(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
+	if r.flag.FromFile != "" {
+		spec, err := clusterspec.ReadFile(r.fs, r.flag.FromFile)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.flag.applySpec(spec, cmd.Flags().Changed)
+		r.spec = spec
+	}
+
+	if r.flag.Interactive {
+		err := r.runInteractive(ctx, cmd)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
 	// Sorting is required before validation for uniqueness.
 	sort.Slice(r.flag.ControlPlaneAZ, func(i, j int) bool {
 		return r.flag.ControlPlaneAZ[i] < r.flag.ControlPlaneAZ[j]
@@ -44,6 +80,10 @@ func (r *runner) Run(cmd *cobra.Command, args []string) error {
 		return microerror.Mask(err)
 	}
 
+	if r.flag.Seed != "" {
+		r.generateID = key.SeededIDGenerator(r.flag.Seed)
+	}
+
 	err = r.run(ctx, cmd, args)
 	if err != nil {
 		return microerror.Mask(err)
@@ -55,6 +95,14 @@ func (r *runner) Run(cmd *cobra.Command, args []string) error {
 func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) error {
 	var err error
 
+	var spec *clusterspec.Spec
+	{
+		spec, err = r.effectiveSpec()
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
 	var config provider.ClusterCRsConfig
 	{
 		config = provider.ClusterCRsConfig{
@@ -75,12 +123,23 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 		}
 
 		if config.Name == "" {
-			config.Name = id.Generate()
+			config.Name = r.generateID()
 		}
 
 		// Remove leading 'v' from release flag input.
 		config.ReleaseVersion = strings.TrimLeft(config.ReleaseVersion, "v")
 
+		if r.flag.Provider == key.ProviderAWS {
+			if key.IsCAPAVersion(config.ReleaseVersion) {
+				config.SSHSSOPublicKey, err = key.GetSSHSSOPublicKey(ctx, r.fs, r.flag.SSHSSOPublicKeyFile, r.getClient)
+				if err != nil {
+					return microerror.Mask(err)
+				}
+			} else {
+				config.ControlPlaneID = r.generateID()
+			}
+		}
+
 		config.Labels, err = labels.Parse(r.flag.Label)
 		if err != nil {
 			return microerror.Mask(err)
@@ -89,35 +148,242 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 		if r.flag.Provider == key.ProviderAzure {
 			config.Namespace = key.OrganizationNamespaceFromName(config.Owner)
 		}
-	}
 
-	var output *os.File
-	{
-		if r.flag.Output == "" {
-			output = os.Stdout
-		} else {
-			f, err := os.Create(r.flag.Output)
+		if r.flag.Provider == key.ProviderKVM {
+			config.KVMMasterID = r.generateID()
+			for i := 0; i < spec.KVM.Workers; i++ {
+				config.KVMWorkerIDs = append(config.KVMWorkerIDs, r.generateID())
+			}
+			config.KVMWorkerCPUs = spec.KVM.CPUs
+			config.KVMWorkerMemory = spec.KVM.Memory
+			config.KVMWorkerStorage = spec.KVM.Storage
+
+			config.ReleaseComponents, err = r.releaseComponents(ctx, config.ReleaseVersion)
 			if err != nil {
 				return microerror.Mask(err)
 			}
-			defer f.Close()
+		}
 
-			output = f
+		if r.flag.Provider == key.ProviderOpenStack {
+			config.Namespace = key.OrganizationNamespaceFromName(config.Owner)
+			config.OpenStackCloud = spec.OpenStack.Cloud
+			config.OpenStackDNSServers = spec.OpenStack.DNSServers
+			config.OpenStackExternalNetworkID = spec.OpenStack.ExternalNetworkID
+			config.OpenStackFlavor = spec.OpenStack.Flavor
+			config.OpenStackImage = spec.OpenStack.Image
 		}
+
+		if r.flag.Provider == key.ProviderVSphere {
+			config.Namespace = key.OrganizationNamespaceFromName(config.Owner)
+			config.VSphereClusterTemplate = r.flag.VSphereClusterTemplate
+			config.VSphereControlPlaneEndpoint = spec.VSphere.ControlPlaneEndpoint
+			config.VSphereDatacenter = spec.VSphere.Datacenter
+			config.VSphereDatastore = spec.VSphere.Datastore
+			config.VSphereNetwork = spec.VSphere.Network
+			config.VSphereResourcePool = spec.VSphere.ResourcePool
+			config.VSphereServer = spec.VSphere.Server
+			config.VSphereTemplate = spec.VSphere.Template
+		}
+	}
+
+	output, err := apply.NewOutput(apply.OutputConfig{
+		Apply:       r.flag.Apply,
+		DryRun:      r.flag.DryRun,
+		GetClient:   r.getClient,
+		Wait:        r.flag.Wait,
+		WaitTimeout: r.flag.WaitTimeout,
+		Fs:          r.fs,
+		Dir:         r.flag.OutputDir,
+		Force:       r.flag.Force,
+		File:        r.flag.Output,
+		Stdout:      r.stdout,
+	})
+	if err != nil {
+		return microerror.Mask(err)
 	}
 
-	switch r.flag.Provider {
-	case key.ProviderAWS:
-		err = provider.WriteAWSTemplate(output, config)
+	if r.flag.PrintSpec {
+		specYaml, err := yaml.Marshal(spec)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		_, err = output.Write(specYaml)
 		if err != nil {
 			return microerror.Mask(err)
 		}
-	case key.ProviderAzure:
-		err = provider.WriteAzureTemplate(output, config)
+
+		err = output.Flush(ctx)
 		if err != nil {
 			return microerror.Mask(err)
 		}
+
+		return nil
+	}
+
+	err = provider.WriteTemplate(output, r.flag.Provider, config)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	for _, np := range spec.NodePools {
+		if np.Name == "" {
+			np.Name = r.generateID()
+		}
+
+		_, err = fmt.Fprintln(output, "---")
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = provider.WriteNodePoolTemplate(output, r.flag.Provider, config, np)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	for _, app := range spec.Apps {
+		_, err = fmt.Fprintln(output, "---")
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = provider.WriteAppTemplate(output, config.Name, app)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	if r.flag.Flux.Enabled() {
+		err = r.flag.Flux.WriteKustomization(output, r.fs, r.flag.OutputDir)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	err = output.Flush(ctx)
+	if err != nil {
+		return microerror.Mask(err)
 	}
 
 	return nil
 }
+
+// getClient returns the client for the management cluster, which is created
+// on first use.
+func (r *runner) getClient() (runtimeclient.Client, error) {
+	if r.client == nil {
+		var err error
+		r.client, err = commonconfig.New(r.flag.config).GetClient(r.logger)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	return r.client.K8sClient.CtrlClient(), nil
+}
+
+// releaseComponents returns the component versions of the given release,
+// read from the management cluster.
+func (r *runner) releaseComponents(ctx context.Context, releaseVersion string) (map[string]string, error) {
+	c, err := r.getClient()
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	components, err := key.ReleaseComponents(ctx, c, releaseVersion)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return components, nil
+}
+
+// effectiveSpec returns the cluster spec resulting from the flags, which
+// already carry the values of the spec file, and the node pools and apps
+// of the spec file.
+func (r *runner) effectiveSpec() (*clusterspec.Spec, error) {
+	spec := &clusterspec.Spec{
+		APIVersion:  clusterspec.APIVersion,
+		Kind:        clusterspec.Kind,
+		Provider:    r.flag.Provider,
+		Name:        r.flag.Name,
+		Description: r.flag.Description,
+		Owner:       r.flag.Owner,
+		Release:     strings.TrimLeft(r.flag.Release, "v"),
+	}
+
+	if len(r.flag.Label) > 0 {
+		var err error
+		spec.Labels, err = labels.Parse(r.flag.Label)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	if len(r.flag.ControlPlaneAZ) > 0 || r.flag.ControlPlaneSubnet != "" {
+		spec.ControlPlane = &clusterspec.ControlPlane{
+			AvailabilityZones: r.flag.ControlPlaneAZ,
+		}
+
+		if r.flag.ControlPlaneSubnet != "" {
+			subnetSize, err := strconv.Atoi(r.flag.ControlPlaneSubnet)
+			if err != nil {
+				return nil, microerror.Maskf(invalidFlagError, "--%s must be a valid subnet size", flagControlPlaneSubnet)
+			}
+			spec.ControlPlane.SubnetSize = subnetSize
+		}
+	}
+
+	if r.flag.ExternalSNAT || r.flag.PodsCIDR != "" {
+		spec.Network = &clusterspec.Network{
+			ExternalSNAT: r.flag.ExternalSNAT,
+			PodsCIDR:     r.flag.PodsCIDR,
+		}
+	}
+
+	if r.flag.Provider == key.ProviderKVM {
+		spec.KVM = &clusterspec.KVM{
+			Workers: r.flag.KVMWorkers,
+			CPUs:    r.flag.KVMWorkerCPUs,
+			Memory:  r.flag.KVMWorkerMemory,
+			Storage: r.flag.KVMWorkerStorage,
+		}
+	}
+
+	if r.flag.Provider == key.ProviderOpenStack {
+		spec.OpenStack = &clusterspec.OpenStack{
+			Cloud:             r.flag.OpenStackCloud,
+			ExternalNetworkID: r.flag.OpenStackExternalNetworkID,
+			DNSServers:        r.flag.OpenStackDNSServers,
+			Flavor:            r.flag.OpenStackFlavor,
+			Image:             r.flag.OpenStackImage,
+		}
+	}
+
+	if r.flag.Provider == key.ProviderVSphere {
+		spec.VSphere = &clusterspec.VSphere{
+			Server:               r.flag.VSphereServer,
+			Datacenter:           r.flag.VSphereDatacenter,
+			Datastore:            r.flag.VSphereDatastore,
+			Network:              r.flag.VSphereNetwork,
+			ResourcePool:         r.flag.VSphereResourcePool,
+			Template:             r.flag.VSphereTemplate,
+			ControlPlaneEndpoint: r.flag.VSphereControlPlaneEndpoint,
+		}
+	}
+
+	if r.spec != nil {
+		spec.NodePools = r.spec.NodePools
+		spec.Apps = r.spec.Apps
+	}
+
+	spec.Default()
+
+	err := spec.Validate()
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return spec, nil
+}
@@ -0,0 +1,508 @@
+package cluster
+
+import (
+	"bytes"
+	"context"
+	goflag "flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	releasev1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/release/v1alpha1"
+	securityv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/security/v1alpha1"
+	"github.com/giantswarm/micrologger/microloggertest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/giantswarm/kubectl-gs/cmd/template/cluster/provider"
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
+	"github.com/giantswarm/kubectl-gs/pkg/template/clusterspec"
+	"github.com/giantswarm/kubectl-gs/pkg/template/flux"
+	"github.com/giantswarm/kubectl-gs/test/goldenfile"
+)
+
+var update = goflag.Bool("update", false, "update .golden reference test files")
+
+// TestRunner_Run uses golden files.
+//
+//  go test ./cmd/template/cluster -run TestRunner_Run -update
+//
+func TestRunner_Run(t *testing.T) {
+	testCases := []struct {
+		name               string
+		args               []string
+		input              string
+		outputDir          bool
+		expectedGoldenFile string
+		errorMatcher       func(error) bool
+	}{
+		{
+			name: "case 0: print spec from flags",
+			args: []string{
+				"--provider", "aws",
+				"--name", "a1b2c",
+				"--owner", "acme",
+				"--release", "v16.0.1",
+				"--control-plane-az", "eu-central-1a",
+				"--pods-cidr", "10.2.0.0/16",
+				"--label", "team=storage",
+				"--print-spec",
+			},
+			expectedGoldenFile: "run_print_spec_from_flags.golden",
+		},
+		{
+			name: "case 1: print spec from file, with flags overriding file values",
+			args: []string{
+				"--from-file", "testdata/aws_cluster.yaml",
+				"--release", "16.1.0",
+				"--control-plane-az", "eu-central-1b",
+				"--label", "team=network",
+				"--print-spec",
+			},
+			expectedGoldenFile: "run_print_spec_from_file_with_flags.golden",
+		},
+		{
+			name: "case 2: template cluster, node pools and apps from file",
+			args: []string{
+				"--from-file", "testdata/azure_cluster.yaml",
+			},
+			expectedGoldenFile: "run_template_from_file_azure.golden",
+		},
+		{
+			name: "case 3: spec file not matching the schema",
+			args: []string{
+				"--from-file", "testdata/invalid_cluster.yaml",
+			},
+			errorMatcher: clusterspec.IsInvalidSpec,
+		},
+		{
+			name: "case 4: spec file without owner",
+			args: []string{
+				"--from-file", "testdata/cluster_without_owner.yaml",
+			},
+			errorMatcher: IsInvalidFlag,
+		},
+		{
+			name: "case 5: owner given as flag",
+			args: []string{
+				"--from-file", "testdata/cluster_without_owner.yaml",
+				"--owner", "acme",
+				"--name", "x9y8z",
+				"--print-spec",
+			},
+			expectedGoldenFile: "run_print_spec_with_owner_flag.golden",
+		},
+		{
+			name: "case 6: node pool settings of the other provider",
+			args: []string{
+				"--from-file", "testdata/azure_cluster.yaml",
+				"--provider", "aws",
+			},
+			errorMatcher: clusterspec.IsInvalidSpec,
+		},
+		{
+			name: "case 7: flux kustomization for the output directory",
+			args: []string{
+				"--from-file", "testdata/azure_cluster.yaml",
+				"--gitops", "flux",
+				"--flux-path", "./clusters/x9y8z",
+			},
+			outputDir:          true,
+			expectedGoldenFile: "run_template_gitops_flux.golden",
+		},
+		{
+			name: "case 8: flux without output directory",
+			args: []string{
+				"--from-file", "testdata/azure_cluster.yaml",
+				"--gitops", "flux",
+			},
+			errorMatcher: flux.IsInvalidFlag,
+		},
+		{
+			name: "case 9: interactive, with invalid answers asked again",
+			args: []string{
+				"--interactive",
+				"--provider", "azure",
+				"--print-spec",
+			},
+			input: strings.Join([]string{
+				"3",                // Organization, out of range.
+				"1",                // Organization.
+				"",                 // Release, the latest one.
+				"abcde",            // Name, letters only.
+				"a1b2c",            // Name.
+				"Production",       // Description.
+				"1,2",              // Control plane availability zones, too many.
+				"2",                // Control plane availability zones.
+				"team=storage,bad", // Labels, invalid.
+				"team=storage",     // Labels.
+			}, "\n") + "\n",
+			expectedGoldenFile: "run_interactive.golden",
+		},
+		{
+			name: "case 10: interactive with spec file",
+			args: []string{
+				"--interactive",
+				"--from-file", "testdata/azure_cluster.yaml",
+			},
+			errorMatcher: IsInvalidFlag,
+		},
+		{
+			name: "case 11: aws release before v16 with a seed",
+			args: []string{
+				"--provider", "aws",
+				"--owner", "acme",
+				"--release", "15.2.1",
+				"--control-plane-az", "eu-central-1a",
+				"--seed", "production",
+			},
+			expectedGoldenFile: "run_template_aws_v15.golden",
+		},
+		{
+			name: "case 12: aws release v16 with a seed",
+			args: []string{
+				"--from-file", "testdata/aws_cluster.yaml",
+				"--seed", "production",
+			},
+			expectedGoldenFile: "run_template_aws_v16.golden",
+		},
+		{
+			name: "case 13: azure release v16 with a seed",
+			args: []string{
+				"--provider", "azure",
+				"--owner", "acme",
+				"--release", "16.0.1",
+				"--seed", "production",
+			},
+			expectedGoldenFile: "run_template_azure_v16.golden",
+		},
+		{
+			name: "case 14: azure CAPZ release with a seed",
+			args: []string{
+				"--provider", "azure",
+				"--owner", "acme",
+				"--release", "20.0.0",
+				"--seed", "production",
+			},
+			expectedGoldenFile: "run_template_azure_v20.golden",
+		},
+		{
+			name: "case 15: kvm with a seed",
+			args: []string{
+				"--provider", "kvm",
+				"--owner", "acme",
+				"--release", "14.1.0",
+				"--description", "On-premises cluster",
+				"--kvm-workers", "2",
+				"--kvm-worker-cpus", "8",
+				"--kvm-worker-memory", "32",
+				"--label", "team=storage",
+				"--seed", "production",
+			},
+			expectedGoldenFile: "run_template_kvm.golden",
+		},
+		{
+			name: "case 16: kvm workers from file, with flags overriding file values",
+			args: []string{
+				"--from-file", "testdata/kvm_cluster.yaml",
+				"--kvm-worker-storage", "100",
+				"--print-spec",
+			},
+			expectedGoldenFile: "run_print_spec_kvm.golden",
+		},
+		{
+			name: "case 17: kvm with node pools",
+			args: []string{
+				"--from-file", "testdata/kvm_cluster_with_node_pools.yaml",
+			},
+			errorMatcher: clusterspec.IsInvalidSpec,
+		},
+		{
+			name: "case 18: kvm with availability zones",
+			args: []string{
+				"--provider", "kvm",
+				"--owner", "acme",
+				"--release", "14.1.0",
+				"--control-plane-az", "1",
+			},
+			errorMatcher: IsInvalidFlag,
+		},
+		{
+			name: "case 19: kvm with a name, without worker cpus",
+			args: []string{
+				"--provider", "kvm",
+				"--name", "a1b2c",
+				"--owner", "acme",
+				"--release", "14.1.0",
+				"--kvm-worker-cpus", "0",
+			},
+			errorMatcher: IsInvalidFlag,
+		},
+		{
+			name: "case 20: kvm release without kvm-operator",
+			args: []string{
+				"--provider", "kvm",
+				"--owner", "acme",
+				"--release", "16.1.0",
+			},
+			errorMatcher: provider.IsInvalidFlag,
+		},
+		{
+			name: "case 21: vsphere with a seed",
+			args: []string{
+				"--provider", "vsphere",
+				"--owner", "acme",
+				"--release", "20.0.0",
+				"--description", "On-premises cluster",
+				"--vsphere-server", "vcenter.example.com",
+				"--vsphere-datacenter", "dc0",
+				"--vsphere-datastore", "ds0",
+				"--vsphere-network", "VM Network",
+				"--vsphere-resource-pool", "*/Resources",
+				"--vsphere-template", "ubuntu-2004-kube-v1.19.9",
+				"--vsphere-control-plane-endpoint", "10.0.0.10",
+				"--label", "team=storage",
+				"--seed", "production",
+			},
+			expectedGoldenFile: "run_template_vsphere.golden",
+		},
+		{
+			name: "case 22: vsphere cluster and node pools from file",
+			args: []string{
+				"--from-file", "testdata/vsphere_cluster.yaml",
+				"--vsphere-cluster-template", "testdata/capv_cluster_template.yaml",
+			},
+			expectedGoldenFile: "run_template_from_file_vsphere.golden",
+		},
+		{
+			name: "case 23: vsphere without control plane endpoint",
+			args: []string{
+				"--provider", "vsphere",
+				"--owner", "acme",
+				"--release", "20.0.0",
+				"--vsphere-server", "vcenter.example.com",
+				"--vsphere-datacenter", "dc0",
+				"--vsphere-datastore", "ds0",
+				"--vsphere-network", "VM Network",
+				"--vsphere-resource-pool", "*/Resources",
+				"--vsphere-template", "ubuntu-2004-kube-v1.19.9",
+			},
+			errorMatcher: IsInvalidFlag,
+		},
+		{
+			name: "case 24: vsphere with a name, without vsphere settings",
+			args: []string{
+				"--provider", "vsphere",
+				"--name", "a1b2c",
+				"--owner", "acme",
+				"--release", "20.0.0",
+			},
+			errorMatcher: IsInvalidFlag,
+		},
+		{
+			name: "case 25: openstack with control plane availability zones",
+			args: []string{
+				"--provider", "openstack",
+				"--owner", "acme",
+				"--release", "20.0.0",
+				"--description", "Private cloud cluster",
+				"--control-plane-az", "nova-1,nova-2,nova-3",
+				"--openstack-cloud", "openstack",
+				"--openstack-external-network-id", "4c9f2bb1-1a52-4d6e-9f2e-0a1c9f3b7d21",
+				"--openstack-dns-servers", "10.10.0.2,10.10.0.3",
+				"--openstack-flavor", "m1.large",
+				"--openstack-image", "ubuntu-2004-kube-v1.19.9",
+				"--seed", "production",
+			},
+			expectedGoldenFile: "run_template_openstack.golden",
+		},
+		{
+			name: "case 26: openstack cluster and node pools from file",
+			args: []string{
+				"--from-file", "testdata/openstack_cluster.yaml",
+			},
+			expectedGoldenFile: "run_template_from_file_openstack.golden",
+		},
+		{
+			name: "case 27: openstack with an invalid DNS server",
+			args: []string{
+				"--provider", "openstack",
+				"--owner", "acme",
+				"--release", "20.0.0",
+				"--openstack-cloud", "openstack",
+				"--openstack-external-network-id", "4c9f2bb1-1a52-4d6e-9f2e-0a1c9f3b7d21",
+				"--openstack-dns-servers", "dns.example.com",
+				"--openstack-flavor", "m1.large",
+				"--openstack-image", "ubuntu-2004-kube-v1.19.9",
+			},
+			errorMatcher: IsInvalidFlag,
+		},
+		{
+			name: "case 28: openstack with a name, without openstack settings",
+			args: []string{
+				"--provider", "openstack",
+				"--name", "a1b2c",
+				"--owner", "acme",
+				"--release", "20.0.0",
+			},
+			errorMatcher: IsInvalidFlag,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := new(bytes.Buffer)
+
+			args := tc.args
+			var outputDir string
+			if tc.outputDir {
+				tmpDir, err := ioutil.TempDir("", "kubectl-gs-template-cluster-")
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+				defer os.RemoveAll(tmpDir)
+
+				outputDir = filepath.Join(tmpDir, "x9y8z")
+				args = append(args, "--output-dir", outputDir)
+			}
+
+			f := &flag{}
+			cmd := &cobra.Command{}
+			f.Init(cmd)
+
+			cmd.Use = "cluster"
+
+			err := cmd.ParseFlags(args)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			fakeClient, err := newFakeClient()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			// The questions of the interactive mode are part of the golden
+			// file, followed by the CRs.
+			var generatedIDs int
+			r := &runner{
+				flag:   f,
+				fs:     afero.NewOsFs(),
+				stdin:  strings.NewReader(tc.input),
+				stderr: out,
+				stdout: out,
+				client: fakeClient,
+				generateID: func() string {
+					generatedIDs++
+					return fmt.Sprintf("id%03d", generatedIDs)
+				},
+			}
+
+			err = r.Run(cmd, nil)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if tc.outputDir {
+				// The written files are listed on stdout.
+				listing := strings.ReplaceAll(out.String(), outputDir, "<output-dir>")
+				out.Reset()
+				out.WriteString(listing)
+
+				files, err := ioutil.ReadDir(outputDir)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+
+				for _, file := range files {
+					content, err := ioutil.ReadFile(filepath.Join(outputDir, file.Name()))
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err.Error())
+					}
+
+					fmt.Fprintf(out, "# %s\n%s", file.Name(), content)
+				}
+			}
+
+			var expectedResult []byte
+			{
+				gf := goldenfile.New("testdata", tc.expectedGoldenFile)
+				if *update {
+					expectedResult = out.Bytes()
+					err = gf.Update(expectedResult)
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err.Error())
+					}
+				} else {
+					expectedResult, err = gf.Read()
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err.Error())
+					}
+				}
+			}
+
+			diff := cmp.Diff(string(expectedResult), out.String())
+			if diff != "" {
+				t.Fatalf("value not expected, got:\n %s", diff)
+			}
+		})
+	}
+}
+
+// newFakeClient returns a client for a management cluster with one
+// organization and a few releases.
+func newFakeClient() (*client.Client, error) {
+	c, err := client.NewFakeClient(client.Config{Logger: microloggertest.New()})
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []runtime.Object{
+		&securityv1alpha1.Organization{
+			ObjectMeta: metav1.ObjectMeta{Name: "acme"},
+		},
+		&releasev1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{Name: "v15.2.1"},
+			Spec:       releasev1alpha1.ReleaseSpec{State: releasev1alpha1.StateDeprecated},
+		},
+		&releasev1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{Name: "v16.0.1"},
+			Spec:       releasev1alpha1.ReleaseSpec{State: releasev1alpha1.StateActive},
+		},
+		&releasev1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{Name: "v16.1.0"},
+			Spec:       releasev1alpha1.ReleaseSpec{State: releasev1alpha1.StateActive},
+		},
+		&releasev1alpha1.Release{
+			ObjectMeta: metav1.ObjectMeta{Name: "v14.1.0"},
+			Spec: releasev1alpha1.ReleaseSpec{
+				State: releasev1alpha1.StateDeprecated,
+				Components: []releasev1alpha1.ReleaseSpecComponent{
+					{Name: "cluster-operator", Version: "0.23.22"},
+					{Name: "kvm-operator", Version: "3.17.0"},
+				},
+			},
+		},
+	}
+	for _, o := range objects {
+		err = c.K8sClient.CtrlClient().Create(context.Background(), o)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
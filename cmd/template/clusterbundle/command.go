@@ -0,0 +1,97 @@
+package clusterbundle
+
+import (
+	"io"
+	"os"
+
+	"github.com/giantswarm/apiextensions/v3/pkg/id"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	name        = "cluster-bundle"
+	description = "Template a cluster together with its node pools and apps."
+
+	longDescription = `Template a cluster together with its node pools and apps.
+
+Renders the CRs of a cluster, one or more node pools and the App CRs of the
+apps to install into it, all referencing the same cluster name. Instead of
+calling the template cluster, template nodepool and template app commands one
+after another, passing the generated cluster name along, everything is created
+in one go.
+
+Node pools are created with the defaults of the template nodepool command.
+Apps are given as <catalog>/<app>@<version>, optionally followed by
+:<namespace> to install them into a namespace other than kube-system. User
+values for an app can be given as a YAML file with --app-values, they are
+rendered into a config map referenced by the App CR.
+
+The CRs are written as one multi-document YAML stream, or with --output-dir
+into a directory, with one file per CR and a kustomization.yaml listing them.
+
+To keep the description of a bundle in version control, use a cluster spec file
+with the template cluster command and its --from-file flag instead.`
+
+	examples = `  # Template an Azure cluster with two node pools
+  kubectl gs template cluster-bundle --provider azure --owner acme \
+    --release 16.0.1 --nodepools 2
+
+  # Template an AWS cluster with one node pool and an ingress controller
+  kubectl gs template cluster-bundle --provider aws --owner acme \
+    --control-plane-az eu-central-1a --nodepool-az eu-central-1b \
+    --app giantswarm/nginx-ingress-controller-app@1.17.0 \
+    --app-values nginx-ingress-controller-app=ingress-values.yaml
+
+  # Write the CRs into one file each
+  kubectl gs template cluster-bundle --provider azure --owner acme \
+    --release 16.0.1 --output-dir ./clusters/a1b2c`
+)
+
+type Config struct {
+	Logger     micrologger.Logger
+	FileSystem afero.Fs
+
+	Stderr io.Writer
+	Stdout io.Writer
+}
+
+func New(config Config) (*cobra.Command, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.FileSystem == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.FileSystem must not be empty", config)
+	}
+	if config.Stderr == nil {
+		config.Stderr = os.Stderr
+	}
+	if config.Stdout == nil {
+		config.Stdout = os.Stdout
+	}
+
+	f := &flag{}
+
+	r := &runner{
+		flag:       f,
+		logger:     config.Logger,
+		fs:         config.FileSystem,
+		stderr:     config.Stderr,
+		stdout:     config.Stdout,
+		generateID: id.Generate,
+	}
+
+	c := &cobra.Command{
+		Use:     name,
+		Short:   description,
+		Long:    longDescription,
+		Example: examples,
+		RunE:    r.Run,
+	}
+
+	f.Init(c)
+
+	return c, nil
+}
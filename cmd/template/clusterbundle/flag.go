@@ -0,0 +1,166 @@
+package clusterbundle
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/giantswarm/microerror"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/pkg/labels"
+	"github.com/giantswarm/kubectl-gs/pkg/template/apply"
+	"github.com/giantswarm/kubectl-gs/pkg/template/clusterspec"
+)
+
+const (
+	flagApp                 = "app"
+	flagAppValues           = "app-values"
+	flagControlPlaneAZ      = "control-plane-az"
+	flagDescription         = "description"
+	flagForce               = "force"
+	flagLabel               = "label"
+	flagName                = "name"
+	flagNodePoolAZ          = "nodepool-az"
+	flagNodePools           = "nodepools"
+	flagOutput              = "output"
+	flagOutputDir           = "output-dir"
+	flagOwner               = "owner"
+	flagProvider            = "provider"
+	flagRelease             = "release"
+	flagSeed                = "seed"
+	flagSSHSSOPublicKeyFile = "ssh-sso-public-key-file"
+)
+
+const (
+	defaultAppNamespace = "kube-system"
+)
+
+var appRegexp = regexp.MustCompile(`^([^/@:]+)/([^/@:]+)@([^/@:]+)(?::([^/@:]+))?$`)
+
+type flag struct {
+	App                 []string
+	AppValues           []string
+	ControlPlaneAZ      []string
+	Description         string
+	Force               bool
+	Label               []string
+	Name                string
+	NodePoolAZ          []string
+	NodePools           int
+	Output              string
+	OutputDir           string
+	Owner               string
+	Provider            string
+	Release             string
+	Seed                string
+	SSHSSOPublicKeyFile string
+
+	config genericclioptions.RESTClientGetter
+}
+
+func (f *flag) Init(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&f.App, flagApp, nil, "App to install into the cluster, as <catalog>/<app>@<version>[:<namespace>]. Can be given multiple times.")
+	cmd.Flags().StringSliceVar(&f.AppValues, flagAppValues, nil, "User values for an app, as <app>=<path to YAML file>. Can be given multiple times.")
+	cmd.Flags().StringSliceVar(&f.ControlPlaneAZ, flagControlPlaneAZ, nil, "Availability zone(s) to use by control plane nodes.")
+	cmd.Flags().StringVar(&f.Description, flagDescription, "", "User-friendly description of the cluster's purpose.")
+	cmd.Flags().BoolVar(&f.Force, flagForce, false, "Overwrite existing files when writing into --output-dir.")
+	cmd.Flags().StringSliceVar(&f.Label, flagLabel, nil, "Workload cluster label.")
+	cmd.Flags().StringVar(&f.Name, flagName, "", "Unique identifier of the cluster. Generated if not given.")
+	cmd.Flags().StringSliceVar(&f.NodePoolAZ, flagNodePoolAZ, nil, "Availability zone(s) to use by the node pools.")
+	cmd.Flags().IntVar(&f.NodePools, flagNodePools, 1, "Number of node pools to create.")
+	cmd.Flags().StringVar(&f.Output, flagOutput, "", "File path for storing CRs. (default: stdout)")
+	cmd.Flags().StringVar(&f.OutputDir, flagOutputDir, "", "Directory to write the CRs into, one file per CR named <kind>-<name>.yaml, listed in the kustomization.yaml of the directory.")
+	cmd.Flags().StringVar(&f.Owner, flagOwner, "", "Workload cluster owner organization.")
+	cmd.Flags().StringVar(&f.Provider, flagProvider, "", "Installation infrastructure provider.")
+	cmd.Flags().StringVar(&f.Release, flagRelease, "", "Workload cluster release. If not given, this remains empty for defaulting to the most recent one via the Management API.")
+	cmd.Flags().StringVar(&f.Seed, flagSeed, "", "Derive the names generated for the cluster, its control plane and node pools from the given seed, so the same flags always result in the same CRs.")
+	cmd.Flags().StringVar(&f.SSHSSOPublicKeyFile, flagSSHSSOPublicKeyFile, "", "Path to the SSH SSO public key, required for CAPA releases. If not given, the key is read from the management cluster.")
+
+	f.config = apply.NewConfigFlags()
+	f.config.(*genericclioptions.ConfigFlags).AddFlags(cmd.Flags())
+}
+
+func (f *flag) Validate() error {
+	if f.Provider != key.ProviderAWS && f.Provider != key.ProviderAzure {
+		return microerror.Maskf(invalidFlagError, "--%s must be either aws or azure", flagProvider)
+	}
+
+	if f.Owner == "" {
+		return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagOwner)
+	}
+
+	// Validate release version for non-aws clusters.
+	if f.Provider != key.ProviderAWS && f.Release == "" {
+		return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagRelease)
+	}
+
+	switch f.Provider {
+	case key.ProviderAWS:
+		if len(f.ControlPlaneAZ) != 0 && len(f.ControlPlaneAZ) != 1 && len(f.ControlPlaneAZ) != 3 {
+			return microerror.Maskf(invalidFlagError, "--%s must be set to either one or three availability zone names", flagControlPlaneAZ)
+		}
+		if len(f.NodePoolAZ) < 1 {
+			return microerror.Maskf(invalidFlagError, "--%s must be configured with at least 1 AZ", flagNodePoolAZ)
+		}
+	case key.ProviderAzure:
+		if len(f.ControlPlaneAZ) > 1 {
+			return microerror.Maskf(invalidFlagError, "--%s supports one availability zone only", flagControlPlaneAZ)
+		}
+	}
+
+	if f.NodePools < 1 {
+		return microerror.Maskf(invalidFlagError, "--%s must be at least 1", flagNodePools)
+	}
+
+	_, err := labels.Parse(f.Label)
+	if err != nil {
+		return microerror.Maskf(invalidFlagError, "--%s must contain valid label definitions (%s)", flagLabel, err)
+	}
+
+	apps := map[string]bool{}
+	for _, a := range f.App {
+		app, err := parseApp(a)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		apps[app.Name] = true
+	}
+
+	for _, v := range f.AppValues {
+		parts := strings.SplitN(v, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return microerror.Maskf(invalidFlagError, "--%s must be given as <app>=<path>, got %#q", flagAppValues, v)
+		}
+		if !apps[parts[0]] {
+			return microerror.Maskf(invalidFlagError, "--%s refers to app %#q, which is not given with --%s", flagAppValues, parts[0], flagApp)
+		}
+	}
+
+	if f.Output != "" && f.OutputDir != "" {
+		return microerror.Maskf(invalidFlagError, "--%s and --%s cannot be combined", flagOutput, flagOutputDir)
+	}
+
+	return nil
+}
+
+func parseApp(value string) (clusterspec.App, error) {
+	matches := appRegexp.FindStringSubmatch(value)
+	if matches == nil {
+		return clusterspec.App{}, microerror.Maskf(invalidFlagError, "--%s must be given as <catalog>/<app>@<version>[:<namespace>], got %#q", flagApp, value)
+	}
+
+	app := clusterspec.App{
+		Catalog:   matches[1],
+		Name:      matches[2],
+		Version:   matches[3],
+		Namespace: matches[4],
+	}
+
+	if app.Namespace == "" {
+		app.Namespace = defaultAppNamespace
+	}
+
+	return app, nil
+}
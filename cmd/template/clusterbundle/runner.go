@@ -0,0 +1,247 @@
+package clusterbundle
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/giantswarm/kubectl-gs/cmd/template/cluster/provider"
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/pkg/commonconfig"
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
+	"github.com/giantswarm/kubectl-gs/pkg/labels"
+	"github.com/giantswarm/kubectl-gs/pkg/template/apply"
+	"github.com/giantswarm/kubectl-gs/pkg/template/clusterspec"
+)
+
+const (
+	clusterCRFileName = "clusterCR"
+)
+
+type runner struct {
+	flag   *flag
+	logger micrologger.Logger
+	fs     afero.Fs
+	stdout io.Writer
+	stderr io.Writer
+
+	client *client.Client
+
+	// generateID returns the names of the cluster, its control plane and
+	// the node pools when they are not given. With --seed, it is replaced
+	// by a generator derived from the seed.
+	generateID func() string
+}
+
+func (r *runner) Run(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	sort.Strings(r.flag.ControlPlaneAZ)
+
+	err := r.flag.Validate()
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if r.flag.Seed != "" {
+		r.generateID = key.SeededIDGenerator(r.flag.Seed)
+	}
+
+	err = r.run(ctx, cmd, args)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) error {
+	var err error
+
+	var spec *clusterspec.Spec
+	{
+		spec, err = r.newSpec()
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	var config provider.ClusterCRsConfig
+	{
+		config = provider.ClusterCRsConfig{
+			FileName:       clusterCRFileName,
+			ControlPlaneAZ: r.flag.ControlPlaneAZ,
+			Description:    spec.Description,
+			Name:           spec.Name,
+			Owner:          spec.Owner,
+			ReleaseVersion: spec.Release,
+			Labels:         spec.Labels,
+			Namespace:      metav1.NamespaceDefault,
+		}
+
+		if spec.Provider == key.ProviderAzure {
+			config.Namespace = key.OrganizationNamespaceFromName(config.Owner)
+		}
+
+		if spec.Provider == key.ProviderAWS {
+			if key.IsCAPAVersion(config.ReleaseVersion) {
+				config.SSHSSOPublicKey, err = key.GetSSHSSOPublicKey(ctx, r.fs, r.flag.SSHSSOPublicKeyFile, r.getClient)
+				if err != nil {
+					return microerror.Mask(err)
+				}
+			} else {
+				config.ControlPlaneID = r.generateID()
+			}
+		}
+	}
+
+	output, err := apply.NewOutput(apply.OutputConfig{
+		Fs:     r.fs,
+		Dir:    r.flag.OutputDir,
+		Force:  r.flag.Force,
+		File:   r.flag.Output,
+		Stdout: r.stdout,
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = provider.WriteTemplate(output, spec.Provider, config)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	for _, np := range spec.NodePools {
+		_, err = fmt.Fprintln(output, "---")
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = provider.WriteNodePoolTemplate(output, spec.Provider, config, np)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	for _, app := range spec.Apps {
+		_, err = fmt.Fprintln(output, "---")
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = provider.WriteAppTemplate(output, config.Name, app)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	err = output.Flush(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// newSpec describes the bundle given by the flags as a cluster spec, so it
+// is defaulted and validated the same way as a cluster spec file.
+func (r *runner) newSpec() (*clusterspec.Spec, error) {
+	var err error
+
+	spec := &clusterspec.Spec{
+		APIVersion:  clusterspec.APIVersion,
+		Kind:        clusterspec.Kind,
+		Provider:    r.flag.Provider,
+		Name:        r.flag.Name,
+		Description: r.flag.Description,
+		Owner:       r.flag.Owner,
+		// Remove leading 'v' from release flag input.
+		Release: strings.TrimLeft(r.flag.Release, "v"),
+	}
+
+	if spec.Name == "" {
+		spec.Name = r.generateID()
+	}
+
+	if len(r.flag.Label) > 0 {
+		spec.Labels, err = labels.Parse(r.flag.Label)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	if len(r.flag.ControlPlaneAZ) > 0 {
+		spec.ControlPlane = &clusterspec.ControlPlane{
+			AvailabilityZones: r.flag.ControlPlaneAZ,
+		}
+	}
+
+	for i := 0; i < r.flag.NodePools; i++ {
+		spec.NodePools = append(spec.NodePools, clusterspec.NodePool{
+			Name:              r.generateID(),
+			Description:       fmt.Sprintf("Node pool %d", i+1),
+			AvailabilityZones: r.flag.NodePoolAZ,
+		})
+	}
+
+	values := map[string]map[string]interface{}{}
+	for _, v := range r.flag.AppValues {
+		parts := strings.SplitN(v, "=", 2)
+
+		data, err := afero.ReadFile(r.fs, parts[1])
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		appValues := map[string]interface{}{}
+		err = yaml.Unmarshal(data, &appValues)
+		if err != nil {
+			return nil, microerror.Maskf(invalidFlagError, "--%s must point to a YAML file, %#q is not valid YAML (%s)", flagAppValues, parts[1], err)
+		}
+
+		values[parts[0]] = appValues
+	}
+
+	for _, a := range r.flag.App {
+		app, err := parseApp(a)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+		app.Values = values[app.Name]
+
+		spec.Apps = append(spec.Apps, app)
+	}
+
+	spec.Default()
+
+	err = spec.Validate()
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return spec, nil
+}
+
+// getClient returns the client for the management cluster, which is created
+// on first use.
+func (r *runner) getClient() (runtimeclient.Client, error) {
+	if r.client == nil {
+		var err error
+		r.client, err = commonconfig.New(r.flag.config).GetClient(r.logger)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	return r.client.K8sClient.CtrlClient(), nil
+}
@@ -0,0 +1,213 @@
+package clusterbundle
+
+import (
+	"bytes"
+	goflag "flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/giantswarm/kubectl-gs/pkg/template/clusterspec"
+	"github.com/giantswarm/kubectl-gs/test/goldenfile"
+)
+
+var update = goflag.Bool("update", false, "update .golden reference test files")
+
+// TestRunner_Run uses golden files.
+//
+//  go test ./cmd/template/clusterbundle -run TestRunner_Run -update
+//
+func TestRunner_Run(t *testing.T) {
+	testCases := []struct {
+		name               string
+		args               []string
+		outputDir          bool
+		expectedGoldenFile string
+		errorMatcher       func(error) bool
+	}{
+		{
+			name: "case 0: cluster, node pools and apps as one stream",
+			args: []string{
+				"--provider", "azure",
+				"--owner", "acme",
+				"--release", "v16.0.1",
+				"--description", "Staging cluster",
+				"--nodepools", "2",
+				"--app", "giantswarm/nginx-ingress-controller-app@1.17.0",
+				"--app-values", "nginx-ingress-controller-app=testdata/ingress_values.yaml",
+				"--app", "giantswarm/cert-manager-app@2.7.1:cert-manager",
+			},
+			expectedGoldenFile: "run_template_bundle.golden",
+		},
+		{
+			name: "case 1: one file per CR",
+			args: []string{
+				"--provider", "azure",
+				"--owner", "acme",
+				"--release", "16.0.1",
+				"--app", "giantswarm/cert-manager-app@2.7.1:cert-manager",
+			},
+			outputDir:          true,
+			expectedGoldenFile: "run_template_bundle_output_dir.golden",
+		},
+		{
+			name: "case 2: missing owner",
+			args: []string{
+				"--provider", "azure",
+				"--release", "16.0.1",
+			},
+			errorMatcher: IsInvalidFlag,
+		},
+		{
+			name: "case 3: node pools on aws without availability zones",
+			args: []string{
+				"--provider", "aws",
+				"--owner", "acme",
+			},
+			errorMatcher: IsInvalidFlag,
+		},
+		{
+			name: "case 4: app without version",
+			args: []string{
+				"--provider", "azure",
+				"--owner", "acme",
+				"--release", "16.0.1",
+				"--app", "giantswarm/cert-manager-app",
+			},
+			errorMatcher: IsInvalidFlag,
+		},
+		{
+			name: "case 5: values for an app not in the bundle",
+			args: []string{
+				"--provider", "azure",
+				"--owner", "acme",
+				"--release", "16.0.1",
+				"--app-values", "nginx-ingress-controller-app=testdata/ingress_values.yaml",
+			},
+			errorMatcher: IsInvalidFlag,
+		},
+		{
+			name: "case 6: values file not being YAML",
+			args: []string{
+				"--provider", "azure",
+				"--owner", "acme",
+				"--release", "16.0.1",
+				"--app", "giantswarm/nginx-ingress-controller-app@1.17.0",
+				"--app-values", "nginx-ingress-controller-app=testdata/invalid_values.yaml",
+			},
+			errorMatcher: IsInvalidFlag,
+		},
+		{
+			name: "case 7: invalid cluster name",
+			args: []string{
+				"--provider", "azure",
+				"--owner", "acme",
+				"--release", "16.0.1",
+				"--name", "Staging",
+			},
+			errorMatcher: clusterspec.IsInvalidSpec,
+		},
+		{
+			name: "case 8: same app twice",
+			args: []string{
+				"--provider", "azure",
+				"--owner", "acme",
+				"--release", "16.0.1",
+				"--app", "giantswarm/cert-manager-app@2.7.1",
+				"--app", "giantswarm/cert-manager-app@2.7.2",
+			},
+			errorMatcher: clusterspec.IsInvalidSpec,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := new(bytes.Buffer)
+
+			f := &flag{}
+			cmd := &cobra.Command{}
+			f.Init(cmd)
+
+			err := cmd.ParseFlags(tc.args)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if tc.outputDir {
+				f.OutputDir = t.TempDir()
+			}
+
+			var generatedIDs int
+			r := &runner{
+				flag:   f,
+				fs:     afero.NewOsFs(),
+				stdout: out,
+				generateID: func() string {
+					generatedIDs++
+					return fmt.Sprintf("id%03d", generatedIDs)
+				},
+			}
+
+			err = r.Run(cmd, nil)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if tc.outputDir {
+				// The written files are listed on stdout.
+				listing := strings.ReplaceAll(out.String(), f.OutputDir, "<output-dir>")
+				out.Reset()
+				out.WriteString(listing)
+
+				files, err := ioutil.ReadDir(f.OutputDir)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+
+				for _, file := range files {
+					content, err := ioutil.ReadFile(filepath.Join(f.OutputDir, file.Name()))
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err.Error())
+					}
+
+					fmt.Fprintf(out, "# %s\n%s", file.Name(), content)
+				}
+			}
+
+			var expectedResult []byte
+			{
+				gf := goldenfile.New("testdata", tc.expectedGoldenFile)
+				if *update {
+					expectedResult = out.Bytes()
+					err = gf.Update(expectedResult)
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err.Error())
+					}
+				} else {
+					expectedResult, err = gf.Read()
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err.Error())
+					}
+				}
+			}
+
+			diff := cmp.Diff(string(expectedResult), out.String())
+			if diff != "" {
+				t.Fatalf("value not expected, got:\n %s", diff)
+			}
+		})
+	}
+}
@@ -6,11 +6,13 @@ import (
 
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 
 	"github.com/giantswarm/kubectl-gs/cmd/template/app"
 	"github.com/giantswarm/kubectl-gs/cmd/template/catalog"
 	"github.com/giantswarm/kubectl-gs/cmd/template/cluster"
+	"github.com/giantswarm/kubectl-gs/cmd/template/clusterbundle"
 	"github.com/giantswarm/kubectl-gs/cmd/template/networkpool"
 	"github.com/giantswarm/kubectl-gs/cmd/template/nodepool"
 	"github.com/giantswarm/kubectl-gs/cmd/template/organization"
@@ -22,7 +24,9 @@ const (
 )
 
 type Config struct {
-	Logger micrologger.Logger
+	Logger     micrologger.Logger
+	FileSystem afero.Fs
+
 	Stderr io.Writer
 	Stdout io.Writer
 }
@@ -31,6 +35,9 @@ func New(config Config) (*cobra.Command, error) {
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
+	if config.FileSystem == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.FileSystem must not be empty", config)
+	}
 	if config.Stderr == nil {
 		config.Stderr = os.Stderr
 	}
@@ -43,9 +50,10 @@ func New(config Config) (*cobra.Command, error) {
 	var appCmd *cobra.Command
 	{
 		c := app.Config{
-			Logger: config.Logger,
-			Stderr: config.Stderr,
-			Stdout: config.Stdout,
+			Logger:     config.Logger,
+			FileSystem: config.FileSystem,
+			Stderr:     config.Stderr,
+			Stdout:     config.Stdout,
 		}
 
 		appCmd, err = app.New(c)
@@ -57,9 +65,10 @@ func New(config Config) (*cobra.Command, error) {
 	var appcatalogCmd *cobra.Command
 	{
 		c := catalog.Config{
-			Logger: config.Logger,
-			Stderr: config.Stderr,
-			Stdout: config.Stdout,
+			Logger:     config.Logger,
+			FileSystem: config.FileSystem,
+			Stderr:     config.Stderr,
+			Stdout:     config.Stdout,
 		}
 
 		appcatalogCmd, err = catalog.New(c)
@@ -71,9 +80,10 @@ func New(config Config) (*cobra.Command, error) {
 	var clusterCmd *cobra.Command
 	{
 		c := cluster.Config{
-			Logger: config.Logger,
-			Stderr: config.Stderr,
-			Stdout: config.Stdout,
+			Logger:     config.Logger,
+			FileSystem: config.FileSystem,
+			Stderr:     config.Stderr,
+			Stdout:     config.Stdout,
 		}
 
 		clusterCmd, err = cluster.New(c)
@@ -82,12 +92,28 @@ func New(config Config) (*cobra.Command, error) {
 		}
 	}
 
+	var clusterBundleCmd *cobra.Command
+	{
+		c := clusterbundle.Config{
+			Logger:     config.Logger,
+			FileSystem: config.FileSystem,
+			Stderr:     config.Stderr,
+			Stdout:     config.Stdout,
+		}
+
+		clusterBundleCmd, err = clusterbundle.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var nodepoolCmd *cobra.Command
 	{
 		c := nodepool.Config{
-			Logger: config.Logger,
-			Stderr: config.Stderr,
-			Stdout: config.Stdout,
+			Logger:     config.Logger,
+			FileSystem: config.FileSystem,
+			Stderr:     config.Stderr,
+			Stdout:     config.Stdout,
 		}
 
 		nodepoolCmd, err = nodepool.New(c)
@@ -99,9 +125,10 @@ func New(config Config) (*cobra.Command, error) {
 	var networkpoolCmd *cobra.Command
 	{
 		c := networkpool.Config{
-			Logger: config.Logger,
-			Stderr: config.Stderr,
-			Stdout: config.Stdout,
+			Logger:     config.Logger,
+			FileSystem: config.FileSystem,
+			Stderr:     config.Stderr,
+			Stdout:     config.Stdout,
 		}
 
 		networkpoolCmd, err = networkpool.New(c)
@@ -113,8 +140,10 @@ func New(config Config) (*cobra.Command, error) {
 	var organizationCmd *cobra.Command
 	{
 		c := organization.Config{
-			Stderr: config.Stderr,
-			Stdout: config.Stdout,
+			Logger:     config.Logger,
+			FileSystem: config.FileSystem,
+			Stderr:     config.Stderr,
+			Stdout:     config.Stdout,
 		}
 
 		organizationCmd, err = organization.New(c)
@@ -144,6 +173,7 @@ func New(config Config) (*cobra.Command, error) {
 	c.AddCommand(appCmd)
 	c.AddCommand(appcatalogCmd)
 	c.AddCommand(clusterCmd)
+	c.AddCommand(clusterBundleCmd)
 	c.AddCommand(networkpoolCmd)
 	c.AddCommand(nodepoolCmd)
 	c.AddCommand(organizationCmd)
@@ -10,30 +10,36 @@ import (
 const (
 	// Common.
 	flagCIDRBlock       = "cidr-block"
+	flagForce           = "force"
 	flagNetworkPoolName = "networkpool-name"
 	flagOutput          = "output"
+	flagOutputDir       = "output-dir"
 	flagOwner           = "owner"
+	flagSeed            = "seed"
 )
 
 type flag struct {
 	// Common.
 	CIDRBlock       string
+	Force           bool
 	NetworkPoolName string
 	Output          string
+	OutputDir       string
 	Owner           string
+	Seed            string
 }
 
 func (f *flag) Init(cmd *cobra.Command) {
-	cmd.Flags().StringVar(&f.NetworkPoolName, flagNetworkPoolName, "", "NetworkPool identifier.")
+	cmd.Flags().StringVar(&f.NetworkPoolName, flagNetworkPoolName, "", "NetworkPool identifier, generated if not given.")
 	cmd.Flags().StringVar(&f.CIDRBlock, flagCIDRBlock, "", "Installation infrastructure provider.")
+	cmd.Flags().BoolVar(&f.Force, flagForce, false, "Overwrite existing files when writing into --output-dir.")
 	cmd.Flags().StringVar(&f.Output, flagOutput, "", "File path for storing CRs. (default: stdout)")
+	cmd.Flags().StringVar(&f.OutputDir, flagOutputDir, "", "Directory to write the CRs into, one file per CR named <kind>-<name>.yaml, listed in the kustomization.yaml of the directory.")
 	cmd.Flags().StringVar(&f.Owner, flagOwner, "", "Workload cluster owner organization.")
+	cmd.Flags().StringVar(&f.Seed, flagSeed, "", "Derive the name generated for the network pool from the given seed, so the same flags always result in the same CRs.")
 }
 
 func (f *flag) Validate() error {
-	if f.NetworkPoolName == "" {
-		return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagNetworkPoolName)
-	}
 	if f.CIDRBlock == "" {
 		return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagCIDRBlock)
 	}
@@ -45,6 +51,9 @@ func (f *flag) Validate() error {
 	if f.Owner == "" {
 		return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagOwner)
 	}
+	if f.Output != "" && f.OutputDir != "" {
+		return microerror.Maskf(invalidFlagError, "--%s and --%s cannot be combined", flagOutput, flagOutputDir)
+	}
 
 	return nil
 }
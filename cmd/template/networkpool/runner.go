@@ -3,14 +3,14 @@ package networkpool
 import (
 	"context"
 	"io"
-	"os"
-
-	"github.com/giantswarm/apiextensions/v3/pkg/id"
 
 	"github.com/giantswarm/kubectl-gs/cmd/template/networkpool/provider"
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/pkg/template/apply"
 
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
@@ -21,8 +21,13 @@ const (
 type runner struct {
 	flag   *flag
 	logger micrologger.Logger
+	fs     afero.Fs
 	stdout io.Writer
 	stderr io.Writer
+
+	// generateID returns the name of the network pool. With --seed, it is
+	// replaced by a generator derived from the seed.
+	generateID func() string
 }
 
 func (r *runner) Run(cmd *cobra.Command, args []string) error {
@@ -33,6 +38,10 @@ func (r *runner) Run(cmd *cobra.Command, args []string) error {
 		return microerror.Mask(err)
 	}
 
+	if r.flag.Seed != "" {
+		r.generateID = key.SeededIDGenerator(r.flag.Seed)
+	}
+
 	err = r.run(ctx, cmd, args)
 	if err != nil {
 		return microerror.Mask(err)
@@ -54,23 +63,19 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 		}
 
 		if config.NetworkPoolName == "" {
-			config.NetworkPoolName = id.Generate()
+			config.NetworkPoolName = r.generateID()
 		}
 	}
 
-	var output *os.File
-	{
-		if r.flag.Output == "" {
-			output = os.Stdout
-		} else {
-			f, err := os.Create(r.flag.Output)
-			if err != nil {
-				return microerror.Mask(err)
-			}
-			defer f.Close()
-
-			output = f
-		}
+	output, err := apply.NewOutput(apply.OutputConfig{
+		Fs:     r.fs,
+		Dir:    r.flag.OutputDir,
+		Force:  r.flag.Force,
+		File:   r.flag.Output,
+		Stdout: r.stdout,
+	})
+	if err != nil {
+		return microerror.Mask(err)
 	}
 
 	err = provider.WriteTemplate(output, config)
@@ -78,5 +83,10 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 		return microerror.Mask(err)
 	}
 
+	err = output.Flush(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	return nil
 }
@@ -4,8 +4,10 @@ import (
 	"io"
 	"os"
 
+	"github.com/giantswarm/apiextensions/v3/pkg/id"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
@@ -15,7 +17,10 @@ const (
 )
 
 type Config struct {
-	Logger micrologger.Logger
+	Logger     micrologger.Logger
+	FileSystem afero.Fs
+
+	Stdin  io.Reader
 	Stderr io.Writer
 	Stdout io.Writer
 }
@@ -24,6 +29,12 @@ func New(config Config) (*cobra.Command, error) {
 	if config.Logger == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
 	}
+	if config.FileSystem == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.FileSystem must not be empty", config)
+	}
+	if config.Stdin == nil {
+		config.Stdin = os.Stdin
+	}
 	if config.Stderr == nil {
 		config.Stderr = os.Stderr
 	}
@@ -36,8 +47,12 @@ func New(config Config) (*cobra.Command, error) {
 	r := &runner{
 		flag:   f,
 		logger: config.Logger,
+		fs:     config.FileSystem,
+		stdin:  config.Stdin,
 		stderr: config.Stderr,
 		stdout: config.Stdout,
+
+		generateID: id.Generate,
 	}
 
 	c := &cobra.Command{
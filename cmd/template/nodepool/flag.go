@@ -3,11 +3,15 @@ package nodepool
 import (
 	"fmt"
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/giantswarm/microerror"
 	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/pkg/template/apply"
 )
 
 const (
@@ -26,19 +30,42 @@ const (
 	flagAzureUseSpotVMs      = "azure-spot-vms"
 	flagAzureSpotVMsMaxPrice = "azure-spot-vms-max-price"
 
+	// OpenStack only.
+	flagOpenStackCloud  = "openstack-cloud"
+	flagOpenStackFlavor = "openstack-flavor"
+	flagOpenStackImage  = "openstack-image"
+
+	// vSphere only.
+	flagVSphereClusterTemplate = "vsphere-cluster-template"
+	flagVSphereDatacenter      = "vsphere-datacenter"
+	flagVSphereDatastore       = "vsphere-datastore"
+	flagVSphereNetwork         = "vsphere-network"
+	flagVSphereResourcePool    = "vsphere-resource-pool"
+	flagVSphereServer          = "vsphere-server"
+	flagVSphereTemplate        = "vsphere-template"
+
 	// Common.
+	flagApply                  = "apply"
 	flagAvailabilityZones      = "availability-zones"
 	flagClusterIDDeprecated    = "cluster-id"
 	flagClusterName            = "cluster-name"
 	flagDescription            = "description"
+	flagDryRun                 = "dry-run"
+	flagForce                  = "force"
+	flagInteractive            = "interactive"
 	flagNodepoolNameDeprecated = "nodepool-name"
 	flagNodesMax               = "nodes-max"
 	flagNodesMin               = "nodes-min"
 	flagNodexMax               = "nodex-max"
 	flagNodexMin               = "nodex-min"
 	flagOutput                 = "output"
+	flagOutputDir              = "output-dir"
 	flagOwner                  = "owner"
 	flagRelease                = "release"
+	flagSeed                   = "seed"
+	flagSSHSSOPublicKeyFile    = "ssh-sso-public-key-file"
+	flagWait                   = "wait"
+	flagWaitTimeout            = "wait-timeout"
 )
 
 const (
@@ -62,22 +89,47 @@ type flag struct {
 	AzureUseSpotVms      bool
 	AzureSpotVMsMaxPrice float32
 
+	// OpenStack only.
+	OpenStackCloud  string
+	OpenStackFlavor string
+	OpenStackImage  string
+
+	// vSphere only.
+	VSphereClusterTemplate string
+	VSphereDatacenter      string
+	VSphereDatastore       string
+	VSphereNetwork         string
+	VSphereResourcePool    string
+	VSphereServer          string
+	VSphereTemplate        string
+
 	// Common.
+	Apply                  bool
 	AvailabilityZones      []string
 	ClusterIDDeprecated    string
 	ClusterName            string
 	Description            string
+	DryRun                 string
+	Force                  bool
+	Interactive            bool
 	NodepoolNameDeprecated string
 	NodesMax               int
 	NodesMin               int
 	Output                 string
+	OutputDir              string
 	Owner                  string
 	Release                string
+	Seed                   string
+	SSHSSOPublicKeyFile    string
+	Wait                   bool
+	WaitTimeout            time.Duration
 
 	// Deprecated
 	// Can be removed in a future version around March 2021 or later.
 	NodexMin int
 	NodexMax int
+
+	config genericclioptions.RESTClientGetter
 }
 
 func (f *flag) Init(cmd *cobra.Command) {
@@ -96,17 +148,40 @@ func (f *flag) Init(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&f.AzureUseSpotVms, flagAzureUseSpotVMs, false, "Whether to use Spot VMs for this Node Pool. Defaults to false. Only available on Azure.")
 	cmd.Flags().Float32Var(&f.AzureSpotVMsMaxPrice, flagAzureSpotVMsMaxPrice, 0, "Max hourly price in USD to pay for one spot VM on Azure. If not set, the on-demand price is used as the limit.")
 
+	// OpenStack only.
+	cmd.Flags().StringVar(&f.OpenStackCloud, flagOpenStackCloud, "", "Name of the cloud in the clouds.yaml of the cluster.")
+	cmd.Flags().StringVar(&f.OpenStackFlavor, flagOpenStackFlavor, "", "Flavor of the workers.")
+	cmd.Flags().StringVar(&f.OpenStackImage, flagOpenStackImage, "", "Image the workers boot from.")
+
+	// vSphere only.
+	cmd.Flags().StringVar(&f.VSphereClusterTemplate, flagVSphereClusterTemplate, "", "Path to a CAPV cluster template to use instead of the built-in one of CAPV v0.7.8.")
+	cmd.Flags().StringVar(&f.VSphereDatacenter, flagVSphereDatacenter, "", "vCenter datacenter to create the workers in.")
+	cmd.Flags().StringVar(&f.VSphereDatastore, flagVSphereDatastore, "", "vCenter datastore holding the disks of the workers.")
+	cmd.Flags().StringVar(&f.VSphereNetwork, flagVSphereNetwork, "", "vCenter network the workers are attached to.")
+	cmd.Flags().StringVar(&f.VSphereResourcePool, flagVSphereResourcePool, "", "vCenter resource pool to create the workers in.")
+	cmd.Flags().StringVar(&f.VSphereServer, flagVSphereServer, "", "Address of the vCenter server.")
+	cmd.Flags().StringVar(&f.VSphereTemplate, flagVSphereTemplate, "", "VM template the workers are cloned from.")
+
 	// Common.
+	cmd.Flags().BoolVar(&f.Apply, flagApply, false, "Create the CRs in the management cluster of the current context instead of printing them.")
 	cmd.Flags().StringSliceVar(&f.AvailabilityZones, flagAvailabilityZones, []string{}, "List of availability zones to use, instead of setting a number. Use comma to separate values.")
 	cmd.Flags().StringVar(&f.ClusterIDDeprecated, flagClusterIDDeprecated, "", "Cluster ID (deprecated).")
 	cmd.Flags().StringVar(&f.ClusterName, flagClusterName, "", "Name of the cluster to add the node pool to.")
 	cmd.Flags().StringVar(&f.NodepoolNameDeprecated, flagNodepoolNameDeprecated, "", "Node pool description (deprecated).")
 	cmd.Flags().StringVar(&f.Description, flagDescription, "", "User-friendly description of the node pool's purpose.")
+	cmd.Flags().StringVar(&f.DryRun, flagDryRun, apply.DryRunNone, "With --apply, set to server to only submit the CRs for validation by the API server, without persisting them. One of none, server.")
+	cmd.Flags().BoolVar(&f.Force, flagForce, false, "Overwrite existing files when writing into --output-dir.")
+	cmd.Flags().BoolVar(&f.Interactive, flagInteractive, false, "Ask for the values of the flags not given on the command line, one at a time, and print the equivalent command line.")
 	cmd.Flags().IntVar(&f.NodesMax, flagNodesMax, maxNodes, fmt.Sprintf("Maximum number of worker nodes for the node pool. (default %d)", maxNodes))
 	cmd.Flags().IntVar(&f.NodesMin, flagNodesMin, minNodes, fmt.Sprintf("Minimum number of worker nodes for the node pool. (default %d)", minNodes))
 	cmd.Flags().StringVar(&f.Output, flagOutput, "", "File path for storing CRs. (default: stdout)")
+	cmd.Flags().StringVar(&f.OutputDir, flagOutputDir, "", "Directory to write the CRs into, one file per CR named <kind>-<name>.yaml, listed in the kustomization.yaml of the directory.")
 	cmd.Flags().StringVar(&f.Owner, flagOwner, "", "Workload cluster owner organization.")
 	cmd.Flags().StringVar(&f.Release, flagRelease, "", "Workload cluster release. If not given, this remains empty to match the workload cluster version via the Management API.")
+	cmd.Flags().StringVar(&f.Seed, flagSeed, "", "Derive the name generated for the node pool from the given seed, so the same flags always result in the same CRs.")
+	cmd.Flags().StringVar(&f.SSHSSOPublicKeyFile, flagSSHSSOPublicKeyFile, "", "Path to the SSH SSO public key, required for CAPA releases. If not given, the key is read from the management cluster.")
+	cmd.Flags().BoolVar(&f.Wait, flagWait, false, "With --apply, wait for the created CRs to become ready.")
+	cmd.Flags().DurationVar(&f.WaitTimeout, flagWaitTimeout, 30*time.Minute, "How long to wait for the CRs to become ready with --wait.")
 
 	// This can be removed in a future version around March 2021 or later.
 	cmd.Flags().IntVar(&f.NodexMax, flagNodexMax, 0, "")
@@ -117,32 +192,54 @@ func (f *flag) Init(cmd *cobra.Command) {
 	// To be removed around December 2021
 	_ = cmd.Flags().MarkDeprecated(flagClusterIDDeprecated, "use --cluster-name instead")
 	_ = cmd.Flags().MarkDeprecated(flagNodepoolNameDeprecated, "use --description instead")
+
+	f.config = apply.NewConfigFlags()
+	f.config.(*genericclioptions.ConfigFlags).AddFlags(cmd.Flags())
 }
 
 func (f *flag) Validate() error {
-	if f.Provider != key.ProviderAWS && f.Provider != key.ProviderAzure {
-		return microerror.Maskf(invalidFlagError, "--%s must be either aws or azure", flagProvider)
+	err := validateProvider(f.Provider)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if f.Output != "" && f.OutputDir != "" {
+		return microerror.Maskf(invalidFlagError, "--%s and --%s cannot be combined", flagOutput, flagOutputDir)
+	}
+
+	if f.Apply {
+		if f.Output != "" {
+			return microerror.Maskf(invalidFlagError, "--%s and --%s cannot be combined", flagApply, flagOutput)
+		}
+		if f.OutputDir != "" {
+			return microerror.Maskf(invalidFlagError, "--%s and --%s cannot be combined", flagApply, flagOutputDir)
+		}
+		if !apply.IsDryRunStrategy(f.DryRun) {
+			return microerror.Maskf(invalidFlagError, "--%s must be one of %s", flagDryRun, strings.Join(apply.DryRunStrategies, ", "))
+		}
+		if f.Wait && f.DryRun == apply.DryRunServer {
+			return microerror.Maskf(invalidFlagError, "--%s cannot be combined with --%s=%s", flagWait, flagDryRun, apply.DryRunServer)
+		}
+	} else if f.DryRun == apply.DryRunServer || f.Wait {
+		return microerror.Maskf(invalidFlagError, "--%s and --%s require --%s", flagDryRun, flagWait, flagApply)
 	}
 
 	{
 		// Validate machine type.
 		switch f.Provider {
 		case key.ProviderAWS:
-			if f.AWSInstanceType == "" {
-				return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagAWSInstanceType)
-			}
+			err = validateMachineType(f.Provider, f.AWSInstanceType)
 		case key.ProviderAzure:
-			if f.AzureVMSize == "" {
-				return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagAzureVMSize)
-			}
+			err = validateMachineType(f.Provider, f.AzureVMSize)
+		}
+		if err != nil {
+			return microerror.Mask(err)
 		}
 	}
 
-	if f.MachineDeploymentSubnet != "" {
-		matchedSubnet, err := regexp.MatchString("^20|21|22|23|24|25|26|27|28$", f.MachineDeploymentSubnet)
-		if err == nil && !matchedSubnet {
-			return microerror.Maskf(invalidFlagError, "--%s must be a valid subnet size (20, 21, 22, 23, 24,25, 26, 27 or 28)", flagMachineDeploymentSubnet)
-		}
+	err = validateMachineDeploymentSubnet(f.MachineDeploymentSubnet)
+	if err != nil {
+		return microerror.Mask(err)
 	}
 
 	// To be removed around December 2021
@@ -155,11 +252,13 @@ func (f *flag) Validate() error {
 		f.NodepoolNameDeprecated = ""
 	}
 
-	if f.ClusterName == "" {
-		return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagClusterName)
+	err = validateClusterName(f.ClusterName)
+	if err != nil {
+		return microerror.Mask(err)
 	}
-	if f.Description == "" {
-		return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagDescription)
+	err = validateDescription(f.Description)
+	if err != nil {
+		return microerror.Mask(err)
 	}
 
 	{
@@ -170,38 +269,20 @@ func (f *flag) Validate() error {
 			return microerror.Maskf(invalidFlagError, "please use --nodes-max instead of --nodex-max")
 		}
 
-		// Validate scaling.
-		if f.NodesMax < 0 {
-			return microerror.Maskf(invalidFlagError, "--%s must be >= 0", flagNodesMax)
-		}
-		if f.NodesMin < 0 {
-			return microerror.Maskf(invalidFlagError, "--%s must be >= 0", flagNodesMin)
-		}
-		if f.NodesMin > f.NodesMax {
-			return microerror.Maskf(invalidFlagError, "--%s must be <= --%s", flagNodesMin, flagNodesMax)
+		err = validateScaling(f.NodesMin, f.NodesMax)
+		if err != nil {
+			return microerror.Mask(err)
 		}
 	}
 
-	if f.Owner == "" {
-		return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagOwner)
+	err = validateOwner(f.Owner)
+	if err != nil {
+		return microerror.Mask(err)
 	}
 
-	{
-		// Validate Availability Zones.
-		var azs []string
-		var numOfAZs int
-		{
-			if len(f.AvailabilityZones) > 0 {
-				azs = f.AvailabilityZones
-				numOfAZs = len(azs)
-			}
-		}
-
-		// XXX: The availability zones can be set to nil on Azure.
-		// https://github.com/giantswarm/giantswarm/issues/12860
-		if f.Provider == key.ProviderAWS && numOfAZs < 1 {
-			return microerror.Maskf(invalidFlagError, "--%s must be configured with at least 1 AZ", flagAvailabilityZones)
-		}
+	err = validateAvailabilityZones(f.Provider, f.AvailabilityZones)
+	if err != nil {
+		return microerror.Mask(err)
 	}
 
 	{
@@ -209,19 +290,192 @@ func (f *flag) Validate() error {
 
 		switch f.Provider {
 		case key.ProviderAWS:
-			if f.OnDemandBaseCapacity < 0 {
-				return microerror.Maskf(invalidFlagError, "--%s must be greater than 0", flagOnDemandBaseCapacity)
+			err = validateOnDemandBaseCapacity(f.OnDemandBaseCapacity)
+			if err != nil {
+				return microerror.Mask(err)
 			}
 
-			if f.OnDemandPercentageAboveBaseCapacity < 0 || f.OnDemandPercentageAboveBaseCapacity > 100 {
-				return microerror.Maskf(invalidFlagError, "--%s must be greater than 0 and lower than 100", flagOnDemandPercentageAboveBaseCapacity)
+			err = validateOnDemandPercentageAboveBaseCapacity(f.OnDemandPercentageAboveBaseCapacity)
+			if err != nil {
+				return microerror.Mask(err)
 			}
 		case key.ProviderAzure:
 			if f.OnDemandBaseCapacity != 0 || f.OnDemandPercentageAboveBaseCapacity != 100 || f.UseAlikeInstanceTypes {
 				return microerror.Maskf(invalidFlagError, "--%s, --%s and --%s spot instances flags are not supported on Azure.", flagOnDemandBaseCapacity, flagOnDemandPercentageAboveBaseCapacity, flagUseAlikeInstanceTypes)
 			}
+		case key.ProviderOpenStack:
+			if f.OnDemandBaseCapacity != 0 || f.OnDemandPercentageAboveBaseCapacity != 100 || f.UseAlikeInstanceTypes || f.AzureUseSpotVms {
+				return microerror.Maskf(invalidFlagError, "spot instances flags are not supported on OpenStack.")
+			}
+		case key.ProviderVSphere:
+			if f.OnDemandBaseCapacity != 0 || f.OnDemandPercentageAboveBaseCapacity != 100 || f.UseAlikeInstanceTypes || f.AzureUseSpotVms {
+				return microerror.Maskf(invalidFlagError, "spot instances flags are not supported on vSphere.")
+			}
+		}
+	}
+
+	if f.Provider == key.ProviderOpenStack {
+		for _, setting := range []struct {
+			name  string
+			value string
+		}{
+			{name: flagOpenStackCloud, value: f.OpenStackCloud},
+			{name: flagOpenStackFlavor, value: f.OpenStackFlavor},
+			{name: flagOpenStackImage, value: f.OpenStackImage},
+		} {
+			err = validateOpenStackSetting(setting.name, setting.value)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	}
+
+	if f.Provider == key.ProviderVSphere {
+		for _, setting := range []struct {
+			name  string
+			value string
+		}{
+			{name: flagVSphereServer, value: f.VSphereServer},
+			{name: flagVSphereDatacenter, value: f.VSphereDatacenter},
+			{name: flagVSphereDatastore, value: f.VSphereDatastore},
+			{name: flagVSphereNetwork, value: f.VSphereNetwork},
+			{name: flagVSphereResourcePool, value: f.VSphereResourcePool},
+			{name: flagVSphereTemplate, value: f.VSphereTemplate},
+		} {
+			err = validateVSphereSetting(setting.name, setting.value)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Each validate function below checks the value of a single flag, so that
+// --interactive can reject an answer as soon as it is given.
+
+func validateProvider(provider string) error {
+	if provider == key.ProviderKVM {
+		return microerror.Maskf(invalidFlagError, "--%s cannot be kvm, KVM clusters have no node pools, their workers are set with the --kvm-worker* flags of template cluster", flagProvider)
+	}
+	if provider != key.ProviderAWS && provider != key.ProviderAzure && provider != key.ProviderOpenStack && provider != key.ProviderVSphere {
+		return microerror.Maskf(invalidFlagError, "--%s must be one of aws, azure, openstack, vsphere", flagProvider)
+	}
+
+	return nil
+}
+
+func validateMachineType(provider, machineType string) error {
+	if machineType != "" {
+		return nil
+	}
+
+	switch provider {
+	case key.ProviderAWS:
+		return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagAWSInstanceType)
+	case key.ProviderAzure:
+		return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagAzureVMSize)
+	}
+
+	return nil
+}
+
+func validateMachineDeploymentSubnet(subnet string) error {
+	if subnet != "" {
+		matchedSubnet, err := regexp.MatchString("^20|21|22|23|24|25|26|27|28$", subnet)
+		if err == nil && !matchedSubnet {
+			return microerror.Maskf(invalidFlagError, "--%s must be a valid subnet size (20, 21, 22, 23, 24,25, 26, 27 or 28)", flagMachineDeploymentSubnet)
 		}
 	}
 
 	return nil
 }
+
+func validateClusterName(clusterName string) error {
+	if clusterName == "" {
+		return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagClusterName)
+	}
+
+	return nil
+}
+
+func validateDescription(description string) error {
+	if description == "" {
+		return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagDescription)
+	}
+
+	return nil
+}
+
+func validateScaling(nodesMin, nodesMax int) error {
+	if nodesMax < 0 {
+		return microerror.Maskf(invalidFlagError, "--%s must be >= 0", flagNodesMax)
+	}
+	if nodesMin < 0 {
+		return microerror.Maskf(invalidFlagError, "--%s must be >= 0", flagNodesMin)
+	}
+	if nodesMin > nodesMax {
+		return microerror.Maskf(invalidFlagError, "--%s must be <= --%s", flagNodesMin, flagNodesMax)
+	}
+
+	return nil
+}
+
+func validateOwner(owner string) error {
+	if owner == "" {
+		return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagOwner)
+	}
+
+	return nil
+}
+
+func validateAvailabilityZones(provider string, azs []string) error {
+	// XXX: The availability zones can be set to nil on Azure.
+	// https://github.com/giantswarm/giantswarm/issues/12860
+	if provider == key.ProviderAWS && len(azs) < 1 {
+		return microerror.Maskf(invalidFlagError, "--%s must be configured with at least 1 AZ", flagAvailabilityZones)
+	}
+	// The machines of a MachineDeployment are placed in a single failure
+	// domain.
+	if provider == key.ProviderOpenStack && len(azs) > 1 {
+		return microerror.Maskf(invalidFlagError, "--%s must contain at most 1 AZ on provider openstack", flagAvailabilityZones)
+	}
+	if provider == key.ProviderVSphere && len(azs) > 0 {
+		return microerror.Maskf(invalidFlagError, "--%s cannot be used with provider vsphere, which has no availability zones", flagAvailabilityZones)
+	}
+
+	return nil
+}
+
+func validateOnDemandBaseCapacity(capacity int) error {
+	if capacity < 0 {
+		return microerror.Maskf(invalidFlagError, "--%s must be greater than 0", flagOnDemandBaseCapacity)
+	}
+
+	return nil
+}
+
+func validateOnDemandPercentageAboveBaseCapacity(percentage int) error {
+	if percentage < 0 || percentage > 100 {
+		return microerror.Maskf(invalidFlagError, "--%s must be greater than 0 and lower than 100", flagOnDemandPercentageAboveBaseCapacity)
+	}
+
+	return nil
+}
+
+func validateOpenStackSetting(name, value string) error {
+	if value == "" {
+		return microerror.Maskf(invalidFlagError, "--%s must not be empty", name)
+	}
+
+	return nil
+}
+
+func validateVSphereSetting(name, value string) error {
+	if value == "" {
+		return microerror.Maskf(invalidFlagError, "--%s must not be empty", name)
+	}
+
+	return nil
+}
@@ -0,0 +1,372 @@
+package nodepool
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/giantswarm/microerror"
+	"github.com/spf13/cobra"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/pkg/commonconfig"
+	"github.com/giantswarm/kubectl-gs/pkg/template/wizard"
+)
+
+// runInteractive asks for the values of the flags which were not given on
+// the command line and sets them as if they were, checking every answer
+// with the rules of Validate. The clusters of the chosen organization are
+// offered from the management cluster, and the release and namespace of
+// the chosen cluster are the defaults for the node pool. Finally, the
+// equivalent command line is printed.
+func (r *runner) runInteractive(ctx context.Context, cmd *cobra.Command) error {
+	p, err := wizard.New(wizard.Config{
+		In:  r.stdin,
+		Out: r.stderr,
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	flags := cmd.Flags()
+	set := func(name, value string) error {
+		if value == "" {
+			return nil
+		}
+
+		return flags.Set(name, value)
+	}
+
+	if !flags.Changed(flagProvider) {
+		var detected string
+		{
+			provider, err := commonconfig.New(r.flag.config).GetProvider()
+			if err == nil && validateProvider(provider) == nil {
+				detected = provider
+			}
+		}
+
+		answer, err := p.Select("Provider", []string{key.ProviderAWS, key.ProviderAzure, key.ProviderOpenStack, key.ProviderVSphere}, detected, validateProvider)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = set(flagProvider, answer)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	var reader runtimeclient.Reader
+	{
+		c, err := r.getClient()
+		if err != nil {
+			p.Note("The management cluster cannot be reached (%s), please type in the values.", microerror.Pretty(err, false))
+		} else {
+			reader = c
+		}
+	}
+
+	if !flags.Changed(flagOwner) {
+		var organizations []string
+		if reader != nil {
+			organizations, err = wizard.Organizations(ctx, reader)
+			if err != nil {
+				p.Note("The organizations cannot be listed (%s).", microerror.Pretty(err, false))
+			}
+		}
+
+		answer, err := p.Select("Organization", organizations, "", validateOwner)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = set(flagOwner, answer)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	var cluster wizard.Cluster
+	{
+		var clusters []wizard.Cluster
+		if reader != nil {
+			clusters, err = wizard.Clusters(ctx, reader, r.flag.Owner)
+			if err != nil {
+				p.Note("The clusters cannot be listed (%s).", microerror.Pretty(err, false))
+			}
+		}
+
+		if !flags.Changed(flagClusterName) && !flags.Changed(flagClusterIDDeprecated) {
+			answer, err := p.Select("Cluster", wizard.ClusterNames(clusters), "", validateClusterName)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			err = set(flagClusterName, answer)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+
+		for _, c := range clusters {
+			if c.Name == r.flag.ClusterName {
+				cluster = c
+			}
+		}
+	}
+
+	if !flags.Changed(flagRelease) {
+		var releases []string
+		if reader != nil {
+			releases, err = wizard.Releases(ctx, reader)
+			if err != nil {
+				p.Note("The releases cannot be listed (%s).", microerror.Pretty(err, false))
+			}
+		}
+
+		answer, err := p.Select("Release, the one of the cluster by default", releases, strings.TrimLeft(cluster.Release, "v"), nil)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = set(flagRelease, answer)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	if r.flag.Provider == key.ProviderAWS && !flags.Changed(flagClusterNamespace) {
+		err = set(flagClusterNamespace, cluster.Namespace)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	if !flags.Changed(flagDescription) && !flags.Changed(flagNodepoolNameDeprecated) {
+		answer, err := p.Ask("Description", "", validateDescription)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = set(flagDescription, answer)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	if r.flag.Provider != key.ProviderVSphere && !flags.Changed(flagAvailabilityZones) {
+		var region string
+		if r.flag.Provider == key.ProviderAWS && reader != nil {
+			region, err = wizard.Region(ctx, reader)
+			if err != nil {
+				p.Note("The AWS region cannot be detected (%s).", microerror.Pretty(err, false))
+			}
+		}
+
+		answer, err := p.SelectMultiple("Availability zones", wizard.AvailabilityZones(r.flag.Provider, region), nil, func(azs []string) error {
+			return validateAvailabilityZones(r.flag.Provider, azs)
+		})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = set(flagAvailabilityZones, strings.Join(answer, ","))
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	switch r.flag.Provider {
+	case key.ProviderAWS:
+		if !flags.Changed(flagAWSInstanceType) {
+			answer, err := p.Ask("EC2 instance type", r.flag.AWSInstanceType, func(instanceType string) error {
+				return validateMachineType(r.flag.Provider, instanceType)
+			})
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			err = set(flagAWSInstanceType, answer)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	case key.ProviderAzure:
+		if !flags.Changed(flagAzureVMSize) {
+			answer, err := p.Ask("Azure VM size", r.flag.AzureVMSize, func(vmSize string) error {
+				return validateMachineType(r.flag.Provider, vmSize)
+			})
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			err = set(flagAzureVMSize, answer)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	case key.ProviderOpenStack:
+		questions := []struct {
+			flag     string
+			question string
+		}{
+			{flag: flagOpenStackCloud, question: "Cloud name in the clouds.yaml"},
+			{flag: flagOpenStackFlavor, question: "Flavor"},
+			{flag: flagOpenStackImage, question: "Image"},
+		}
+
+		for _, q := range questions {
+			if flags.Changed(q.flag) {
+				continue
+			}
+
+			name := q.flag
+			answer, err := p.Ask(q.question, "", func(value string) error {
+				return validateOpenStackSetting(name, value)
+			})
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			err = set(q.flag, answer)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	case key.ProviderVSphere:
+		questions := []struct {
+			flag     string
+			question string
+		}{
+			{flag: flagVSphereServer, question: "vCenter server"},
+			{flag: flagVSphereDatacenter, question: "vCenter datacenter"},
+			{flag: flagVSphereDatastore, question: "vCenter datastore"},
+			{flag: flagVSphereNetwork, question: "vCenter network"},
+			{flag: flagVSphereResourcePool, question: "vCenter resource pool"},
+			{flag: flagVSphereTemplate, question: "VM template"},
+		}
+
+		for _, q := range questions {
+			if flags.Changed(q.flag) {
+				continue
+			}
+
+			name := q.flag
+			answer, err := p.Ask(q.question, "", func(value string) error {
+				return validateVSphereSetting(name, value)
+			})
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			err = set(q.flag, answer)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	}
+
+	if !flags.Changed(flagNodesMin) {
+		answer, err := p.AskInt("Minimum number of nodes", r.flag.NodesMin, func(n int) error {
+			return validateScaling(n, n)
+		})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = set(flagNodesMin, strconv.Itoa(answer))
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	if !flags.Changed(flagNodesMax) {
+		defaultValue := r.flag.NodesMax
+		if defaultValue < r.flag.NodesMin {
+			defaultValue = r.flag.NodesMin
+		}
+
+		answer, err := p.AskInt("Maximum number of nodes", defaultValue, func(n int) error {
+			return validateScaling(r.flag.NodesMin, n)
+		})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = set(flagNodesMax, strconv.Itoa(answer))
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	switch r.flag.Provider {
+	case key.ProviderAWS:
+		if !flags.Changed(flagOnDemandBaseCapacity) {
+			answer, err := p.AskInt("Number of on-demand instances before using spot instances", r.flag.OnDemandBaseCapacity, validateOnDemandBaseCapacity)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			err = set(flagOnDemandBaseCapacity, strconv.Itoa(answer))
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+
+		if !flags.Changed(flagOnDemandPercentageAboveBaseCapacity) {
+			answer, err := p.AskInt("Percentage of on-demand instances above that", r.flag.OnDemandPercentageAboveBaseCapacity, validateOnDemandPercentageAboveBaseCapacity)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			err = set(flagOnDemandPercentageAboveBaseCapacity, strconv.Itoa(answer))
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	case key.ProviderAzure:
+		if !flags.Changed(flagAzureUseSpotVMs) {
+			answer, err := p.Confirm("Use spot VMs", false)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			if answer {
+				err = set(flagAzureUseSpotVMs, "true")
+				if err != nil {
+					return microerror.Mask(err)
+				}
+			}
+		}
+
+		if r.flag.AzureUseSpotVms && !flags.Changed(flagAzureSpotVMsMaxPrice) {
+			answer, err := p.Ask("Maximum hourly price in USD per spot VM, leave empty for the on-demand price", "", func(price string) error {
+				if price == "" {
+					return nil
+				}
+
+				_, err := strconv.ParseFloat(price, 32)
+				if err != nil {
+					return microerror.Maskf(invalidFlagError, "--%s must be a number", flagAzureSpotVMsMaxPrice)
+				}
+
+				return nil
+			})
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			err = set(flagAzureSpotVMsMaxPrice, answer)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	}
+
+	fmt.Fprintf(r.stderr, "\nTo template the same CRs again, run:\n\n  %s\n\n", wizard.CommandLine(cmd.CommandPath(), flags, flagInteractive))
+
+	return nil
+}
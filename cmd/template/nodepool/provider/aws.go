@@ -48,12 +48,9 @@ func WriteCAPATemplate(out io.Writer, config NodePoolCRsConfig) error {
 		return microerror.Maskf(invalidFlagError, "--use-alike-instance-types setting is not available for release %v", config.ReleaseVersion)
 	}
 
-	var sshSSOPublicKey string
-	{
-		sshSSOPublicKey, err = key.SSHSSOPublicKey()
-		if err != nil {
-			return microerror.Mask(err)
-		}
+	sshSSOPublicKey := config.SSHSSOPublicKey
+	if sshSSOPublicKey == "" {
+		return microerror.Maskf(invalidFlagError, "--ssh-sso-public-key-file must be given for release %v", config.ReleaseVersion)
 	}
 
 	nodepoolTemplate, err := getCAPANodepoolTemplate(config)
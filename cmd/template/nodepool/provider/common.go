@@ -17,12 +17,31 @@ type NodePoolCRsConfig struct {
 	OnDemandBaseCapacity                int
 	OnDemandPercentageAboveBaseCapacity int
 	UseAlikeInstanceTypes               bool
+	// SSHSSOPublicKey is the base64-encoded SSH SSO public key, required
+	// for CAPA releases.
+	SSHSSOPublicKey string
 
 	// Azure only.
 	VMSize            string
 	AzureUseSpotVms   bool
 	AzureSpotMaxPrice float32
 
+	// vSphere only.
+	// VSphereClusterTemplate is the path of a local CAPV cluster template,
+	// used instead of the built-in one.
+	VSphereClusterTemplate string
+	VSphereDatacenter      string
+	VSphereDatastore       string
+	VSphereNetwork         string
+	VSphereResourcePool    string
+	VSphereServer          string
+	VSphereTemplate        string
+
+	// OpenStack only.
+	OpenStackCloud  string
+	OpenStackFlavor string
+	OpenStackImage  string
+
 	// Common.
 	FileName          string
 	NodePoolID        string
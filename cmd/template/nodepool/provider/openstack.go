@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"io"
+	"strconv"
+	"text/template"
+
+	"github.com/giantswarm/apiextensions/v3/pkg/annotation"
+	"github.com/giantswarm/apiextensions/v3/pkg/label"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	bootstrap "sigs.k8s.io/cluster-api/bootstrap/kubeadm/api/v1alpha3"
+	kubeadmtypes "sigs.k8s.io/cluster-api/bootstrap/kubeadm/types/v1beta1"
+	"sigs.k8s.io/yaml"
+
+	capov1alpha3 "github.com/giantswarm/kubectl-gs/internal/capo/api/v1alpha3"
+	"github.com/giantswarm/kubectl-gs/internal/key"
+)
+
+// WriteOpenStackTemplate writes the CRs of a node pool on OpenStack, a
+// MachineDeployment with its own OpenStackMachineTemplate and
+// KubeadmConfigTemplate. The machines of a MachineDeployment are placed in a
+// single failure domain, so at most one availability zone is used.
+func WriteOpenStackTemplate(out io.Writer, config NodePoolCRsConfig) error {
+	var err error
+
+	crLabels := map[string]string{
+		label.ReleaseVersion:            config.ReleaseVersion,
+		label.Cluster:                   config.ClusterName,
+		label.MachineDeployment:         config.NodePoolID,
+		capiv1alpha3.ClusterLabelName:   config.ClusterName,
+		label.Organization:              config.Owner,
+		"cluster.x-k8s.io/watch-filter": "capi",
+	}
+
+	data := struct {
+		KubeadmConfigTemplateCR    string
+		MachineDeploymentCR        string
+		OpenStackMachineTemplateCR string
+	}{}
+
+	for _, cr := range []struct {
+		object interface{}
+		yaml   *string
+	}{
+		{object: newOpenStackMachineDeploymentCR(config, crLabels), yaml: &data.MachineDeploymentCR},
+		{object: newOpenStackMachineTemplateCR(config, crLabels), yaml: &data.OpenStackMachineTemplateCR},
+		{object: newOpenStackKubeadmConfigTemplateCR(config, crLabels), yaml: &data.KubeadmConfigTemplateCR},
+	} {
+		b, err := yaml.Marshal(cr.object)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		*cr.yaml = string(b)
+	}
+
+	t := template.Must(template.New(config.FileName).Parse(key.MachineDeploymentCAPOCRsTemplate))
+	err = t.Execute(out, data)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func newOpenStackMachineDeploymentCR(config NodePoolCRsConfig, crLabels map[string]string) *capiv1alpha3.MachineDeployment {
+	version := key.OpenStackKubernetesVersion
+
+	var failureDomain *string
+	if len(config.AvailabilityZones) > 0 {
+		failureDomain = &config.AvailabilityZones[0]
+	}
+
+	return &capiv1alpha3.MachineDeployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "MachineDeployment",
+			APIVersion: capiv1alpha3.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      config.NodePoolID,
+			Namespace: config.Namespace,
+			Labels:    crLabels,
+			Annotations: map[string]string{
+				annotation.MachinePoolName: config.Description,
+				annotation.NodePoolMinSize: strconv.Itoa(config.NodesMin),
+				annotation.NodePoolMaxSize: strconv.Itoa(config.NodesMax),
+			},
+		},
+		Spec: capiv1alpha3.MachineDeploymentSpec{
+			ClusterName: config.ClusterName,
+			Replicas:    toInt32Ptr(int32(config.NodesMin)),
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					capiv1alpha3.ClusterLabelName: config.ClusterName,
+					label.MachineDeployment:       config.NodePoolID,
+				},
+			},
+			Template: capiv1alpha3.MachineTemplateSpec{
+				ObjectMeta: capiv1alpha3.ObjectMeta{
+					Labels: map[string]string{
+						capiv1alpha3.ClusterLabelName: config.ClusterName,
+						label.MachineDeployment:       config.NodePoolID,
+					},
+				},
+				Spec: capiv1alpha3.MachineSpec{
+					ClusterName: config.ClusterName,
+					Bootstrap: capiv1alpha3.Bootstrap{
+						ConfigRef: &corev1.ObjectReference{
+							APIVersion: bootstrap.GroupVersion.String(),
+							Kind:       "KubeadmConfigTemplate",
+							Name:       config.NodePoolID,
+						},
+					},
+					InfrastructureRef: corev1.ObjectReference{
+						APIVersion: capov1alpha3.GroupVersion.String(),
+						Kind:       "OpenStackMachineTemplate",
+						Name:       config.NodePoolID,
+					},
+					Version:       &version,
+					FailureDomain: failureDomain,
+				},
+			},
+		},
+	}
+}
+
+func newOpenStackMachineTemplateCR(config NodePoolCRsConfig, crLabels map[string]string) *capov1alpha3.OpenStackMachineTemplate {
+	return &capov1alpha3.OpenStackMachineTemplate{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "OpenStackMachineTemplate",
+			APIVersion: capov1alpha3.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      config.NodePoolID,
+			Namespace: config.Namespace,
+			Labels:    crLabels,
+		},
+		Spec: capov1alpha3.OpenStackMachineTemplateSpec{
+			Template: capov1alpha3.OpenStackMachineTemplateResource{
+				Spec: capov1alpha3.OpenStackMachineSpec{
+					CloudName: config.OpenStackCloud,
+					CloudsSecret: &corev1.SecretReference{
+						Name:      key.OpenStackCloudsSecretName(config.ClusterName),
+						Namespace: config.Namespace,
+					},
+					Flavor: config.OpenStackFlavor,
+					Image:  config.OpenStackImage,
+				},
+			},
+		},
+	}
+}
+
+func newOpenStackKubeadmConfigTemplateCR(config NodePoolCRsConfig, crLabels map[string]string) *bootstrap.KubeadmConfigTemplate {
+	return &bootstrap.KubeadmConfigTemplate{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "KubeadmConfigTemplate",
+			APIVersion: bootstrap.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      config.NodePoolID,
+			Namespace: config.Namespace,
+			Labels:    crLabels,
+		},
+		Spec: bootstrap.KubeadmConfigTemplateSpec{
+			Template: bootstrap.KubeadmConfigTemplateResource{
+				Spec: bootstrap.KubeadmConfigSpec{
+					JoinConfiguration: &kubeadmtypes.JoinConfiguration{
+						NodeRegistration: kubeadmtypes.NodeRegistrationOptions{
+							Name: "{{ local_hostname }}",
+							KubeletExtraArgs: map[string]string{
+								"cloud-provider": "external",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"io"
+	"strconv"
+	"text/template"
+
+	"github.com/giantswarm/apiextensions/v3/pkg/annotation"
+	"github.com/giantswarm/apiextensions/v3/pkg/label"
+	"github.com/giantswarm/microerror"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/yaml"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/pkg/template/capv"
+)
+
+// WriteVSphereTemplate writes the CRs of a node pool on vSphere, taken from
+// the worker CRs of the CAPV cluster template. The upstream template shares
+// the VSphereMachineTemplate of the control plane with the workers, so the
+// node pool gets its own copy of it.
+func WriteVSphereTemplate(out io.Writer, config NodePoolCRsConfig) error {
+	var err error
+
+	objects, err := getCAPVNodepoolTemplate(config)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	data := struct {
+		KubeadmConfigTemplateCR  string
+		MachineDeploymentCR      string
+		VSphereMachineTemplateCR string
+	}{}
+
+	crLabels := map[string]string{
+		label.ReleaseVersion:            config.ReleaseVersion,
+		label.Cluster:                   config.ClusterName,
+		label.MachineDeployment:         config.NodePoolID,
+		capiv1alpha3.ClusterLabelName:   config.ClusterName,
+		label.Organization:              config.Owner,
+		"cluster.x-k8s.io/watch-filter": "capi",
+	}
+
+	for _, o := range objects {
+		var crYaml []byte
+		switch o.GetKind() {
+		case "KubeadmConfigTemplate":
+			o.SetName(config.NodePoolID)
+			o.SetLabels(crLabels)
+			// The upstream template adds a user for the SSH key given in
+			// VSPHERE_SSH_AUTHORIZED_KEY, which is left empty.
+			unstructured.RemoveNestedField(o.Object, "spec", "template", "spec", "users")
+			crYaml, err = yaml.Marshal(o.Object)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+			data.KubeadmConfigTemplateCR = string(crYaml)
+		case "MachineDeployment":
+			o.SetName(config.NodePoolID)
+			o.SetLabels(crLabels)
+			md, err := newMachineDeploymentFromUnstructured(config, o)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+			crYaml, err = yaml.Marshal(md)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+			data.MachineDeploymentCR = string(crYaml)
+		case "VSphereMachineTemplate":
+			o.SetName(config.NodePoolID)
+			o.SetLabels(crLabels)
+			crYaml, err = yaml.Marshal(o.Object)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+			data.VSphereMachineTemplateCR = string(crYaml)
+		}
+	}
+
+	t := template.Must(template.New(config.FileName).Parse(key.MachineDeploymentCAPVCRsTemplate))
+	err = t.Execute(out, data)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func getCAPVNodepoolTemplate(config NodePoolCRsConfig) ([]unstructured.Unstructured, error) {
+	objects, err := capv.GetClusterTemplate(capv.TemplateConfig{
+		ClusterName:     config.ClusterName,
+		Namespace:       key.OrganizationNamespaceFromName(config.Owner),
+		ClusterTemplate: config.VSphereClusterTemplate,
+
+		Datacenter:   config.VSphereDatacenter,
+		Datastore:    config.VSphereDatastore,
+		Network:      config.VSphereNetwork,
+		ResourcePool: config.VSphereResourcePool,
+		Server:       config.VSphereServer,
+		Template:     config.VSphereTemplate,
+
+		WorkerMachineCount: config.NodesMin,
+	})
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return objects, nil
+}
+
+func newMachineDeploymentFromUnstructured(config NodePoolCRsConfig, o unstructured.Unstructured) (*capiv1alpha3.MachineDeployment, error) {
+	var md capiv1alpha3.MachineDeployment
+	{
+		err := runtime.DefaultUnstructuredConverter.
+			FromUnstructured(o.Object, &md)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+		md.SetAnnotations(map[string]string{
+			annotation.MachinePoolName: config.Description,
+			annotation.NodePoolMinSize: strconv.Itoa(config.NodesMin),
+			annotation.NodePoolMaxSize: strconv.Itoa(config.NodesMax)})
+
+		md.Spec.Template.Spec.Bootstrap.ConfigRef.Name = config.NodePoolID
+		md.Spec.Template.Spec.InfrastructureRef.Name = config.NodePoolID
+		if md.Spec.Template.Labels == nil {
+			md.Spec.Template.Labels = map[string]string{}
+		}
+		md.Spec.Template.Labels[label.MachineDeployment] = config.NodePoolID
+	}
+	return &md, nil
+}
@@ -3,17 +3,19 @@ package nodepool
 import (
 	"context"
 	"io"
-	"os"
 	"strings"
 
-	"github.com/giantswarm/apiextensions/v3/pkg/id"
-
 	"github.com/giantswarm/kubectl-gs/cmd/template/nodepool/provider"
 	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/pkg/commonconfig"
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
+	"github.com/giantswarm/kubectl-gs/pkg/template/apply"
 
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -23,18 +25,37 @@ const (
 type runner struct {
 	flag   *flag
 	logger micrologger.Logger
+	fs     afero.Fs
+	stdin  io.Reader
 	stdout io.Writer
 	stderr io.Writer
+
+	client *client.Client
+
+	// generateID returns the name of the node pool. With --seed, it is
+	// replaced by a generator derived from the seed.
+	generateID func() string
 }
 
 func (r *runner) Run(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
+	if r.flag.Interactive {
+		err := r.runInteractive(ctx, cmd)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
 	err := r.flag.Validate()
 	if err != nil {
 		return microerror.Mask(err)
 	}
 
+	if r.flag.Seed != "" {
+		r.generateID = key.SeededIDGenerator(r.flag.Seed)
+	}
+
 	err = r.run(ctx, cmd, args)
 	if err != nil {
 		return microerror.Mask(err)
@@ -67,12 +88,19 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 		}
 
 		if config.NodePoolID == "" {
-			config.NodePoolID = id.Generate()
+			config.NodePoolID = r.generateID()
 		}
 
 		// Remove leading 'v' from release flag input.
 		config.ReleaseVersion = strings.TrimLeft(config.ReleaseVersion, "v")
 
+		if r.flag.Provider == key.ProviderAWS && key.IsCAPAVersion(config.ReleaseVersion) {
+			config.SSHSSOPublicKey, err = key.GetSSHSSOPublicKey(ctx, r.fs, r.flag.SSHSSOPublicKeyFile, r.getClient)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+
 		if len(r.flag.AvailabilityZones) > 0 {
 			config.AvailabilityZones = r.flag.AvailabilityZones
 		}
@@ -83,23 +111,41 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 		if r.flag.Provider == key.ProviderAWS {
 			config.Namespace = r.flag.ClusterNamespace
 		}
-	}
-
-	var output *os.File
-	{
-		if r.flag.Output == "" {
-			output = os.Stdout
-		} else {
-			f, err := os.Create(r.flag.Output)
-			if err != nil {
-				return microerror.Mask(err)
-			}
-			defer f.Close()
+		if r.flag.Provider == key.ProviderOpenStack {
+			config.Namespace = key.OrganizationNamespaceFromName(config.Owner)
+			config.OpenStackCloud = r.flag.OpenStackCloud
+			config.OpenStackFlavor = r.flag.OpenStackFlavor
+			config.OpenStackImage = r.flag.OpenStackImage
+		}
 
-			output = f
+		if r.flag.Provider == key.ProviderVSphere {
+			config.Namespace = key.OrganizationNamespaceFromName(config.Owner)
+			config.VSphereClusterTemplate = r.flag.VSphereClusterTemplate
+			config.VSphereDatacenter = r.flag.VSphereDatacenter
+			config.VSphereDatastore = r.flag.VSphereDatastore
+			config.VSphereNetwork = r.flag.VSphereNetwork
+			config.VSphereResourcePool = r.flag.VSphereResourcePool
+			config.VSphereServer = r.flag.VSphereServer
+			config.VSphereTemplate = r.flag.VSphereTemplate
 		}
 	}
 
+	output, err := apply.NewOutput(apply.OutputConfig{
+		Apply:       r.flag.Apply,
+		DryRun:      r.flag.DryRun,
+		GetClient:   r.getClient,
+		Wait:        r.flag.Wait,
+		WaitTimeout: r.flag.WaitTimeout,
+		Fs:          r.fs,
+		Dir:         r.flag.OutputDir,
+		Force:       r.flag.Force,
+		File:        r.flag.Output,
+		Stdout:      r.stdout,
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	switch r.flag.Provider {
 	case key.ProviderAWS:
 		err = provider.WriteAWSTemplate(output, config)
@@ -111,7 +157,36 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 		if err != nil {
 			return microerror.Mask(err)
 		}
+	case key.ProviderOpenStack:
+		err = provider.WriteOpenStackTemplate(output, config)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	case key.ProviderVSphere:
+		err = provider.WriteVSphereTemplate(output, config)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	err = output.Flush(ctx)
+	if err != nil {
+		return microerror.Mask(err)
 	}
 
 	return nil
 }
+
+// getClient returns the client for the management cluster, which is created
+// on first use.
+func (r *runner) getClient() (runtimeclient.Client, error) {
+	if r.client == nil {
+		var err error
+		r.client, err = commonconfig.New(r.flag.config).GetClient(r.logger)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	return r.client.K8sClient.CtrlClient(), nil
+}
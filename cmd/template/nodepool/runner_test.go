@@ -0,0 +1,233 @@
+package nodepool
+
+import (
+	"bytes"
+	goflag "flag"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/giantswarm/kubectl-gs/test/goldenfile"
+)
+
+var update = goflag.Bool("update", false, "update .golden reference test files")
+
+// TestRunner_Run uses golden files.
+//
+//  go test ./cmd/template/nodepool -run TestRunner_Run -update
+//
+func TestRunner_Run(t *testing.T) {
+	testCases := []struct {
+		name               string
+		args               []string
+		expectedGoldenFile string
+		errorMatcher       func(error) bool
+	}{
+		{
+			name: "case 0: aws release before v16 with a seed",
+			args: []string{
+				"--provider", "aws",
+				"--owner", "acme",
+				"--cluster-name", "a1b2c",
+				"--release", "15.2.1",
+				"--description", "Workers",
+				"--availability-zones", "eu-central-1a",
+				"--seed", "production",
+			},
+			expectedGoldenFile: "run_template_aws_v15.golden",
+		},
+		{
+			name: "case 1: aws release v16 in the organization namespace",
+			args: []string{
+				"--provider", "aws",
+				"--owner", "acme",
+				"--cluster-name", "a1b2c",
+				"--aws-cluster-namespace", "org-acme",
+				"--release", "16.0.1",
+				"--description", "Spot workers",
+				"--availability-zones", "eu-central-1a,eu-central-1b",
+				"--on-demand-percentage-above-base-capacity", "0",
+				"--use-alike-instance-types",
+				"--seed", "production",
+			},
+			expectedGoldenFile: "run_template_aws_v16.golden",
+		},
+		{
+			name: "case 2: azure release v16 with spot VMs",
+			args: []string{
+				"--provider", "azure",
+				"--owner", "acme",
+				"--cluster-name", "a1b2c",
+				"--release", "16.0.1",
+				"--description", "Workers",
+				"--availability-zones", "2",
+				"--azure-spot-vms",
+			},
+			expectedGoldenFile: "run_template_azure_v16.golden",
+		},
+		{
+			name: "case 3: azure CAPZ release with a seed",
+			args: []string{
+				"--provider", "azure",
+				"--owner", "acme",
+				"--cluster-name", "a1b2c",
+				"--release", "20.0.0",
+				"--description", "Workers",
+				"--seed", "production",
+			},
+			expectedGoldenFile: "run_template_azure_v20.golden",
+		},
+		{
+			name: "case 4: output and output directory",
+			args: []string{
+				"--provider", "azure",
+				"--owner", "acme",
+				"--cluster-name", "a1b2c",
+				"--release", "16.0.1",
+				"--output", "nodepool.yaml",
+				"--output-dir", "nodepools",
+			},
+			errorMatcher: IsInvalidFlag,
+		},
+		{
+			name: "case 5: kvm, which has no node pools",
+			args: []string{
+				"--provider", "kvm",
+				"--owner", "acme",
+				"--cluster-name", "a1b2c",
+				"--release", "14.1.0",
+			},
+			errorMatcher: IsInvalidFlag,
+		},
+		{
+			name: "case 6: vsphere",
+			args: []string{
+				"--provider", "vsphere",
+				"--owner", "acme",
+				"--cluster-name", "a1b2c",
+				"--release", "20.0.0",
+				"--description", "Workers",
+				"--nodes-min", "2",
+				"--nodes-max", "5",
+				"--vsphere-server", "vcenter.example.com",
+				"--vsphere-datacenter", "dc0",
+				"--vsphere-datastore", "ds0",
+				"--vsphere-network", "VM Network",
+				"--vsphere-resource-pool", "*/Resources",
+				"--vsphere-template", "ubuntu-2004-kube-v1.19.9",
+			},
+			expectedGoldenFile: "run_template_vsphere.golden",
+		},
+		{
+			name: "case 7: vsphere with availability zones",
+			args: []string{
+				"--provider", "vsphere",
+				"--owner", "acme",
+				"--cluster-name", "a1b2c",
+				"--release", "20.0.0",
+				"--description", "Workers",
+				"--availability-zones", "1",
+				"--vsphere-server", "vcenter.example.com",
+				"--vsphere-datacenter", "dc0",
+				"--vsphere-datastore", "ds0",
+				"--vsphere-network", "VM Network",
+				"--vsphere-resource-pool", "*/Resources",
+				"--vsphere-template", "ubuntu-2004-kube-v1.19.9",
+			},
+			errorMatcher: IsInvalidFlag,
+		},
+		{
+			name: "case 8: openstack",
+			args: []string{
+				"--provider", "openstack",
+				"--owner", "acme",
+				"--cluster-name", "a1b2c",
+				"--release", "20.0.0",
+				"--description", "Workers",
+				"--availability-zones", "nova-1",
+				"--nodes-min", "2",
+				"--nodes-max", "5",
+				"--openstack-cloud", "openstack",
+				"--openstack-flavor", "m1.large",
+				"--openstack-image", "ubuntu-2004-kube-v1.19.9",
+			},
+			expectedGoldenFile: "run_template_openstack.golden",
+		},
+		{
+			name: "case 9: openstack with more than one availability zone",
+			args: []string{
+				"--provider", "openstack",
+				"--owner", "acme",
+				"--cluster-name", "a1b2c",
+				"--release", "20.0.0",
+				"--description", "Workers",
+				"--availability-zones", "nova-1,nova-2",
+				"--openstack-cloud", "openstack",
+				"--openstack-flavor", "m1.large",
+				"--openstack-image", "ubuntu-2004-kube-v1.19.9",
+			},
+			errorMatcher: IsInvalidFlag,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := new(bytes.Buffer)
+
+			f := &flag{}
+			cmd := &cobra.Command{}
+			f.Init(cmd)
+
+			err := cmd.ParseFlags(tc.args)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			r := &runner{
+				flag:   f,
+				fs:     afero.NewOsFs(),
+				stderr: out,
+				stdout: out,
+				generateID: func() string {
+					return "np001"
+				},
+			}
+
+			err = r.Run(cmd, nil)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			var expectedResult []byte
+			{
+				gf := goldenfile.New("testdata", tc.expectedGoldenFile)
+				if *update {
+					expectedResult = out.Bytes()
+					err = gf.Update(expectedResult)
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err.Error())
+					}
+				} else {
+					expectedResult, err = gf.Read()
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err.Error())
+					}
+				}
+			}
+
+			diff := cmp.Diff(string(expectedResult), out.String())
+			if diff != "" {
+				t.Fatalf("value not expected, got:\n %s", diff)
+			}
+		})
+	}
+}
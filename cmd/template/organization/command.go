@@ -4,6 +4,9 @@ import (
 	"io"
 	"os"
 
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
@@ -13,11 +16,20 @@ const (
 )
 
 type Config struct {
+	Logger     micrologger.Logger
+	FileSystem afero.Fs
+
 	Stderr io.Writer
 	Stdout io.Writer
 }
 
 func New(config Config) (*cobra.Command, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.FileSystem == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.FileSystem must not be empty", config)
+	}
 	if config.Stderr == nil {
 		config.Stderr = os.Stderr
 	}
@@ -29,6 +41,8 @@ func New(config Config) (*cobra.Command, error) {
 
 	r := &runner{
 		flag:   f,
+		logger: config.Logger,
+		fs:     config.FileSystem,
 		stderr: config.Stderr,
 		stdout: config.Stdout,
 	}
@@ -1,20 +1,49 @@
 package organization
 
 import (
+	"strings"
+	"time"
+
 	"github.com/giantswarm/microerror"
 	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/giantswarm/kubectl-gs/pkg/template/apply"
 )
 
 const (
-	flagName = "name"
+	flagApply       = "apply"
+	flagDryRun      = "dry-run"
+	flagForce       = "force"
+	flagName        = "name"
+	flagOutputDir   = "output-dir"
+	flagWait        = "wait"
+	flagWaitTimeout = "wait-timeout"
 )
 
 type flag struct {
-	Name string
+	Apply       bool
+	DryRun      string
+	Force       bool
+	Name        string
+	OutputDir   string
+	Wait        bool
+	WaitTimeout time.Duration
+
+	config genericclioptions.RESTClientGetter
 }
 
 func (f *flag) Init(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&f.Apply, flagApply, false, "Create the CRs in the management cluster of the current context instead of printing them.")
+	cmd.Flags().StringVar(&f.DryRun, flagDryRun, apply.DryRunNone, "With --apply, set to server to only submit the CRs for validation by the API server, without persisting them. One of none, server.")
+	cmd.Flags().BoolVar(&f.Force, flagForce, false, "Overwrite existing files when writing into --output-dir.")
 	cmd.Flags().StringVar(&f.Name, flagName, "", "Organization name.")
+	cmd.Flags().StringVar(&f.OutputDir, flagOutputDir, "", "Directory to write the CRs into, one file per CR named <kind>-<name>.yaml, listed in the kustomization.yaml of the directory.")
+	cmd.Flags().BoolVar(&f.Wait, flagWait, false, "With --apply, wait for the created CRs to become ready.")
+	cmd.Flags().DurationVar(&f.WaitTimeout, flagWaitTimeout, 30*time.Minute, "How long to wait for the CRs to become ready with --wait.")
+
+	f.config = apply.NewConfigFlags()
+	f.config.(*genericclioptions.ConfigFlags).AddFlags(cmd.Flags())
 }
 
 func (f *flag) Validate() error {
@@ -22,5 +51,19 @@ func (f *flag) Validate() error {
 		return microerror.Maskf(invalidFlagError, "--%s must not be empty", flagName)
 	}
 
+	if f.Apply {
+		if f.OutputDir != "" {
+			return microerror.Maskf(invalidFlagError, "--%s and --%s cannot be combined", flagApply, flagOutputDir)
+		}
+		if !apply.IsDryRunStrategy(f.DryRun) {
+			return microerror.Maskf(invalidFlagError, "--%s must be one of %s", flagDryRun, strings.Join(apply.DryRunStrategies, ", "))
+		}
+		if f.Wait && f.DryRun == apply.DryRunServer {
+			return microerror.Maskf(invalidFlagError, "--%s cannot be combined with --%s=%s", flagWait, flagDryRun, apply.DryRunServer)
+		}
+	} else if f.DryRun == apply.DryRunServer || f.Wait {
+		return microerror.Maskf(invalidFlagError, "--%s and --%s require --%s", flagDryRun, flagWait, flagApply)
+	}
+
 	return nil
 }
@@ -2,20 +2,29 @@ package organization
 
 import (
 	"context"
-	"fmt"
 	"io"
 
 	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
+	"github.com/giantswarm/kubectl-gs/pkg/commonconfig"
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
+	"github.com/giantswarm/kubectl-gs/pkg/template/apply"
 	template "github.com/giantswarm/kubectl-gs/pkg/template/organization"
 )
 
 type runner struct {
 	flag   *flag
+	logger micrologger.Logger
+	fs     afero.Fs
 	stdout io.Writer
 	stderr io.Writer
+
+	client *client.Client
 }
 
 func (r *runner) Run(cmd *cobra.Command, args []string) error {
@@ -51,7 +60,44 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 		return microerror.Mask(err)
 	}
 
-	fmt.Fprint(r.stdout, string(organizationCRYaml))
+	output, err := apply.NewOutput(apply.OutputConfig{
+		Apply:       r.flag.Apply,
+		DryRun:      r.flag.DryRun,
+		GetClient:   r.getClient,
+		Wait:        r.flag.Wait,
+		WaitTimeout: r.flag.WaitTimeout,
+		Fs:          r.fs,
+		Dir:         r.flag.OutputDir,
+		Force:       r.flag.Force,
+		Stdout:      r.stdout,
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	_, err = output.Write(organizationCRYaml)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = output.Flush(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
 
 	return nil
 }
+
+// getClient returns the client for the management cluster, which is created
+// on first use.
+func (r *runner) getClient() (runtimeclient.Client, error) {
+	if r.client == nil {
+		var err error
+		r.client, err = commonconfig.New(r.flag.config).GetClient(r.logger)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	return r.client.K8sClient.CtrlClient(), nil
+}
@@ -3,11 +3,19 @@ package organization
 import (
 	"bytes"
 	goflag "flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/giantswarm/micrologger/microloggertest"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"github.com/spf13/afero"
 
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
+	"github.com/giantswarm/kubectl-gs/pkg/template/apply"
 	"github.com/giantswarm/kubectl-gs/test/goldenfile"
 )
 
@@ -21,6 +29,7 @@ func TestRunner_Run(t *testing.T) {
 	testCases := []struct {
 		name               string
 		flag               *flag
+		outputDir          bool
 		expectedGoldenFile string
 		errorMatcher       func(error) bool
 	}{
@@ -36,18 +45,46 @@ func TestRunner_Run(t *testing.T) {
 			},
 			expectedGoldenFile: "run_with_name.golden",
 		},
+		{
+			name: "",
+			flag: &flag{
+				Name: "example",
+			},
+			outputDir:          true,
+			expectedGoldenFile: "run_with_output_dir.golden",
+		},
+		{
+			name: "",
+			flag: &flag{
+				Name:   "example",
+				Apply:  true,
+				DryRun: apply.DryRunNone,
+			},
+			expectedGoldenFile: "run_with_apply.golden",
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			out := new(bytes.Buffer)
 
+			if tc.outputDir {
+				tc.flag.OutputDir = t.TempDir()
+			}
+
+			fakeClient, err := client.NewFakeClient(client.Config{Logger: microloggertest.New()})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
 			r := &runner{
 				flag:   tc.flag,
+				fs:     afero.NewOsFs(),
 				stdout: out,
+				client: fakeClient,
 			}
 
-			err := r.Run(nil, nil)
+			err = r.Run(nil, nil)
 			if tc.errorMatcher != nil {
 				if !tc.errorMatcher(err) {
 					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
@@ -58,6 +95,27 @@ func TestRunner_Run(t *testing.T) {
 				t.Fatalf("unexpected error: %s", err.Error())
 			}
 
+			if tc.outputDir {
+				// The written files are listed on stdout.
+				listing := strings.ReplaceAll(out.String(), tc.flag.OutputDir, "<output-dir>")
+				out.Reset()
+				out.WriteString(listing)
+
+				files, err := ioutil.ReadDir(tc.flag.OutputDir)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+
+				for _, file := range files {
+					content, err := ioutil.ReadFile(filepath.Join(tc.flag.OutputDir, file.Name()))
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err.Error())
+					}
+
+					fmt.Fprintf(out, "# %s\n%s", file.Name(), content)
+				}
+			}
+
 			var expectedResult []byte
 			{
 				gf := goldenfile.New("testdata", tc.expectedGoldenFile)
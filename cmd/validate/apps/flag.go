@@ -1,12 +1,14 @@
 package apps
 
 import (
+	"github.com/giantswarm/microerror"
 	"github.com/spf13/cobra"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 )
 
 const (
 	flagAllNamespaces    = "all-namespaces"
+	flagChunkSize        = "chunk-size"
 	flagLabelSelector    = "selector"
 	flagQuiet            = "quiet"
 	flagOutputFormat     = "output"
@@ -17,6 +19,7 @@ type flag struct {
 	config genericclioptions.RESTClientGetter
 
 	AllNamespaces    bool
+	ChunkSize        int64
 	LabelSelector    string
 	OutputFormat     string
 	Quiet            bool
@@ -27,6 +30,7 @@ func (f *flag) Init(cmd *cobra.Command) {
 	cmd.Flags().BoolVarP(&f.Quiet, flagQuiet, "q", false, "Suppress output and just return the exit code.")
 	cmd.Flags().StringVarP(&f.LabelSelector, flagLabelSelector, "l", "", "Specify label selector(s) to filter Apps by.")
 	cmd.Flags().BoolVarP(&f.AllNamespaces, flagAllNamespaces, "A", false, "Validate apps across all namespaces. This can take a long time.")
+	cmd.Flags().Int64Var(&f.ChunkSize, flagChunkSize, 500, "Return large lists in chunks rather than all at once. Pass 0 to disable.")
 	cmd.Flags().StringVarP(&f.ValuesSchemaFile, flagValuesSchemaFile, "f", "", "Provide your own schema file to validate app values against.")
 	cmd.Flags().StringVarP(&f.OutputFormat, flagOutputFormat, "o", "", "Output format. Use 'report' to get a human readable report of validation issues.")
 
@@ -38,5 +42,9 @@ func (f *flag) Init(cmd *cobra.Command) {
 }
 
 func (f *flag) Validate() error {
+	if f.ChunkSize < 0 {
+		return microerror.Maskf(invalidFlagError, "--%s must not be negative", flagChunkSize)
+	}
+
 	return nil
 }
@@ -91,6 +91,7 @@ func (r *runner) run(ctx context.Context, cmd *cobra.Command, args []string) err
 				options.Name = args[0]
 			}
 
+			options.ChunkSize = r.flag.ChunkSize
 			options.Namespace = namespace
 			options.LabelSelector = labelSelector
 			options.ValuesSchema = valuesSchema
@@ -0,0 +1,7 @@
+package annotation
+
+const (
+	// CreatedBy names the person or automation that created a resource,
+	// where the API server itself does not record it.
+	CreatedBy = "giantswarm.io/created-by"
+)
@@ -0,0 +1,270 @@
+package v1alpha3
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// The deep copy functions below are written by hand, as the types are a
+// subset of the upstream ones. They have to be updated whenever a field is
+// added to or removed from the types.
+
+func (in *OpenStackClusterSpec) DeepCopyInto(out *OpenStackClusterSpec) {
+	*out = *in
+	if in.CloudsSecret != nil {
+		in, out := &in.CloudsSecret, &out.CloudsSecret
+		*out = new(corev1.SecretReference)
+		**out = **in
+	}
+	if in.DNSNameservers != nil {
+		in, out := &in.DNSNameservers, &out.DNSNameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
+	if in.ControlPlaneAvailabilityZones != nil {
+		in, out := &in.ControlPlaneAvailabilityZones, &out.ControlPlaneAvailabilityZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+func (in *OpenStackClusterSpec) DeepCopy() *OpenStackClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OpenStackClusterStatus) DeepCopyInto(out *OpenStackClusterStatus) {
+	*out = *in
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureMessage != nil {
+		in, out := &in.FailureMessage, &out.FailureMessage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+func (in *OpenStackClusterStatus) DeepCopy() *OpenStackClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OpenStackCluster) DeepCopyInto(out *OpenStackCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+func (in *OpenStackCluster) DeepCopy() *OpenStackCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OpenStackCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *OpenStackClusterList) DeepCopyInto(out *OpenStackClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenStackCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+func (in *OpenStackClusterList) DeepCopy() *OpenStackClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OpenStackClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *OpenStackMachineSpec) DeepCopyInto(out *OpenStackMachineSpec) {
+	*out = *in
+	if in.ProviderID != nil {
+		in, out := &in.ProviderID, &out.ProviderID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CloudsSecret != nil {
+		in, out := &in.CloudsSecret, &out.CloudsSecret
+		*out = new(corev1.SecretReference)
+		**out = **in
+	}
+}
+
+func (in *OpenStackMachineSpec) DeepCopy() *OpenStackMachineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OpenStackMachine) DeepCopyInto(out *OpenStackMachine) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+func (in *OpenStackMachine) DeepCopy() *OpenStackMachine {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OpenStackMachine) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *OpenStackMachineList) DeepCopyInto(out *OpenStackMachineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenStackMachine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+func (in *OpenStackMachineList) DeepCopy() *OpenStackMachineList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OpenStackMachineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *OpenStackMachineTemplateResource) DeepCopyInto(out *OpenStackMachineTemplateResource) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+func (in *OpenStackMachineTemplateResource) DeepCopy() *OpenStackMachineTemplateResource {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachineTemplateResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OpenStackMachineTemplateSpec) DeepCopyInto(out *OpenStackMachineTemplateSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+func (in *OpenStackMachineTemplateSpec) DeepCopy() *OpenStackMachineTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachineTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OpenStackMachineTemplate) DeepCopyInto(out *OpenStackMachineTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+func (in *OpenStackMachineTemplate) DeepCopy() *OpenStackMachineTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachineTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OpenStackMachineTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *OpenStackMachineTemplateList) DeepCopyInto(out *OpenStackMachineTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenStackMachineTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+func (in *OpenStackMachineTemplateList) DeepCopy() *OpenStackMachineTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachineTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OpenStackMachineTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+// Package v1alpha3 holds the subset of the Cluster API OpenStack (CAPO)
+// v1alpha3 API used by kubectl-gs, the OpenStackCluster, OpenStackMachine and
+// OpenStackMachineTemplate types with the fields that are templated or
+// shown in tables. The JSON names match the upstream types in
+// sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha3, fields which are
+// not listed here are dropped when decoding. The types are therefore never
+// printed as a whole, the JSON and YAML output of the get commands is made of
+// the Cluster API resources.
+package v1alpha3
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is the group version of the CAPO infrastructure types.
+	GroupVersion = schema.GroupVersion{Group: "infrastructure.cluster.x-k8s.io", Version: "v1alpha3"}
+
+	// SchemeBuilder is used to add the types to a scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
@@ -0,0 +1,55 @@
+package v1alpha3
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+type OpenStackClusterSpec struct {
+	// CloudName is the name of the cloud in the clouds.yaml of CloudsSecret.
+	CloudName string `json:"cloudName,omitempty"`
+	// CloudsSecret references the secret holding the clouds.yaml with the
+	// credentials of the cloud.
+	CloudsSecret *corev1.SecretReference `json:"cloudsSecret,omitempty"`
+
+	// NodeCIDR is the CIDR of the network created for the cluster.
+	NodeCIDR string `json:"nodeCidr,omitempty"`
+	// DNSNameservers are the DNS servers of the subnet created for the
+	// cluster.
+	DNSNameservers []string `json:"dnsNameservers,omitempty"`
+	// ExternalNetworkID is the ID of the network the floating IPs are
+	// allocated from.
+	ExternalNetworkID string `json:"externalNetworkId,omitempty"`
+
+	ManagedAPIServerLoadBalancer bool `json:"managedAPIServerLoadBalancer"`
+	ManagedSecurityGroups        bool `json:"managedSecurityGroups"`
+
+	ControlPlaneEndpoint          capiv1alpha3.APIEndpoint `json:"controlPlaneEndpoint"`
+	ControlPlaneAvailabilityZones []string                 `json:"controlPlaneAvailabilityZones,omitempty"`
+}
+
+type OpenStackClusterStatus struct {
+	Ready bool `json:"ready"`
+
+	FailureReason  *string `json:"failureReason,omitempty"`
+	FailureMessage *string `json:"failureMessage,omitempty"`
+}
+
+type OpenStackCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenStackClusterSpec   `json:"spec,omitempty"`
+	Status OpenStackClusterStatus `json:"status,omitempty"`
+}
+
+type OpenStackClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenStackCluster `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenStackCluster{}, &OpenStackClusterList{})
+}
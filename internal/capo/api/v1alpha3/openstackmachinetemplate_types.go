@@ -0,0 +1,49 @@
+package v1alpha3
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type OpenStackMachineSpec struct {
+	// ProviderID is set by CAPO on the machines created from the template.
+	ProviderID *string `json:"providerID,omitempty"`
+
+	// CloudName is the name of the cloud in the clouds.yaml of CloudsSecret.
+	CloudName string `json:"cloudName"`
+	// CloudsSecret references the secret holding the clouds.yaml with the
+	// credentials of the cloud.
+	CloudsSecret *corev1.SecretReference `json:"cloudsSecret"`
+
+	// Flavor is the name of the OpenStack flavor of the machines.
+	Flavor string `json:"flavor"`
+	// Image is the name of the image the machines boot from.
+	Image string `json:"image"`
+	// SSHKeyName is the name of the OpenStack key pair of the machines.
+	SSHKeyName string `json:"sshKeyName,omitempty"`
+}
+
+type OpenStackMachineTemplateResource struct {
+	Spec OpenStackMachineSpec `json:"spec"`
+}
+
+type OpenStackMachineTemplateSpec struct {
+	Template OpenStackMachineTemplateResource `json:"template"`
+}
+
+type OpenStackMachineTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OpenStackMachineTemplateSpec `json:"spec,omitempty"`
+}
+
+type OpenStackMachineTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenStackMachineTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenStackMachineTemplate{}, &OpenStackMachineTemplateList{})
+}
@@ -1,6 +1,7 @@
 package feature
 
 import (
+	"sort"
 	"strings"
 
 	semver "github.com/blang/semver/v4"
@@ -43,3 +44,17 @@ func (s *Service) Supports(featureName string, releaseVersion string) bool {
 
 	return inputVersion.GE(featureMinVersion)
 }
+
+// Features returns the names of all the features known for the
+// provider, sorted alphabetically.
+func (s *Service) Features() []string {
+	var names []string
+	for name, feature := range s.features {
+		if _, exists := feature[s.provider]; exists {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -91,3 +91,39 @@ func TestService_Supports(t *testing.T) {
 		})
 	}
 }
+
+func TestService_Features(t *testing.T) {
+	testCases := []struct {
+		name           string
+		provider       string
+		expectedResult []string
+	}{
+		{
+			name:           "case 0: features on aws",
+			provider:       ProviderAWS,
+			expectedResult: []string{Autoscaling},
+		},
+		{
+			name:           "case 1: features on azure",
+			provider:       ProviderAzure,
+			expectedResult: []string{Autoscaling, NodePoolConditions},
+		},
+		{
+			name:           "case 2: features on kvm",
+			provider:       ProviderKVM,
+			expectedResult: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			capabilities := New(tc.provider)
+			features := capabilities.Features()
+
+			diff := cmp.Diff(tc.expectedResult, features)
+			if len(diff) > 0 {
+				t.Fatalf("value not expected, got:\n %s", diff)
+			}
+		})
+	}
+}
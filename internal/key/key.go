@@ -4,18 +4,17 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"hash/fnv"
 	"math/rand"
 	"regexp"
-	"strconv"
 	"strings"
-	"time"
 
 	"github.com/blang/semver/v4"
+	releasev1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/release/v1alpha1"
 	"github.com/giantswarm/microerror"
 	"github.com/spf13/afero"
 	v1 "k8s.io/api/core/v1"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
-	runtimeconfig "sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/yaml"
 
 	"github.com/giantswarm/kubectl-gs/pkg/normalize"
@@ -31,9 +30,22 @@ const (
 	organizationNamespaceFormat = "org-%s"
 )
 
+// idRegexp matches the IDs generated by id.Generate. They start with a
+// letter, followed by pairs of one letter and one digit in either order, so
+// an ID of IDLength characters has three letters and two digits.
+var idRegexp = regexp.MustCompile("^[a-z]([a-z][0-9]|[0-9][a-z])+$")
+
 const (
 	AWSBastionInstanceType = "t3.small"
 
+	// OpenStackKubernetesVersion is the Kubernetes version of the machines
+	// of OpenStack clusters.
+	OpenStackKubernetesVersion = "v1.19.9"
+
+	// VSphereKubernetesVersion is the Kubernetes version of the machines of
+	// vSphere clusters.
+	VSphereKubernetesVersion = "v1.19.9"
+
 	CAPIRoleLabel = "cluster.x-k8s.io/role"
 	CAPARoleTag   = "tag:sigs.k8s.io/cluster-api-provider-aws/role"
 
@@ -47,6 +59,10 @@ const (
 	GiantswarmNamespace = "giantswarm"
 
 	ControllerRuntimeBurstValue = 200
+
+	// KubeconfigSecretKey is the key of the kubeconfig
+	// in the secrets created by Cluster API.
+	KubeconfigSecretKey = "value"
 )
 
 const (
@@ -58,6 +74,12 @@ func BastionResourceName(clusterName string) string {
 	return fmt.Sprintf("%s-bastion", clusterName)
 }
 
+// KubeconfigSecretName returns the name of the secret in which
+// Cluster API stores the kubeconfig of the given cluster.
+func KubeconfigSecretName(clusterName string) string {
+	return fmt.Sprintf("%s-kubeconfig", clusterName)
+}
+
 func BastionSSHDConfigEncoded() string {
 	return base64.StdEncoding.EncodeToString([]byte(bastionSSHDConfig))
 }
@@ -78,32 +100,29 @@ func FlatcarAWSAccountID(awsRegion string) string {
 	}
 }
 
-func GenerateID() string {
-	compiledRegexp, _ := regexp.Compile("^[a-z]+$")
+// SeededIDGenerator returns a function generating IDs in the format of
+// id.Generate. The IDs are derived from the given seed, so every generator
+// with the same seed returns the same sequence of IDs.
+func SeededIDGenerator(seed string) func() string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(seed))
 
 	/* #nosec G404 */
-	for {
-		letterRunes := []rune(IDChars)
-		b := make([]rune, IDLength)
-		rand.Seed(time.Now().UnixNano())
-		for i := range b {
-			b[i] = letterRunes[rand.Intn(len(letterRunes))]
-		}
-
-		id := string(b)
-
-		if _, err := strconv.Atoi(id); err == nil {
-			// ID is made up of numbers only, which we want to avoid.
-			continue
-		}
+	r := rand.New(rand.NewSource(int64(h.Sum64())))
+	letterRunes := []rune(IDChars)
 
-		matched := compiledRegexp.MatchString(id)
-		if matched {
-			// ID is made up of letters only, which we also avoid.
-			continue
+	return func() string {
+		b := make([]rune, IDLength)
+		for {
+			for i := range b {
+				b[i] = letterRunes[r.Intn(len(letterRunes))]
+			}
+
+			id := string(b)
+			if idRegexp.MatchString(id) {
+				return id
+			}
 		}
-
-		return id
 	}
 }
 
@@ -115,6 +134,33 @@ func GetCAPAEnvVars() []string {
 	return []string{"AWS_SUBNET", "AWS_CONTROL_PLANE_MACHINE_TYPE", "AWS_REGION", "AWS_SSH_KEY_NAME"}
 }
 
+// GetCAPVEnvVars returns the vSphere specific variables of the CAPV cluster
+// template.
+func GetCAPVEnvVars() []string {
+	return []string{
+		"CONTROL_PLANE_ENDPOINT_IP",
+		"VSPHERE_DATACENTER",
+		"VSPHERE_DATASTORE",
+		"VSPHERE_FOLDER",
+		"VSPHERE_NETWORK",
+		"VSPHERE_PASSWORD",
+		"VSPHERE_RESOURCE_POOL",
+		"VSPHERE_SERVER",
+		"VSPHERE_SSH_AUTHORIZED_KEY",
+		"VSPHERE_STORAGE_POLICY",
+		"VSPHERE_TEMPLATE",
+		"VSPHERE_TLS_THUMBPRINT",
+		"VSPHERE_USERNAME",
+	}
+}
+
+// OpenStackCloudsSecretName returns the name of the secret holding the
+// clouds.yaml of the given cluster. It is not templated, as it holds the
+// credentials of the cloud, and is expected next to the cluster CRs.
+func OpenStackCloudsSecretName(clusterName string) string {
+	return fmt.Sprintf("%s-cloud-config", clusterName)
+}
+
 func GetControlPlaneInstanceProfile(clusterID string) string {
 	return fmt.Sprintf("control-plane-%s", clusterID)
 }
@@ -179,21 +225,13 @@ func ReadSecretYamlFromFile(fs afero.Fs, path string) ([]byte, error) {
 	return data, nil
 }
 
-func SSHSSOPublicKey() (string, error) {
-	c, err := runtimeconfig.GetConfig()
-	if err != nil {
-		return "", microerror.Mask(err)
-	}
-	c.Burst = ControllerRuntimeBurstValue // to avoid throttling the request
-
-	k8sClient, err := runtimeclient.New(c, runtimeclient.Options{})
-	if err != nil {
-		return "", microerror.Mask(err)
-	}
+// SSHSSOPublicKey returns the base64-encoded SSH SSO public key stored in
+// the management cluster.
+func SSHSSOPublicKey(ctx context.Context, k8sClient runtimeclient.Reader) (string, error) {
 	secretList := &v1.SecretList{}
 
-	err = k8sClient.List(
-		context.TODO(),
+	err := k8sClient.List(
+		ctx,
 		secretList,
 		runtimeclient.MatchingLabels{
 			RoleLabel: SSHSSOPubKeyLabel,
@@ -211,6 +249,64 @@ func SSHSSOPublicKey() (string, error) {
 	return sshSSOPublicKey, nil
 }
 
+// ReleaseComponents returns the versions of the components of the given
+// release, keyed by component name, as listed in the Release CR of the
+// management cluster.
+func ReleaseComponents(ctx context.Context, k8sClient runtimeclient.Reader, releaseVersion string) (map[string]string, error) {
+	release := &releasev1alpha1.Release{}
+
+	err := k8sClient.Get(ctx, runtimeclient.ObjectKey{Name: "v" + strings.TrimPrefix(releaseVersion, "v")}, release)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	components := map[string]string{}
+	for _, c := range release.Spec.Components {
+		components[c.Name] = c.Version
+	}
+
+	return components, nil
+}
+
+// SSHSSOPublicKeyFromFile returns the base64-encoded SSH SSO public key read
+// from the given file, as a replacement for the one in the management
+// cluster when templating offline.
+func SSHSSOPublicKeyFromFile(fs afero.Fs, path string) (string, error) {
+	data, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// GetSSHSSOPublicKey returns the base64-encoded SSH SSO public key, read
+// from the given file if any, and from the management cluster otherwise. The
+// client of the management cluster is only created when it is needed, so
+// that templating with the file works offline.
+func GetSSHSSOPublicKey(ctx context.Context, fs afero.Fs, path string, getClient func() (runtimeclient.Client, error)) (string, error) {
+	if path != "" {
+		sshSSOPublicKey, err := SSHSSOPublicKeyFromFile(fs, path)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+
+		return sshSSOPublicKey, nil
+	}
+
+	k8sClient, err := getClient()
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	sshSSOPublicKey, err := SSHSSOPublicKey(ctx, k8sClient)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	return sshSSOPublicKey, nil
+}
+
 func UbuntuSudoersConfigEncoded() string {
 	return base64.StdEncoding.EncodeToString([]byte(ubuntuSudoersConfig))
 }
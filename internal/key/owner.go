@@ -0,0 +1,50 @@
+package key
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/kubectl-gs/internal/annotation"
+	"github.com/giantswarm/kubectl-gs/internal/label"
+)
+
+// Organization returns the organization owning a resource, from its
+// organization label, or else from the organization namespace it is in.
+func Organization(obj metav1.Object) string {
+	organization := obj.GetLabels()[label.Organization]
+	if len(organization) > 0 {
+		return organization
+	}
+
+	prefix := strings.TrimSuffix(organizationNamespaceFormat, "%s")
+	if strings.HasPrefix(obj.GetNamespace(), prefix) {
+		return strings.TrimPrefix(obj.GetNamespace(), prefix)
+	}
+
+	return ""
+}
+
+// CreatedBy returns who created a resource, from its created-by annotation.
+// Resources without it fall back to the field manager that wrote to them
+// first, e.g. "kubectl-client-side-apply" or the name of an operator.
+func CreatedBy(obj metav1.Object) string {
+	createdBy := obj.GetAnnotations()[annotation.CreatedBy]
+	if len(createdBy) > 0 {
+		return createdBy
+	}
+
+	var first *metav1.ManagedFieldsEntry
+	managedFields := obj.GetManagedFields()
+	for i := range managedFields {
+		entry := &managedFields[i]
+		if first == nil || (entry.Time != nil && (first.Time == nil || entry.Time.Before(first.Time))) {
+			first = entry
+		}
+	}
+	if first == nil {
+		return ""
+	}
+
+	return first.Manager
+}
@@ -1,7 +1,9 @@
 package key
 
 const (
-	ProviderAWS   = "aws"
-	ProviderAzure = "azure"
-	ProviderKVM   = "kvm"
+	ProviderAWS       = "aws"
+	ProviderAzure     = "azure"
+	ProviderKVM       = "kvm"
+	ProviderOpenStack = "openstack"
+	ProviderVSphere   = "vsphere"
 )
@@ -58,6 +58,48 @@ const MachineDeploymentCRsTemplate = `
 {{ .AWSMachineDeploymentCR -}}
 `
 
+const ClusterCAPVCRsTemplate = `
+{{- .ClusterCR -}}
+---
+{{ .VSphereClusterCR -}}
+---
+{{ .KubeadmControlPlaneCR -}}
+---
+{{ .VSphereMachineTemplateCR -}}
+`
+
+const ClusterKVMCRsTemplate = `
+{{- .ClusterCR -}}
+---
+{{ .KVMConfigCR -}}
+`
+
+const MachineDeploymentCAPVCRsTemplate = `
+{{- .MachineDeploymentCR -}}
+---
+{{ .VSphereMachineTemplateCR -}}
+---
+{{ .KubeadmConfigTemplateCR -}}
+`
+
+const ClusterCAPOCRsTemplate = `
+{{- .ClusterCR -}}
+---
+{{ .OpenStackClusterCR -}}
+---
+{{ .KubeadmControlPlaneCR -}}
+---
+{{ .OpenStackMachineTemplateCR -}}
+`
+
+const MachineDeploymentCAPOCRsTemplate = `
+{{- .MachineDeploymentCR -}}
+---
+{{ .OpenStackMachineTemplateCR -}}
+---
+{{ .KubeadmConfigTemplateCR -}}
+`
+
 const NetworkPoolCRsTemplate = `
 {{- .NetworkPoolCR -}}
 `
@@ -8,6 +8,9 @@ import (
 )
 
 type ValidateOptions struct {
+	// ChunkSize is the maximum number of apps listed per
+	// request, or 0 to list all of them at once.
+	ChunkSize     int64
 	LabelSelector string
 	Name          string
 	Namespace     string
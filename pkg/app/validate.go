@@ -37,7 +37,7 @@ func (s *Service) Validate(ctx context.Context, options ValidateOptions) (Valida
 			return nil, microerror.Mask(err)
 		}
 	} else {
-		results, err = s.validateMultiple(ctx, namespace, selector, options.ValuesSchema)
+		results, err = s.validateMultiple(ctx, namespace, selector, options.ValuesSchema, options.ChunkSize)
 		if err != nil {
 			return nil, microerror.Mask(err)
 		}
@@ -91,55 +91,60 @@ func (s *Service) validateByName(ctx context.Context, name, namespace string, cu
 
 }
 
-func (s *Service) validateMultiple(ctx context.Context, namespace string, labelSelector string, customValuesSchema string) (ValidationResults, error) {
+func (s *Service) validateMultiple(ctx context.Context, namespace string, labelSelector string, customValuesSchema string, chunkSize int64) (ValidationResults, error) {
 	var err error
 	results := ValidationResults{}
 
 	options := appdata.GetOptions{
+		ChunkSize:     chunkSize,
 		Namespace:     namespace,
 		LabelSelector: labelSelector,
 	}
 
-	appResource, err := s.appDataService.Get(ctx, options)
-	if err != nil {
-		return results, microerror.Mask(err)
-	}
-
-	var apps []*applicationv1alpha1.App
+	// The apps are validated chunk by chunk, as they are listed,
+	// so that they don't have to be held in memory all at once.
+	err = s.appDataService.Stream(ctx, options, func(appResource appdata.Resource) error {
+		var apps []*applicationv1alpha1.App
 
-	switch a := appResource.(type) {
-	case *app.Collection:
-		for _, appItem := range a.Items {
-			apps = append(apps, appItem.CR)
+		switch a := appResource.(type) {
+		case *app.Collection:
+			for _, appItem := range a.Items {
+				apps = append(apps, appItem.CR)
+			}
+		default:
+			return microerror.Maskf(invalidTypeError, "unexpected type %T found", a)
 		}
-	default:
-		return results, microerror.Maskf(invalidTypeError, "unexpected type %T found", a)
-	}
-
-	if len(apps) == 0 {
-		return results, microerror.Mask(noResourcesError)
-	}
 
-	// Iterate over all apps and fetch the AppCatalog CR, index.yaml, and
-	// corresponding values.schema.json if it is defined for that app's version.
-	for _, app := range apps {
-		valuesSchema, schemaValidationResult, err := s.validateApp(ctx, app, customValuesSchema)
-		if err != nil {
+		// Iterate over all apps and fetch the AppCatalog CR, index.yaml, and
+		// corresponding values.schema.json if it is defined for that app's version.
+		for _, app := range apps {
+			valuesSchema, schemaValidationResult, err := s.validateApp(ctx, app, customValuesSchema)
+			if err != nil {
+				results = append(results, &ValidationResult{
+					App:          app,
+					ValuesSchema: "",
+					Err:          microerror.Mask(err),
+				})
+
+				continue
+			}
+
+			// Append the result to the results array.
 			results = append(results, &ValidationResult{
-				App:          app,
-				ValuesSchema: "",
-				Err:          microerror.Mask(err),
+				App:              app,
+				ValuesSchema:     valuesSchema,
+				ValidationErrors: schemaValidationResult.Errors(),
 			})
-
-			continue
 		}
 
-		// Append the result to the results array.
-		results = append(results, &ValidationResult{
-			App:              app,
-			ValuesSchema:     valuesSchema,
-			ValidationErrors: schemaValidationResult.Errors(),
-		})
+		return nil
+	})
+	if err != nil {
+		return results, microerror.Mask(err)
+	}
+
+	if len(results) == 0 {
+		return results, microerror.Mask(noResourcesError)
 	}
 
 	return results, nil
@@ -3,6 +3,7 @@ package commonconfig
 import (
 	"fmt"
 	"regexp"
+	"sync"
 
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
@@ -19,6 +20,9 @@ const (
 
 type CommonConfig struct {
 	configFlags genericclioptions.RESTClientGetter
+
+	cachedClientMutex sync.Mutex
+	cachedClient      *dataClient.Client
 }
 
 func New(cf genericclioptions.RESTClientGetter) *CommonConfig {
@@ -37,6 +41,7 @@ func (cc *CommonConfig) GetProvider() (string, error) {
 
 	awsRegexp := regexp.MustCompile(fmt.Sprintf(providerRegexpPattern, key.ProviderAWS))
 	azureRegexp := regexp.MustCompile(fmt.Sprintf(providerRegexpPattern, key.ProviderAzure))
+	openStackRegexp := regexp.MustCompile(fmt.Sprintf(providerRegexpPattern, key.ProviderOpenStack))
 
 	var provider string
 	switch {
@@ -46,6 +51,9 @@ func (cc *CommonConfig) GetProvider() (string, error) {
 	case azureRegexp.MatchString(config.Host):
 		provider = key.ProviderAzure
 
+	case openStackRegexp.MatchString(config.Host):
+		provider = key.ProviderOpenStack
+
 	default:
 		provider = key.ProviderKVM
 	}
@@ -71,3 +79,36 @@ func (cc *CommonConfig) GetClient(logger micrologger.Logger) (*dataClient.Client
 
 	return client, nil
 }
+
+// GetCachedClient returns a client whose reads are served from shared
+// informers. It is created once, so that all the services created with it
+// share the same informers, and it has to be stopped once it's not needed.
+// The informers list all the resources of a kind, which only pays off for
+// commands reading them for many clusters, like get cluster-health. Commands
+// getting single resources or watching them use GetClient.
+func (cc *CommonConfig) GetCachedClient(logger micrologger.Logger) (*dataClient.Client, error) {
+	cc.cachedClientMutex.Lock()
+	defer cc.cachedClientMutex.Unlock()
+
+	if cc.cachedClient != nil {
+		return cc.cachedClient, nil
+	}
+
+	restConfig, err := cc.configFlags.ToRESTConfig()
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	config := dataClient.Config{
+		Logger:        logger,
+		Cache:         true,
+		K8sRestConfig: restConfig,
+	}
+
+	cc.cachedClient, err = dataClient.New(config)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return cc.cachedClient, nil
+}
@@ -39,6 +39,11 @@ func TestCommonConfig_GetProvider(t *testing.T) {
 			k8sApiURL:      "https://g8s.sazure.eu-west-1.kvm.coolio.com",
 			expectedResult: key.ProviderKVM,
 		},
+		{
+			name:           "case 5: OpenStack url",
+			k8sApiURL:      "https://g8s.test.regionone.openstack.coolio.com",
+			expectedResult: key.ProviderOpenStack,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,281 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/giantswarm/microerror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const (
+	// cacheSyncPeriod is how often reads check
+	// whether an informer has synced.
+	cacheSyncPeriod = 10 * time.Millisecond
+)
+
+var _ runtimeclient.Reader = &Cache{}
+
+// Cache is a reader that serves gets and lists from shared informers.
+// An informer is built the first time a kind is read in a namespace, and
+// it is kept up to date by a watch afterwards, so that reading the same
+// kind again, from any of the services of a command, costs no API call.
+type Cache struct {
+	dynClient dynamic.Interface
+	scheme    *runtime.Scheme
+
+	mutex     sync.Mutex
+	informers map[informerKey]*informerEntry
+}
+
+type informerEntry struct {
+	key      informerKey
+	informer cache.SharedIndexInformer
+
+	// failed is closed when the initial list fails, so that
+	// reads don't wait for an informer that is not going to sync.
+	failed   chan struct{}
+	failOnce sync.Once
+	err      error
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+func (e *informerEntry) fail(err error) {
+	e.failOnce.Do(func() {
+		e.err = err
+		close(e.failed)
+	})
+}
+
+func (e *informerEntry) stop() {
+	e.stopOnce.Do(func() {
+		close(e.stopCh)
+	})
+}
+
+type informerKey struct {
+	resource  schema.GroupVersionResource
+	namespace string
+}
+
+// NewCache returns a cache that builds its informers with the given
+// dynamic client. The scheme is used to convert the cached objects
+// into the typed objects that are read.
+func NewCache(dynClient dynamic.Interface, scheme *runtime.Scheme) *Cache {
+	c := &Cache{
+		dynClient: dynClient,
+		scheme:    scheme,
+
+		informers: map[informerKey]*informerEntry{},
+	}
+
+	return c
+}
+
+// Get reads a single object from the informer of its kind.
+func (c *Cache) Get(ctx context.Context, key runtimeclient.ObjectKey, obj runtime.Object) error {
+	gvk, err := apiutil.GVKForObject(obj, c.scheme)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	informer, err := c.getInformer(ctx, gvk, key.Namespace)
+	if err != nil {
+		return err
+	}
+
+	item, exists, err := informer.GetIndexer().GetByKey(key.String())
+	if err != nil {
+		return microerror.Mask(err)
+	} else if !exists {
+		resource, _ := apimeta.UnsafeGuessKindToResource(gvk)
+		return apierrors.NewNotFound(resource.GroupResource(), key.Name)
+	}
+
+	err = c.convert(item.(*unstructured.Unstructured), obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// List reads the objects of a kind from its informer. Only label
+// selectors are supported, field selectors are ignored.
+func (c *Cache) List(ctx context.Context, list runtime.Object, opts ...runtimeclient.ListOption) error {
+	options := (&runtimeclient.ListOptions{}).ApplyOptions(opts)
+
+	gvk, err := apiutil.GVKForObject(list, c.scheme)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+
+	informer, err := c.getInformer(ctx, gvk, options.Namespace)
+	if err != nil {
+		return err
+	}
+
+	selector := options.LabelSelector
+	if selector == nil {
+		selector = labels.Everything()
+	}
+
+	var items []runtime.Object
+	for _, item := range informer.GetIndexer().List() {
+		u := item.(*unstructured.Unstructured)
+		if len(options.Namespace) > 0 && u.GetNamespace() != options.Namespace {
+			continue
+		}
+		if !selector.Matches(labels.Set(u.GetLabels())) {
+			continue
+		}
+
+		if _, ok := list.(*unstructured.UnstructuredList); ok {
+			items = append(items, u.DeepCopy())
+			continue
+		}
+
+		obj, err := c.scheme.New(gvk)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		err = c.convert(u, obj)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		items = append(items, obj)
+	}
+
+	err = apimeta.SetList(list, items)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// Stop stops all the informers of the cache.
+func (c *Cache) Stop() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for k, e := range c.informers {
+		e.stop()
+		delete(c.informers, k)
+	}
+}
+
+// getInformer returns the informer of the given resource in the given
+// namespace, and starts it if needed. An informer of all namespaces is
+// reused for reads in a single namespace. It blocks until the informer
+// has synced, or its initial list has failed. The errors of the API are
+// returned without masking, like the API client does, since they are
+// checked by their type.
+func (c *Cache) getInformer(ctx context.Context, gvk schema.GroupVersionKind, namespace string) (cache.SharedIndexInformer, error) {
+	resource, _ := apimeta.UnsafeGuessKindToResource(gvk)
+	key := informerKey{resource: resource, namespace: namespace}
+
+	c.mutex.Lock()
+	e, exists := c.informers[informerKey{resource: resource, namespace: metav1.NamespaceAll}]
+	if !exists {
+		e, exists = c.informers[key]
+	}
+	if !exists {
+		e = c.newInformer(key)
+		c.informers[key] = e
+	}
+	c.mutex.Unlock()
+
+	ticker := time.NewTicker(cacheSyncPeriod)
+	defer ticker.Stop()
+
+	for !e.informer.HasSynced() {
+		select {
+		case <-e.failed:
+			c.mutex.Lock()
+			if c.informers[e.key] == e {
+				delete(c.informers, e.key)
+			}
+			e.stop()
+			c.mutex.Unlock()
+
+			if apierrors.IsNotFound(e.err) {
+				// The dynamic client reports resources that the API
+				// doesn't serve as not found, which the typed client
+				// reports as no match.
+				return nil, &apimeta.NoKindMatchError{GroupKind: gvk.GroupKind(), SearchedVersions: []string{gvk.Version}}
+			}
+
+			return nil, e.err
+		case <-ctx.Done():
+			return nil, microerror.Maskf(cacheNotSyncedError, "the informer of %s in namespace %#q has not synced", resource.String(), namespace)
+		case <-ticker.C:
+		}
+	}
+
+	return e.informer, nil
+}
+
+func (c *Cache) newInformer(key informerKey) *informerEntry {
+	e := &informerEntry{
+		key:    key,
+		failed: make(chan struct{}),
+		stopCh: make(chan struct{}),
+	}
+
+	resourceClient := c.dynClient.Resource(key.resource).Namespace(key.namespace)
+	lw := &cache.ListWatch{
+		ListFunc: func(o metav1.ListOptions) (runtime.Object, error) {
+			list, err := resourceClient.List(context.Background(), o)
+			if err != nil {
+				// Lists failing after the initial one are
+				// retried by the informer, like its watches.
+				if !e.informer.HasSynced() {
+					e.fail(err)
+				}
+
+				return nil, err
+			}
+
+			return list, nil
+		},
+		WatchFunc: func(o metav1.ListOptions) (watch.Interface, error) {
+			return resourceClient.Watch(context.Background(), o)
+		},
+	}
+	e.informer = cache.NewSharedIndexInformer(lw, &unstructured.Unstructured{}, 0, cache.Indexers{})
+
+	go e.informer.Run(e.stopCh)
+
+	return e
+}
+
+func (c *Cache) convert(u *unstructured.Unstructured, obj runtime.Object) error {
+	if o, ok := obj.(*unstructured.Unstructured); ok {
+		u.DeepCopyInto(o)
+		return nil
+	}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,306 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/giantswarm/microerror"
+	"golang.org/x/sync/errgroup"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capiexpv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Test_Cache_List(t *testing.T) {
+	type read func(ctx context.Context, c *Cache) error
+
+	listClusters := func(opts ...runtimeclient.ListOption) read {
+		return func(ctx context.Context, c *Cache) error {
+			return c.List(ctx, &capiv1alpha3.ClusterList{}, opts...)
+		}
+	}
+	listMachinePools := func(opts ...runtimeclient.ListOption) read {
+		return func(ctx context.Context, c *Cache) error {
+			return c.List(ctx, &capiexpv1alpha3.MachinePoolList{}, opts...)
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		reads         []read
+		expectedLists int
+	}{
+		{
+			name: "case 0: list the same kind twice",
+			reads: []read{
+				listClusters(runtimeclient.InNamespace("default")),
+				listClusters(runtimeclient.InNamespace("default")),
+			},
+			expectedLists: 1,
+		},
+		{
+			name: "case 1: list two kinds",
+			reads: []read{
+				listClusters(runtimeclient.InNamespace("default")),
+				listMachinePools(runtimeclient.InNamespace("default")),
+			},
+			expectedLists: 2,
+		},
+		{
+			name: "case 2: list all namespaces, then a single namespace",
+			reads: []read{
+				listClusters(),
+				listClusters(runtimeclient.InNamespace("default")),
+				listClusters(runtimeclient.InNamespace("org-test")),
+			},
+			expectedLists: 1,
+		},
+		{
+			name: "case 3: list two namespaces",
+			reads: []read{
+				listClusters(runtimeclient.InNamespace("default")),
+				listClusters(runtimeclient.InNamespace("org-test")),
+			},
+			expectedLists: 2,
+		},
+		{
+			name: "case 4: list the same kind with different label selectors",
+			reads: []read{
+				listClusters(runtimeclient.InNamespace("default"), runtimeclient.MatchingLabels{capiv1alpha3.ClusterLabelName: "f930q"}),
+				listClusters(runtimeclient.InNamespace("default"), runtimeclient.MatchingLabels{capiv1alpha3.ClusterLabelName: "a2wax"}),
+			},
+			expectedLists: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			dynClient := newFakeDynClient()
+			c := NewCache(dynClient, newCacheTestScheme())
+			defer c.Stop()
+
+			for _, r := range tc.reads {
+				err := r(ctx, c)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+			}
+
+			lists := countActions(dynClient, "list")
+			if lists != tc.expectedLists {
+				t.Fatalf("expected %d lists, got %d", tc.expectedLists, lists)
+			}
+		})
+	}
+}
+
+func Test_Cache_ListFilters(t *testing.T) {
+	ctx := context.Background()
+
+	dynClient := newFakeDynClient(
+		newUnstructuredCluster("default", "f930q"),
+		newUnstructuredCluster("default", "a2wax"),
+		newUnstructuredCluster("org-test", "s0m3x"),
+	)
+	c := NewCache(dynClient, newCacheTestScheme())
+	defer c.Stop()
+
+	all := &capiv1alpha3.ClusterList{}
+	err := c.List(ctx, all)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(all.Items) != 3 {
+		t.Fatalf("expected 3 clusters, got %d", len(all.Items))
+	}
+
+	namespaced := &capiv1alpha3.ClusterList{}
+	err = c.List(ctx, namespaced, runtimeclient.InNamespace("default"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(namespaced.Items) != 2 {
+		t.Fatalf("expected 2 clusters, got %d", len(namespaced.Items))
+	}
+
+	labelled := &capiv1alpha3.ClusterList{}
+	err = c.List(ctx, labelled, runtimeclient.InNamespace("default"), runtimeclient.MatchingLabels{capiv1alpha3.ClusterLabelName: "a2wax"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(labelled.Items) != 1 {
+		t.Fatalf("expected 1 cluster, got %d", len(labelled.Items))
+	}
+	if labelled.Items[0].Name != "a2wax" {
+		t.Fatalf("expected cluster %#q, got %#q", "a2wax", labelled.Items[0].Name)
+	}
+	if labelled.Items[0].Spec.ControlPlaneEndpoint.Host != "api.a2wax.test.gigantic.io" {
+		t.Fatalf("expected the spec of the cluster to be converted, got %#v", labelled.Items[0].Spec)
+	}
+
+	u := &unstructured.UnstructuredList{}
+	u.SetGroupVersionKind(capiv1alpha3.GroupVersion.WithKind("ClusterList"))
+	err = c.List(ctx, u, runtimeclient.InNamespace("org-test"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(u.Items) != 1 {
+		t.Fatalf("expected 1 cluster, got %d", len(u.Items))
+	}
+
+	lists := countActions(dynClient, "list")
+	if lists != 1 {
+		t.Fatalf("expected 1 list, got %d", lists)
+	}
+}
+
+func Test_Cache_Get(t *testing.T) {
+	ctx := context.Background()
+
+	dynClient := newFakeDynClient(
+		newUnstructuredCluster("default", "f930q"),
+	)
+	c := NewCache(dynClient, newCacheTestScheme())
+	defer c.Stop()
+
+	cluster := &capiv1alpha3.Cluster{}
+	err := c.Get(ctx, runtimeclient.ObjectKey{Namespace: "default", Name: "f930q"}, cluster)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if cluster.Name != "f930q" {
+		t.Fatalf("expected cluster %#q, got %#q", "f930q", cluster.Name)
+	}
+
+	err = c.Get(ctx, runtimeclient.ObjectKey{Namespace: "default", Name: "a2wax"}, &capiv1alpha3.Cluster{})
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	lists := countActions(dynClient, "list")
+	if lists != 1 {
+		t.Fatalf("expected 1 list, got %d", lists)
+	}
+}
+
+func Test_Cache_ConcurrentLists(t *testing.T) {
+	ctx := context.Background()
+
+	dynClient := newFakeDynClient(
+		newUnstructuredCluster("default", "f930q"),
+	)
+	c := NewCache(dynClient, newCacheTestScheme())
+	defer c.Stop()
+
+	g, gctx := errgroup.WithContext(ctx)
+	for i := 0; i < 10; i++ {
+		g.Go(func() error {
+			list := &capiv1alpha3.ClusterList{}
+			err := c.List(gctx, list, runtimeclient.InNamespace("default"))
+			if err != nil {
+				return microerror.Mask(err)
+			}
+			if len(list.Items) != 1 {
+				return fmt.Errorf("expected 1 cluster, got %d", len(list.Items))
+			}
+
+			return nil
+		})
+	}
+
+	err := g.Wait()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	lists := countActions(dynClient, "list")
+	if lists != 1 {
+		t.Fatalf("expected 1 list, got %d", lists)
+	}
+}
+
+func Test_Cache_ListNotServed(t *testing.T) {
+	ctx := context.Background()
+
+	dynClient := newFakeDynClient()
+	dynClient.PrependReactor("list", "machinepools", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(schema.GroupResource{Group: capiexpv1alpha3.GroupVersion.Group, Resource: "machinepools"}, "")
+	})
+	c := NewCache(dynClient, newCacheTestScheme())
+	defer c.Stop()
+
+	err := c.List(ctx, &capiexpv1alpha3.MachinePoolList{}, runtimeclient.InNamespace("default"))
+	if !apimeta.IsNoMatchError(err) {
+		t.Fatalf("expected no match error, got %v", err)
+	}
+
+	// The failed informer is not kept, so the next read tries again.
+	err = c.List(ctx, &capiexpv1alpha3.MachinePoolList{}, runtimeclient.InNamespace("default"))
+	if !apimeta.IsNoMatchError(err) {
+		t.Fatalf("expected no match error, got %v", err)
+	}
+
+	lists := countActions(dynClient, "list")
+	if lists != 2 {
+		t.Fatalf("expected 2 lists, got %d", lists)
+	}
+}
+
+func countActions(dynClient *dynamicfake.FakeDynamicClient, verb string) int {
+	var count int
+	for _, a := range dynClient.Actions() {
+		if a.GetVerb() == verb {
+			count++
+		}
+	}
+
+	return count
+}
+
+func newCacheTestScheme() *runtime.Scheme {
+	s := runtime.NewScheme()
+
+	err := capiv1alpha3.AddToScheme(s)
+	if err != nil {
+		panic(err)
+	}
+	err = capiexpv1alpha3.AddToScheme(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+func newFakeDynClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), objects...)
+}
+
+func newUnstructuredCluster(namespace, name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(capiv1alpha3.GroupVersion.WithKind("Cluster"))
+	u.SetNamespace(namespace)
+	u.SetName(name)
+	u.SetCreationTimestamp(metav1.Now())
+	u.SetLabels(map[string]string{
+		capiv1alpha3.ClusterLabelName: name,
+	})
+
+	err := unstructured.SetNestedField(u.Object, fmt.Sprintf("api.%s.test.gigantic.io", name), "spec", "controlPlaneEndpoint", "host")
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
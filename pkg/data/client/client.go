@@ -14,21 +14,30 @@ import (
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	"k8s.io/client-go/rest"
+	capav1alpha3 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
 	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
 	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	capiexpv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	capov1alpha3 "github.com/giantswarm/kubectl-gs/internal/capo/api/v1alpha3"
 )
 
 type Config struct {
 	Logger micrologger.Logger
 
+	// Cache enables serving the reads of the domain
+	// services from shared informers, see Reader.
+	Cache         bool
 	K8sRestConfig *rest.Config
 }
 
 type Client struct {
 	K8sClient k8sclient.Interface
 	Logger    micrologger.Logger
+
+	cache *Cache
 }
 
 func New(config Config) (*Client, error) {
@@ -43,7 +52,9 @@ func New(config Config) (*Client, error) {
 		c := k8sclient.ClientsConfig{
 			SchemeBuilder: k8sclient.SchemeBuilder{
 				capiv1alpha3.AddToScheme,
+				capav1alpha3.AddToScheme,
 				capzv1alpha3.AddToScheme,
+				capov1alpha3.AddToScheme,
 				capiexpv1alpha3.AddToScheme,
 				capzexpv1alpha3.AddToScheme,
 				applicationv1alpha1.AddToScheme,
@@ -71,5 +82,27 @@ func New(config Config) (*Client, error) {
 		Logger:    config.Logger,
 	}
 
+	if config.Cache {
+		c.cache = NewCache(k8sClient.DynClient(), k8sClient.Scheme())
+	}
+
 	return c, nil
 }
+
+// Reader returns the reader that the domain services get and list
+// resources with. It is the shared informer cache when it is enabled,
+// and the controller-runtime client otherwise.
+func (c *Client) Reader() runtimeclient.Reader {
+	if c.cache != nil {
+		return c.cache
+	}
+
+	return c.K8sClient.CtrlClient()
+}
+
+// Stop stops the informers of the cache, if it is enabled.
+func (c *Client) Stop() {
+	if c.cache != nil {
+		c.cache.Stop()
+	}
+}
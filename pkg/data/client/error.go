@@ -12,3 +12,12 @@ var invalidConfigError = &microerror.Error{
 func IsInvalidConfig(err error) bool {
 	return microerror.Cause(err) == invalidConfigError
 }
+
+var cacheNotSyncedError = &microerror.Error{
+	Kind: "cacheNotSyncedError",
+}
+
+// IsCacheNotSynced asserts cacheNotSyncedError.
+func IsCacheNotSynced(err error) bool {
+	return microerror.Cause(err) == cacheNotSyncedError
+}
@@ -19,12 +19,15 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	capav1alpha3 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
 	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
 	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
 	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	capiexpv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake" //nolint:staticcheck // v0.6.4 has a deprecation on pkg/client/fake that was removed in later versions
+
+	capov1alpha3 "github.com/giantswarm/kubectl-gs/internal/capo/api/v1alpha3"
 )
 
 type fakeK8sClient struct {
@@ -38,7 +41,9 @@ func NewFakeK8sClient() k8sclient.Interface {
 	{
 		schemeBuilder := runtime.SchemeBuilder(k8sclient.SchemeBuilder{
 			capiv1alpha3.AddToScheme,
+			capav1alpha3.AddToScheme,
 			capzv1alpha3.AddToScheme,
+			capov1alpha3.AddToScheme,
 			capiexpv1alpha3.AddToScheme,
 			capzexpv1alpha3.AddToScheme,
 			applicationv1alpha1.AddToScheme,
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListChunks lists the resources into the given list in chunks of at most
+// chunkSize items, like kubectl does, and calls the handler once the items
+// of every chunk are in the list. Each chunk replaces the items of the
+// previous one, so that large lists never have to be held in memory at
+// once. A chunk size of 0 lists all the resources in a single request.
+//
+// The errors of the API are returned without masking, so that
+// callers can check them by their type.
+func (c *Client) ListChunks(ctx context.Context, list runtime.Object, chunkSize int64, handler func() error, opts ...runtimeclient.ListOption) error {
+	return listChunks(ctx, c.Reader(), list, chunkSize, handler, opts...)
+}
+
+func listChunks(ctx context.Context, reader runtimeclient.Reader, list runtime.Object, chunkSize int64, handler func() error, opts ...runtimeclient.ListOption) error {
+	listAccessor, err := apimeta.ListAccessor(list)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	var continueToken string
+	for {
+		chunkOpts := append([]runtimeclient.ListOption{}, opts...)
+		if chunkSize > 0 {
+			chunkOpts = append(chunkOpts, runtimeclient.Limit(chunkSize))
+		}
+		if len(continueToken) > 0 {
+			chunkOpts = append(chunkOpts, runtimeclient.Continue(continueToken))
+		}
+
+		// The items and the continue token of the previous chunk are
+		// reset, since decoding keeps the fields a response omits.
+		err = apimeta.SetList(list, nil)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		listAccessor.SetContinue("")
+
+		err = reader.List(ctx, list, chunkOpts...)
+		if err != nil {
+			return err
+		}
+
+		err = handler()
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		continueToken = listAccessor.GetContinue()
+		if len(continueToken) < 1 {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Test_listChunks(t *testing.T) {
+	clusters := []string{"f930q", "a2wax", "s0m3x", "k2m5x", "z0n3p"}
+
+	testCases := []struct {
+		name           string
+		chunkSize      int64
+		expectedChunks [][]string
+	}{
+		{
+			name:           "case 0: list in a single request",
+			chunkSize:      0,
+			expectedChunks: [][]string{{"f930q", "a2wax", "s0m3x", "k2m5x", "z0n3p"}},
+		},
+		{
+			name:           "case 1: list in chunks of 2 clusters",
+			chunkSize:      2,
+			expectedChunks: [][]string{{"f930q", "a2wax"}, {"s0m3x", "k2m5x"}, {"z0n3p"}},
+		},
+		{
+			name:           "case 2: list in chunks the size of the list",
+			chunkSize:      5,
+			expectedChunks: [][]string{{"f930q", "a2wax", "s0m3x", "k2m5x", "z0n3p"}},
+		},
+		{
+			name:           "case 3: list in chunks larger than the list",
+			chunkSize:      500,
+			expectedChunks: [][]string{{"f930q", "a2wax", "s0m3x", "k2m5x", "z0n3p"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reader := &chunkReader{names: clusters}
+
+			var chunks [][]string
+			list := &capiv1alpha3.ClusterList{}
+			err := listChunks(context.Background(), reader, list, tc.chunkSize, func() error {
+				var names []string
+				for _, c := range list.Items {
+					names = append(names, c.Name)
+				}
+				chunks = append(chunks, names)
+
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			diff := cmp.Diff(tc.expectedChunks, chunks)
+			if diff != "" {
+				t.Fatalf("chunks not expected, got:\n %s", diff)
+			}
+		})
+	}
+}
+
+// chunkReader serves lists of clusters with the given names in
+// chunks, using the offset of the next chunk as continue token.
+type chunkReader struct {
+	names []string
+}
+
+func (r *chunkReader) Get(ctx context.Context, key runtimeclient.ObjectKey, obj runtime.Object) error {
+	return nil
+}
+
+func (r *chunkReader) List(ctx context.Context, list runtime.Object, opts ...runtimeclient.ListOption) error {
+	o := &runtimeclient.ListOptions{}
+	o.ApplyOptions(opts)
+
+	var start int
+	if len(o.Continue) > 0 {
+		var err error
+		start, err = strconv.Atoi(o.Continue)
+		if err != nil {
+			return err
+		}
+	}
+
+	end := len(r.names)
+	if o.Limit > 0 && start+int(o.Limit) < end {
+		end = start + int(o.Limit)
+	}
+
+	clusters := list.(*capiv1alpha3.ClusterList)
+	for _, name := range r.names[start:end] {
+		clusters.Items = append(clusters.Items, capiv1alpha3.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+		})
+	}
+	if end < len(r.names) {
+		clusters.Continue = strconv.Itoa(end)
+	}
+
+	return nil
+}
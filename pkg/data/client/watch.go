@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/giantswarm/microerror"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+)
+
+// WatchOptions are the parameters that the Watch method takes.
+type WatchOptions struct {
+	LabelSelector string
+	Namespace     string
+}
+
+// Watch starts watching all the given resources, and merges their events
+// into a single channel. Only changes happening after the watch has been
+// established are reported. The channel is closed once the context is
+// cancelled, or when all the underlying watches have ended.
+func (c *Client) Watch(ctx context.Context, options WatchOptions, resources ...schema.GroupVersionResource) (<-chan watch.Event, error) {
+	var watchers []watch.Interface
+	for _, resource := range resources {
+		resourceClient := c.K8sClient.DynClient().Resource(resource).Namespace(options.Namespace)
+
+		// Listing a single item is enough to find out the
+		// resource version that the watch must start from.
+		list, err := resourceClient.List(ctx, metav1.ListOptions{
+			LabelSelector: options.LabelSelector,
+			Limit:         1,
+		})
+		if err != nil {
+			stopWatchers(watchers)
+			return nil, microerror.Mask(err)
+		}
+
+		lw := &cache.ListWatch{
+			WatchFunc: func(o metav1.ListOptions) (watch.Interface, error) {
+				o.LabelSelector = options.LabelSelector
+
+				return resourceClient.Watch(ctx, o)
+			},
+		}
+
+		w, err := watchtools.NewRetryWatcher(list.GetResourceVersion(), lw)
+		if err != nil {
+			stopWatchers(watchers)
+			return nil, microerror.Mask(err)
+		}
+		watchers = append(watchers, w)
+	}
+
+	return mergeWatchers(ctx, watchers), nil
+}
+
+func mergeWatchers(ctx context.Context, watchers []watch.Interface) <-chan watch.Event {
+	events := make(chan watch.Event)
+
+	var wg sync.WaitGroup
+	for _, w := range watchers {
+		wg.Add(1)
+
+		go func(w watch.Interface) {
+			defer wg.Done()
+			defer w.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case e, ok := <-w.ResultChan():
+					if !ok {
+						return
+					}
+
+					select {
+					case events <- e:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}(w)
+	}
+
+	go func() {
+		wg.Wait()
+		close(events)
+	}()
+
+	return events
+}
+
+func stopWatchers(watchers []watch.Interface) {
+	for _, w := range watchers {
+		w.Stop()
+	}
+}
@@ -10,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/giantswarm/kubectl-gs/internal/key"
 	"github.com/giantswarm/kubectl-gs/pkg/data/client"
 )
 
@@ -39,13 +40,10 @@ func New(config Config) (Interface, error) {
 }
 
 // Get fetches a list of app CRs filtered by namespace and optionally by
-// name.
+// name, or by the status of their release.
 func (s *Service) Get(ctx context.Context, options GetOptions) (Resource, error) {
-	var resource Resource
-	var err error
-
 	if len(options.Name) > 0 {
-		resource, err = s.getByName(ctx, options.Namespace, options.Name)
+		resource, err := s.getByName(ctx, options.Namespace, options.Name)
 		if err != nil {
 			return nil, microerror.Mask(err)
 		}
@@ -53,45 +51,73 @@ func (s *Service) Get(ctx context.Context, options GetOptions) (Resource, error)
 		return resource, nil
 	}
 
-	resource, err = s.getAll(ctx, options.Namespace)
+	appCollection := &Collection{}
+	err := s.stream(ctx, options, func(chunk *Collection) error {
+		appCollection.Items = append(appCollection.Items, chunk.Items...)
+		return nil
+	})
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
 
-	return resource, nil
+	return appCollection, nil
 }
 
-func (s *Service) getAll(ctx context.Context, namespace string) (Resource, error) {
-	var err error
+// Stream fetches the same app CRs as Get, and calls the handler with
+// every chunk of them as soon as it has been listed.
+func (s *Service) Stream(ctx context.Context, options GetOptions, handler func(Resource) error) error {
+	if len(options.Name) > 0 {
+		resource, err := s.getByName(ctx, options.Namespace, options.Name)
+		if err != nil {
+			return microerror.Mask(err)
+		}
 
-	appCollection := &Collection{}
+		return handler(resource)
+	}
 
-	{
-		lo := &runtimeclient.ListOptions{
-			Namespace: namespace,
-		}
+	err := s.stream(ctx, options, func(chunk *Collection) error {
+		return handler(chunk)
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	}
 
-		apps := &applicationv1alpha1.AppList{}
-		{
-			err = s.client.K8sClient.CtrlClient().List(ctx, apps, lo)
-			if apimeta.IsNoMatchError(err) {
-				return nil, microerror.Mask(noMatchError)
-			} else if err != nil {
-				return nil, microerror.Mask(err)
-			} else if len(apps.Items) == 0 {
-				return nil, microerror.Mask(noResourcesError)
-			}
-		}
+	return nil
+}
 
+func (s *Service) stream(ctx context.Context, options GetOptions, handler func(*Collection) error) error {
+	var found bool
+
+	apps := &applicationv1alpha1.AppList{}
+	err := s.client.ListChunks(ctx, apps, options.ChunkSize, func() error {
+		appCollection := &Collection{}
 		for _, app := range apps.Items {
 			a := App{
-				CR: omitManagedFields(app.DeepCopy()),
+				CreatedBy: key.CreatedBy(&app),
+				CR:        omitManagedFields(app.DeepCopy()),
+			}
+			if options.Failing && !IsFailing(a) {
+				continue
 			}
 			appCollection.Items = append(appCollection.Items, a)
 		}
+
+		if len(appCollection.Items) == 0 {
+			return nil
+		}
+		found = true
+
+		return handler(appCollection)
+	}, runtimeclient.InNamespace(options.Namespace))
+	if apimeta.IsNoMatchError(err) {
+		return microerror.Mask(noMatchError)
+	} else if err != nil {
+		return microerror.Mask(err)
+	} else if !found {
+		return microerror.Mask(noResourcesError)
 	}
 
-	return appCollection, nil
+	return nil
 }
 
 func (s *Service) getByName(ctx context.Context, namespace, name string) (Resource, error) {
@@ -100,7 +126,7 @@ func (s *Service) getByName(ctx context.Context, namespace, name string) (Resour
 	app := &App{}
 	{
 		appCR := &applicationv1alpha1.App{}
-		err = s.client.K8sClient.CtrlClient().Get(ctx, runtimeclient.ObjectKey{
+		err = s.client.Reader().Get(ctx, runtimeclient.ObjectKey{
 			Namespace: namespace,
 			Name:      name,
 		}, appCR)
@@ -112,6 +138,7 @@ func (s *Service) getByName(ctx context.Context, namespace, name string) (Resour
 			return nil, microerror.Mask(err)
 		}
 
+		app.CreatedBy = key.CreatedBy(appCR)
 		app.CR = omitManagedFields(appCR)
 		app.CR.TypeMeta = metav1.TypeMeta{
 			APIVersion: "app.application.giantswarm.io/v1alpha1",
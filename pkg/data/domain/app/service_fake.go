@@ -0,0 +1,109 @@
+package app
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger/microloggertest"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
+)
+
+var _ Interface = &FakeService{}
+
+type FakeService struct {
+	service *Service
+	storage []runtime.Object
+}
+
+func NewFakeService(storage []runtime.Object) *FakeService {
+	clientConfig := client.Config{
+		Logger: microloggertest.New(),
+	}
+	fakeClient, _ := client.NewFakeClient(clientConfig)
+
+	underlyingService := &Service{
+		client: fakeClient,
+	}
+
+	ms := &FakeService{
+		service: underlyingService,
+		storage: storage,
+	}
+
+	return ms
+}
+
+func (ms *FakeService) Get(ctx context.Context, options GetOptions) (Resource, error) {
+	var err error
+	for _, res := range ms.storage {
+		err = ms.service.client.K8sClient.CtrlClient().Create(ctx, res.DeepCopyObject())
+		if apierrors.IsAlreadyExists(err) {
+			// Fall through.
+		} else if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	result, err := ms.service.Get(ctx, options)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return result, nil
+}
+
+func (ms *FakeService) Stream(ctx context.Context, options GetOptions, handler func(Resource) error) error {
+	var err error
+	for _, res := range ms.storage {
+		err = ms.service.client.K8sClient.CtrlClient().Create(ctx, res.DeepCopyObject())
+		if apierrors.IsAlreadyExists(err) {
+			// Fall through.
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	err = ms.service.Stream(ctx, options, handler)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// Watch reports every object in the storage as a newly added one.
+func (ms *FakeService) Watch(ctx context.Context, options GetOptions, handler func(Event) error) error {
+	events := make(chan watch.Event, len(ms.storage))
+	for _, res := range ms.storage {
+		err := ms.service.client.K8sClient.CtrlClient().Create(ctx, res.DeepCopyObject())
+		if apierrors.IsAlreadyExists(err) {
+			// Fall through.
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+
+		// The real watch reports unstructured objects.
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(res)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		events <- watch.Event{
+			Type:   watch.Added,
+			Object: &unstructured.Unstructured{Object: content},
+		}
+	}
+	close(events)
+
+	err := ms.service.watch(options, events, handler)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
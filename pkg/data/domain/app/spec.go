@@ -6,12 +6,16 @@ import (
 	applicationv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/application/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 )
 
 // App abstracts away the custom resource so it can be returned as a runtime
 // object or a typed custom resource.
 type App struct {
 	CR *applicationv1alpha1.App
+	// CreatedBy is who created the app, taken before the managed fields
+	// are removed from the CR.
+	CreatedBy string
 }
 
 // Collection wraps a list of apps.
@@ -19,8 +23,20 @@ type Collection struct {
 	Items []App
 }
 
+// Event represents a change of an app.
+type Event struct {
+	Type     watch.EventType
+	Resource Resource
+}
+
 // GetOptions are the parameters that the Get method takes.
 type GetOptions struct {
+	// ChunkSize is the maximum number of apps listed per request,
+	// or 0 to list all of them at once.
+	ChunkSize int64
+	// Failing limits the result to apps whose
+	// release is not in the deployed status.
+	Failing       bool
 	LabelSelector string
 	Name          string
 	Namespace     string
@@ -35,6 +51,8 @@ type Resource interface {
 // service in tests much simpler.
 type Interface interface {
 	Get(context.Context, GetOptions) (Resource, error)
+	Stream(context.Context, GetOptions, func(Resource) error) error
+	Watch(context.Context, GetOptions, func(Event) error) error
 }
 
 func (a *App) Object() runtime.Object {
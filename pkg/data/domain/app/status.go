@@ -0,0 +1,42 @@
+package app
+
+const (
+	// ReleaseStatusDeployed is the release status of an
+	// app that has been successfully deployed.
+	ReleaseStatusDeployed = "deployed"
+
+	// DriftVersion means that the deployed version of
+	// an app differs from the one in its spec.
+	DriftVersion = "version"
+	// DriftStatus means that the release of an app
+	// is not in the deployed status.
+	DriftStatus = "status"
+)
+
+// IsFailing returns true if the release of the
+// given app is not in the deployed status.
+func IsFailing(a App) bool {
+	if a.CR == nil {
+		return false
+	}
+
+	return a.CR.Status.Release.Status != ReleaseStatusDeployed
+}
+
+// Drift returns the reasons why the deployed state of the given app
+// differs from its desired state. It is empty if there is no drift.
+func Drift(a App) []string {
+	if a.CR == nil {
+		return nil
+	}
+
+	var reasons []string
+	if a.CR.Spec.Version != a.CR.Status.Version {
+		reasons = append(reasons, DriftVersion)
+	}
+	if IsFailing(a) {
+		reasons = append(reasons, DriftStatus)
+	}
+
+	return reasons
+}
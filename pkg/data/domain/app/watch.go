@@ -0,0 +1,111 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	applicationv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/application/v1alpha1"
+	"github.com/giantswarm/microerror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
+)
+
+var appResource = schema.GroupVersionResource{
+	Group:    "application.giantswarm.io",
+	Version:  "v1alpha1",
+	Resource: "apps",
+}
+
+// Watch calls the given handler every time an app CR changes. It blocks
+// until the context is cancelled, the watch ends, or the handler returns
+// an error. When only failing apps are watched, apps that recover are
+// reported as deleted.
+func (s *Service) Watch(ctx context.Context, options GetOptions, handler func(Event) error) error {
+	watchOptions := client.WatchOptions{
+		LabelSelector: options.LabelSelector,
+		Namespace:     options.Namespace,
+	}
+
+	events, err := s.client.Watch(ctx, watchOptions, appResource)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = s.watch(options, events, handler)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (s *Service) watch(options GetOptions, events <-chan watch.Event, handler func(Event) error) error {
+	var err error
+
+	// failing holds the keys of the failing apps
+	// reported so far, when watching failing apps.
+	failing := map[string]bool{}
+
+	for e := range events {
+		if e.Type == watch.Error {
+			return microerror.Mask(apierrors.FromObject(e.Object))
+		}
+
+		u, ok := e.Object.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		if len(options.Name) > 0 && u.GetName() != options.Name {
+			continue
+		}
+
+		appCR := &applicationv1alpha1.App{}
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), appCR)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		a := &App{
+			CreatedBy: key.CreatedBy(appCR),
+			CR:        omitManagedFields(appCR),
+		}
+		event := Event{
+			Type:     e.Type,
+			Resource: a,
+		}
+
+		if options.Failing {
+			k := fmt.Sprintf("%s/%s", appCR.Namespace, appCR.Name)
+			switch {
+			case e.Type == watch.Deleted:
+				if !failing[k] {
+					continue
+				}
+				delete(failing, k)
+			case IsFailing(*a):
+				if !failing[k] {
+					event.Type = watch.Added
+				}
+				failing[k] = true
+			case failing[k]:
+				delete(failing, k)
+				event.Type = watch.Deleted
+			default:
+				continue
+			}
+		}
+
+		err = handler(event)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	return nil
+}
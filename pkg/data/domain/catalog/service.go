@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/giantswarm/kubectl-gs/internal/key"
 	"github.com/giantswarm/kubectl-gs/pkg/data/client"
 )
 
@@ -41,11 +42,8 @@ func New(config Config) (Interface, error) {
 
 // Get fetches a list of catalog CRs optionally filtered by name.
 func (s *Service) Get(ctx context.Context, options GetOptions) (Resource, error) {
-	var resource Resource
-	var err error
-
 	if len(options.Name) > 0 {
-		resource, err = s.getByName(ctx, options.Namespace, options.Name, options.LabelSelector)
+		resource, err := s.getByName(ctx, options.Namespace, options.Name, options.LabelSelector)
 		if err != nil {
 			return nil, microerror.Mask(err)
 		}
@@ -53,31 +51,50 @@ func (s *Service) Get(ctx context.Context, options GetOptions) (Resource, error)
 		return resource, nil
 	}
 
-	resource, err = s.getAll(ctx, options)
+	catalogCollection := &Collection{}
+	err := s.stream(ctx, options, func(chunk *Collection) error {
+		catalogCollection.Items = append(catalogCollection.Items, chunk.Items...)
+		return nil
+	})
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
 
-	return resource, nil
+	return catalogCollection, nil
 }
 
-func (s *Service) getAll(ctx context.Context, options GetOptions) (Resource, error) {
-	catalogCollection := &Collection{}
-	var err error
+// Stream fetches the same catalog CRs as Get, and calls the handler
+// with every chunk of them as soon as it has been listed.
+func (s *Service) Stream(ctx context.Context, options GetOptions, handler func(Resource) error) error {
+	if len(options.Name) > 0 {
+		resource, err := s.getByName(ctx, options.Namespace, options.Name, options.LabelSelector)
+		if err != nil {
+			return microerror.Mask(err)
+		}
 
-	{
-		catalogs := &applicationv1alpha1.CatalogList{}
-		{
-			err = s.client.K8sClient.CtrlClient().List(ctx, catalogs, runtimeclient.InNamespace(options.Namespace))
-			if apimeta.IsNoMatchError(err) {
-				return nil, microerror.Mask(noMatchError)
-			} else if err != nil {
-				return nil, microerror.Mask(err)
-			} else if len(catalogs.Items) == 0 {
-				return nil, microerror.Mask(noResourcesError)
-			}
+		return handler(resource)
+	}
+
+	err := s.stream(ctx, options, func(chunk *Collection) error {
+		return handler(chunk)
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (s *Service) stream(ctx context.Context, options GetOptions, handler func(*Collection) error) error {
+	var found bool
+
+	catalogs := &applicationv1alpha1.CatalogList{}
+	err := s.client.ListChunks(ctx, catalogs, options.ChunkSize, func() error {
+		if len(catalogs.Items) > 0 {
+			found = true
 		}
 
+		catalogCollection := &Collection{}
 		for _, catalog := range catalogs.Items {
 			// We hide catalog CRs from the giantswarm namespace by
 			// default as these are internal.
@@ -87,13 +104,27 @@ func (s *Service) getAll(ctx context.Context, options GetOptions) (Resource, err
 			}
 
 			a := Catalog{
-				CR: omitManagedFields(catalog.DeepCopy()),
+				CreatedBy: key.CreatedBy(&catalog),
+				CR:        omitManagedFields(catalog.DeepCopy()),
 			}
 			catalogCollection.Items = append(catalogCollection.Items, a)
 		}
+
+		if len(catalogCollection.Items) == 0 {
+			return nil
+		}
+
+		return handler(catalogCollection)
+	}, runtimeclient.InNamespace(options.Namespace))
+	if apimeta.IsNoMatchError(err) {
+		return microerror.Mask(noMatchError)
+	} else if err != nil {
+		return microerror.Mask(err)
+	} else if !found {
+		return microerror.Mask(noResourcesError)
 	}
 
-	return catalogCollection, nil
+	return nil
 }
 
 func (s *Service) getByName(ctx context.Context, namespace, name string, labelSelector labels.Selector) (Resource, error) {
@@ -130,8 +161,9 @@ func (s *Service) getByName(ctx context.Context, namespace, name string, labelSe
 	}
 
 	catalog := &Catalog{
-		CR:      omitManagedFields(catalogCR.DeepCopy()),
-		Entries: entries,
+		CR:        omitManagedFields(catalogCR.DeepCopy()),
+		Entries:   entries,
+		CreatedBy: key.CreatedBy(catalogCR),
 	}
 	catalog.CR.TypeMeta = metav1.TypeMeta{
 		APIVersion: "catalog.application.giantswarm.io/v1alpha1",
@@ -14,6 +14,9 @@ import (
 type Catalog struct {
 	CR      *applicationv1alpha1.Catalog
 	Entries *applicationv1alpha1.AppCatalogEntryList
+	// CreatedBy is who created the catalog, taken before the managed
+	// fields are removed from the CR.
+	CreatedBy string
 }
 
 // Collection wraps a list of catalogs.
@@ -24,6 +27,9 @@ type Collection struct {
 // GetOptions are the parameters that the Get method takes.
 type GetOptions struct {
 	AllNamespaces bool
+	// ChunkSize is the maximum number of catalogs listed
+	// per request, or 0 to list all of them at once.
+	ChunkSize     int64
 	LabelSelector labels.Selector
 	Name          string
 	Namespace     string
@@ -38,6 +44,7 @@ type Resource interface {
 // service in tests much simpler.
 type Interface interface {
 	Get(context.Context, GetOptions) (Resource, error)
+	Stream(context.Context, GetOptions, func(Resource) error) error
 }
 
 func (a *Catalog) Object() runtime.Object {
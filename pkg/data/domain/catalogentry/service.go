@@ -0,0 +1,154 @@
+package catalogentry
+
+import (
+	"context"
+	"sort"
+
+	"github.com/blang/semver/v4"
+	applicationv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/application/v1alpha1"
+	"github.com/giantswarm/apiextensions/v3/pkg/label"
+	"github.com/giantswarm/microerror"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
+)
+
+const (
+	// latestLabel marks the most recent entry of an app in a catalog.
+	latestLabel = "latest"
+)
+
+var _ Interface = &Service{}
+
+// Config represent the input parameters that New takes to produce a valid catalog entry getter Service.
+type Config struct {
+	Client *client.Client
+}
+
+// Service is the object we'll hang the catalog entry getter methods on.
+type Service struct {
+	client *client.Client
+}
+
+// New returns a new catalog entry getter Service.
+func New(config Config) (Interface, error) {
+	if config.Client == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Client must not be empty", config)
+	}
+
+	s := &Service{
+		client: config.Client,
+	}
+
+	return s, nil
+}
+
+// Get fetches a list of app catalog entry CRs across catalogs, optionally
+// filtered by app, catalog and version. The entries are sorted by catalog,
+// app and version.
+func (s *Service) Get(ctx context.Context, options GetOptions) (Resource, error) {
+	var err error
+
+	entries := &applicationv1alpha1.AppCatalogEntryList{}
+	{
+		lo := &runtimeclient.ListOptions{
+			LabelSelector: listSelector(options),
+			Namespace:     options.Namespace,
+		}
+		err = s.client.K8sClient.CtrlClient().List(ctx, entries, lo)
+		if apimeta.IsNoMatchError(err) {
+			return nil, microerror.Mask(noMatchError)
+		} else if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	collection := &Collection{}
+	for _, entry := range entries.Items {
+		if !matchesVersion(entry, options.VersionConstraint) {
+			continue
+		}
+
+		e := CatalogEntry{
+			CR: omitManagedFields(entry.DeepCopy()),
+		}
+		e.CR.TypeMeta = metav1.TypeMeta{
+			APIVersion: "application.giantswarm.io/v1alpha1",
+			Kind:       "AppCatalogEntry",
+		}
+		collection.Items = append(collection.Items, e)
+	}
+
+	if len(collection.Items) == 0 {
+		return nil, microerror.Mask(noResourcesError)
+	}
+
+	sort.SliceStable(collection.Items, func(i, j int) bool {
+		return isLess(collection.Items[i].CR, collection.Items[j].CR)
+	})
+
+	return collection, nil
+}
+
+// IsLatest returns true if the given entry is the
+// most recent one of its app in its catalog.
+func IsLatest(entry *applicationv1alpha1.AppCatalogEntry) bool {
+	return entry.Labels[latestLabel] == "true"
+}
+
+func listSelector(options GetOptions) labels.Selector {
+	set := labels.Set{}
+	if len(options.AppName) > 0 {
+		set[label.AppKubernetesName] = options.AppName
+	}
+	if len(options.Catalog) > 0 {
+		set[label.CatalogName] = options.Catalog
+	}
+	if options.Latest {
+		set[latestLabel] = "true"
+	}
+
+	return labels.SelectorFromSet(set)
+}
+
+func matchesVersion(entry applicationv1alpha1.AppCatalogEntry, constraint semver.Range) bool {
+	if constraint == nil {
+		return true
+	}
+
+	version, err := semver.ParseTolerant(entry.Spec.Version)
+	if err != nil {
+		return false
+	}
+
+	return constraint(version)
+}
+
+// isLess sorts entries by catalog and app name, and then by version,
+// falling back to a string comparison for invalid versions.
+func isLess(a, b *applicationv1alpha1.AppCatalogEntry) bool {
+	if a.Spec.Catalog.Name != b.Spec.Catalog.Name {
+		return a.Spec.Catalog.Name < b.Spec.Catalog.Name
+	}
+	if a.Spec.AppName != b.Spec.AppName {
+		return a.Spec.AppName < b.Spec.AppName
+	}
+
+	aVersion, aErr := semver.ParseTolerant(a.Spec.Version)
+	bVersion, bErr := semver.ParseTolerant(b.Spec.Version)
+	if aErr != nil || bErr != nil {
+		return a.Spec.Version < b.Spec.Version
+	}
+
+	return aVersion.LT(bVersion)
+}
+
+// omitManagedFields removes managed fields to make YAML output easier to read.
+// With Kubernetes 1.21 we can use OmitManagedFieldsPrinter and remove this.
+func omitManagedFields(entry *applicationv1alpha1.AppCatalogEntry) *applicationv1alpha1.AppCatalogEntry {
+	entry.ManagedFields = nil
+	return entry
+}
@@ -0,0 +1,78 @@
+package catalogentry
+
+import (
+	"context"
+
+	"github.com/blang/semver/v4"
+	applicationv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/application/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// CatalogEntry abstracts away the custom resource so it can be returned as a
+// runtime object or a typed custom resource.
+type CatalogEntry struct {
+	CR *applicationv1alpha1.AppCatalogEntry
+}
+
+// Collection wraps a list of catalog entries.
+type Collection struct {
+	Items []CatalogEntry
+}
+
+// GetOptions are the parameters that the Get method takes.
+type GetOptions struct {
+	// AppName limits the result to the entries of the app with this name.
+	AppName string
+	// Catalog limits the result to the entries of the catalog with this name.
+	Catalog string
+	// Latest limits the result to the latest entry of each app.
+	Latest    bool
+	Namespace string
+	// VersionConstraint limits the result to the entries whose
+	// version is in the given range, e.g. '>=1.2.0 <2.0.0'.
+	VersionConstraint semver.Range
+}
+
+type Resource interface {
+	Object() runtime.Object
+}
+
+// Interface represents the contract for the catalog entry data service.
+// Using this instead of a regular 'struct' makes mocking the
+// service in tests much simpler.
+type Interface interface {
+	Get(context.Context, GetOptions) (Resource, error)
+}
+
+func (e *CatalogEntry) Object() runtime.Object {
+	if e.CR != nil {
+		return e.CR
+	}
+
+	return nil
+}
+
+func (cc *Collection) Object() runtime.Object {
+	list := &metav1.List{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "List",
+			APIVersion: "v1",
+		},
+		ListMeta: metav1.ListMeta{},
+	}
+
+	for _, item := range cc.Items {
+		obj := item.Object()
+		if obj == nil {
+			continue
+		}
+
+		raw := runtime.RawExtension{
+			Object: obj,
+		}
+		list.Items = append(list.Items, raw)
+	}
+
+	return list
+}
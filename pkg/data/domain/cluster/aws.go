@@ -5,109 +5,129 @@ import (
 
 	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
 	"github.com/giantswarm/microerror"
+	"golang.org/x/sync/errgroup"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func (s *Service) getAllAWS(ctx context.Context, namespace string) (Resource, error) {
-	var err error
-
+func (s *Service) streamAllAWS(ctx context.Context, namespace string, selector labels.Selector, chunkSize int64, handler func(*Collection) error) error {
 	inNamespace := runtimeClient.InNamespace(namespace)
 
-	var awsClusters map[string]*infrastructurev1alpha3.AWSCluster
-	{
-		clusterCollection := &infrastructurev1alpha3.AWSClusterList{}
-		err = s.client.K8sClient.CtrlClient().List(ctx, clusterCollection, inNamespace)
+	// Both resources are listed concurrently, since neither of the
+	// lists depends on the other. The chunks of clusters can only be
+	// joined once all the AWSClusters have been listed, though.
+	awsClusters := map[string]*infrastructurev1alpha3.AWSCluster{}
+	awsClustersListed := make(chan struct{})
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		awsClusterList := &infrastructurev1alpha3.AWSClusterList{}
+		err := s.client.ListChunks(gctx, awsClusterList, chunkSize, func() error {
+			for _, cluster := range awsClusterList.Items {
+				c := cluster
+				awsClusters[cluster.GetName()] = &c
+			}
+
+			return nil
+		}, inNamespace)
 		if err != nil {
-			return nil, microerror.Mask(err)
-		} else if len(clusterCollection.Items) == 0 {
-			return nil, microerror.Mask(noResourcesError)
+			return microerror.Mask(err)
 		}
+		close(awsClustersListed)
+
+		return nil
+	})
+	g.Go(func() error {
+		clusters := &capiv1alpha3.ClusterList{}
+		err := s.client.ListChunks(gctx, clusters, chunkSize, func() error {
+			select {
+			case <-awsClustersListed:
+			case <-gctx.Done():
+				return microerror.Mask(gctx.Err())
+			}
 
-		awsClusters = make(map[string]*infrastructurev1alpha3.AWSCluster, len(clusterCollection.Items))
-		for _, cluster := range clusterCollection.Items {
-			c := cluster
-			awsClusters[cluster.GetName()] = &c
-		}
-	}
+			clusterCollection := &Collection{}
+			for _, cr := range clusters.Items {
+				o := cr
+
+				if awsCluster, exists := awsClusters[cr.GetName()]; exists {
+					o.TypeMeta = metav1.TypeMeta{
+						APIVersion: "cluster.x-k8s.io/v1alpha3",
+						Kind:       "Cluster",
+					}
+					awsCluster.TypeMeta = infrastructurev1alpha3.NewAWSClusterTypeMeta()
+
+					c := Cluster{
+						Cluster:    &o,
+						AWSCluster: awsCluster,
+					}
+					clusterCollection.Items = append(clusterCollection.Items, c)
+				}
+			}
 
-	clusters := &capiv1alpha3.ClusterList{}
-	{
-		err = s.client.K8sClient.CtrlClient().List(ctx, clusters, inNamespace)
+			return handler(clusterCollection)
+		}, inNamespace, runtimeClient.MatchingLabelsSelector{Selector: selector})
 		if err != nil {
-			return nil, microerror.Mask(err)
-		} else if len(clusters.Items) == 0 {
-			return nil, microerror.Mask(noResourcesError)
+			return microerror.Mask(err)
 		}
-	}
 
-	clusterCollection := &Collection{}
-	{
-		for _, cr := range clusters.Items {
-			o := cr
+		return nil
+	})
 
-			if awsCluster, exists := awsClusters[cr.GetName()]; exists {
-				cr.TypeMeta = metav1.TypeMeta{
-					APIVersion: "cluster.x-k8s.io/v1alpha3",
-					Kind:       "Cluster",
-				}
-				awsCluster.TypeMeta = infrastructurev1alpha3.NewAWSClusterTypeMeta()
-
-				c := Cluster{
-					Cluster:    &o,
-					AWSCluster: awsCluster,
-				}
-				clusterCollection.Items = append(clusterCollection.Items, c)
-			}
-		}
+	err := g.Wait()
+	if err != nil {
+		return microerror.Mask(err)
 	}
 
-	return clusterCollection, nil
+	return nil
 }
 
 func (s *Service) getByNameAWS(ctx context.Context, name, namespace string) (Resource, error) {
-	var err error
-
 	labelSelector := runtimeClient.MatchingLabels{
 		capiv1alpha3.ClusterLabelName: name,
 	}
 	inNamespace := runtimeClient.InNamespace(namespace)
 
-	cluster := &Cluster{}
-
+	clusters := &capiv1alpha3.ClusterList{}
+	awsClusters := &infrastructurev1alpha3.AWSClusterList{}
 	{
-		crs := &capiv1alpha3.ClusterList{}
-		err = s.client.K8sClient.CtrlClient().List(ctx, crs, labelSelector, inNamespace)
-		if err != nil {
-			return nil, microerror.Mask(err)
-		}
+		g, gctx := errgroup.WithContext(ctx)
+		g.Go(func() error {
+			err := s.client.Reader().List(gctx, clusters, labelSelector, inNamespace)
+			if err != nil {
+				return microerror.Mask(err)
+			}
 
-		if len(crs.Items) < 1 {
-			return nil, microerror.Mask(notFoundError)
-		}
-		cluster.Cluster = &crs.Items[0]
+			return nil
+		})
+		g.Go(func() error {
+			err := s.client.Reader().List(gctx, awsClusters, labelSelector, inNamespace)
+			if err != nil {
+				return microerror.Mask(err)
+			}
 
-		cluster.Cluster.TypeMeta = metav1.TypeMeta{
-			APIVersion: "cluster.x-k8s.io/v1alpha3",
-			Kind:       "Cluster",
-		}
-	}
+			return nil
+		})
 
-	{
-		crs := &infrastructurev1alpha3.AWSClusterList{}
-		err = s.client.K8sClient.CtrlClient().List(ctx, crs, labelSelector, inNamespace)
+		err := g.Wait()
 		if err != nil {
 			return nil, microerror.Mask(err)
-		}
-
-		if len(crs.Items) < 1 {
+		} else if len(clusters.Items) < 1 || len(awsClusters.Items) < 1 {
 			return nil, microerror.Mask(notFoundError)
 		}
-		cluster.AWSCluster = &crs.Items[0]
+	}
 
-		cluster.AWSCluster.TypeMeta = infrastructurev1alpha3.NewAWSClusterTypeMeta()
+	cluster := &Cluster{
+		Cluster:    &clusters.Items[0],
+		AWSCluster: &awsClusters.Items[0],
+	}
+	cluster.Cluster.TypeMeta = metav1.TypeMeta{
+		APIVersion: "cluster.x-k8s.io/v1alpha3",
+		Kind:       "Cluster",
 	}
+	cluster.AWSCluster.TypeMeta = infrastructurev1alpha3.NewAWSClusterTypeMeta()
 
 	return cluster, nil
 }
@@ -4,115 +4,135 @@ import (
 	"context"
 
 	"github.com/giantswarm/microerror"
+	"golang.org/x/sync/errgroup"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
 	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func (s *Service) getAllAzure(ctx context.Context, namespace string) (Resource, error) {
-	var err error
-
+func (s *Service) streamAllAzure(ctx context.Context, namespace string, selector labels.Selector, chunkSize int64, handler func(*Collection) error) error {
 	inNamespace := runtimeClient.InNamespace(namespace)
 
-	var azureClusters map[string]*capzv1alpha3.AzureCluster
-	{
-		clusterCollection := &capzv1alpha3.AzureClusterList{}
-		err = s.client.K8sClient.CtrlClient().List(ctx, clusterCollection, inNamespace)
+	// Both resources are listed concurrently, since neither of the
+	// lists depends on the other. The chunks of clusters can only be
+	// joined once all the AzureClusters have been listed, though.
+	azureClusters := map[string]*capzv1alpha3.AzureCluster{}
+	azureClustersListed := make(chan struct{})
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		azureClusterList := &capzv1alpha3.AzureClusterList{}
+		err := s.client.ListChunks(gctx, azureClusterList, chunkSize, func() error {
+			for _, cluster := range azureClusterList.Items {
+				c := cluster
+				azureClusters[cluster.GetName()] = &c
+			}
+
+			return nil
+		}, inNamespace)
 		if err != nil {
-			return nil, microerror.Mask(err)
-		} else if len(clusterCollection.Items) == 0 {
-			return nil, microerror.Mask(noResourcesError)
+			return microerror.Mask(err)
 		}
+		close(azureClustersListed)
+
+		return nil
+	})
+	g.Go(func() error {
+		clusters := &capiv1alpha3.ClusterList{}
+		err := s.client.ListChunks(gctx, clusters, chunkSize, func() error {
+			select {
+			case <-azureClustersListed:
+			case <-gctx.Done():
+				return microerror.Mask(gctx.Err())
+			}
 
-		azureClusters = make(map[string]*capzv1alpha3.AzureCluster, len(clusterCollection.Items))
-		for _, cluster := range clusterCollection.Items {
-			c := cluster
-			azureClusters[cluster.GetName()] = &c
-		}
-	}
+			clusterCollection := &Collection{}
+			for _, cr := range clusters.Items {
+				o := cr
+
+				if azureCluster, exists := azureClusters[cr.GetName()]; exists {
+					o.TypeMeta = metav1.TypeMeta{
+						APIVersion: "cluster.x-k8s.io/v1alpha3",
+						Kind:       "Cluster",
+					}
+					azureCluster.TypeMeta = metav1.TypeMeta{
+						APIVersion: "infrastructure.cluster.x-k8s.io/v1alpha3",
+						Kind:       "AzureCluster",
+					}
+
+					c := Cluster{
+						Cluster:      &o,
+						AzureCluster: azureCluster,
+					}
+					clusterCollection.Items = append(clusterCollection.Items, c)
+				}
+			}
 
-	clusters := &capiv1alpha3.ClusterList{}
-	{
-		err = s.client.K8sClient.CtrlClient().List(ctx, clusters, inNamespace)
+			return handler(clusterCollection)
+		}, inNamespace, runtimeClient.MatchingLabelsSelector{Selector: selector})
 		if err != nil {
-			return nil, microerror.Mask(err)
-		} else if len(clusters.Items) == 0 {
-			return nil, microerror.Mask(noResourcesError)
+			return microerror.Mask(err)
 		}
-	}
-
-	clusterCollection := &Collection{}
-	{
-		for _, cr := range clusters.Items {
-			o := cr
 
-			if azureCluster, exists := azureClusters[cr.GetName()]; exists {
-				cr.TypeMeta = metav1.TypeMeta{
-					APIVersion: "cluster.x-k8s.io/v1alpha3",
-					Kind:       "Cluster",
-				}
-				azureCluster.TypeMeta = metav1.TypeMeta{
-					APIVersion: "infrastructure.cluster.x-k8s.io/v1alpha3",
-					Kind:       "AzureCluster",
-				}
+		return nil
+	})
 
-				c := Cluster{
-					Cluster:      &o,
-					AzureCluster: azureCluster,
-				}
-				clusterCollection.Items = append(clusterCollection.Items, c)
-			}
-		}
+	err := g.Wait()
+	if err != nil {
+		return microerror.Mask(err)
 	}
 
-	return clusterCollection, nil
+	return nil
 }
 
 func (s *Service) getByNameAzure(ctx context.Context, name, namespace string) (Resource, error) {
-	var err error
-
 	labelSelector := runtimeClient.MatchingLabels{
 		capiv1alpha3.ClusterLabelName: name,
 	}
 	inNamespace := runtimeClient.InNamespace(namespace)
 
-	cluster := &Cluster{}
-
+	clusters := &capiv1alpha3.ClusterList{}
+	azureClusters := &capzv1alpha3.AzureClusterList{}
 	{
-		crs := &capiv1alpha3.ClusterList{}
-		err = s.client.K8sClient.CtrlClient().List(ctx, crs, labelSelector, inNamespace)
-		if err != nil {
-			return nil, microerror.Mask(err)
-		}
+		g, gctx := errgroup.WithContext(ctx)
+		g.Go(func() error {
+			err := s.client.Reader().List(gctx, clusters, labelSelector, inNamespace)
+			if err != nil {
+				return microerror.Mask(err)
+			}
 
-		if len(crs.Items) < 1 {
-			return nil, microerror.Mask(notFoundError)
-		}
-		cluster.Cluster = &crs.Items[0]
+			return nil
+		})
+		g.Go(func() error {
+			err := s.client.Reader().List(gctx, azureClusters, labelSelector, inNamespace)
+			if err != nil {
+				return microerror.Mask(err)
+			}
 
-		cluster.Cluster.TypeMeta = metav1.TypeMeta{
-			APIVersion: "cluster.x-k8s.io/v1alpha3",
-			Kind:       "Cluster",
-		}
-	}
+			return nil
+		})
 
-	{
-		crs := &capzv1alpha3.AzureClusterList{}
-		err = s.client.K8sClient.CtrlClient().List(ctx, crs, labelSelector, inNamespace)
+		err := g.Wait()
 		if err != nil {
 			return nil, microerror.Mask(err)
-		}
-
-		if len(crs.Items) < 1 {
+		} else if len(clusters.Items) < 1 || len(azureClusters.Items) < 1 {
 			return nil, microerror.Mask(notFoundError)
 		}
-		cluster.AzureCluster = &crs.Items[0]
+	}
 
-		cluster.AzureCluster.TypeMeta = metav1.TypeMeta{
-			APIVersion: "infrastructure.cluster.x-k8s.io/v1alpha3",
-			Kind:       "AzureCluster",
-		}
+	cluster := &Cluster{
+		Cluster:      &clusters.Items[0],
+		AzureCluster: &azureClusters.Items[0],
+	}
+	cluster.Cluster.TypeMeta = metav1.TypeMeta{
+		APIVersion: "cluster.x-k8s.io/v1alpha3",
+		Kind:       "Cluster",
+	}
+	cluster.AzureCluster.TypeMeta = metav1.TypeMeta{
+		APIVersion: "infrastructure.cluster.x-k8s.io/v1alpha3",
+		Kind:       "AzureCluster",
 	}
 
 	return cluster, nil
@@ -0,0 +1,45 @@
+package cluster
+
+import (
+	"github.com/giantswarm/kubectl-gs/internal/key"
+)
+
+// filterCollection removes all the clusters that don't match the given
+// options.
+func filterCollection(collection *Collection, options GetOptions) *Collection {
+	filtered := &Collection{}
+	for _, c := range collection.Items {
+		if matches(c, options) {
+			filtered.Items = append(filtered.Items, c)
+		}
+	}
+
+	return filtered
+}
+
+func matches(c Cluster, options GetOptions) bool {
+	if c.Cluster == nil {
+		return false
+	}
+
+	return options.Matches(c.Cluster.GetLabels(), key.ReleaseVersion(c.Cluster), latestCondition(c))
+}
+
+// latestCondition returns the latest condition reported for a
+// cluster, as found in the provider-specific resources.
+func latestCondition(c Cluster) string {
+	switch {
+	case c.AWSCluster != nil:
+		conditions := c.AWSCluster.Status.Cluster.Conditions
+		if len(conditions) > 0 {
+			return conditions[0].Condition
+		}
+	case c.AzureCluster != nil, c.OpenStackCluster != nil:
+		conditions := c.Cluster.GetConditions()
+		if len(conditions) > 0 {
+			return string(conditions[0].Type)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,140 @@
+package cluster
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+	"golang.org/x/sync/errgroup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	capov1alpha3 "github.com/giantswarm/kubectl-gs/internal/capo/api/v1alpha3"
+)
+
+func (s *Service) streamAllOpenStack(ctx context.Context, namespace string, selector labels.Selector, chunkSize int64, handler func(*Collection) error) error {
+	inNamespace := runtimeClient.InNamespace(namespace)
+
+	// Both resources are listed concurrently, since neither of the
+	// lists depends on the other. The chunks of clusters can only be
+	// joined once all the OpenStackClusters have been listed, though.
+	openStackClusters := map[string]*capov1alpha3.OpenStackCluster{}
+	openStackClustersListed := make(chan struct{})
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		openStackClusterList := &capov1alpha3.OpenStackClusterList{}
+		err := s.client.ListChunks(gctx, openStackClusterList, chunkSize, func() error {
+			for _, cluster := range openStackClusterList.Items {
+				c := cluster
+				openStackClusters[cluster.GetName()] = &c
+			}
+
+			return nil
+		}, inNamespace)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		close(openStackClustersListed)
+
+		return nil
+	})
+	g.Go(func() error {
+		clusters := &capiv1alpha3.ClusterList{}
+		err := s.client.ListChunks(gctx, clusters, chunkSize, func() error {
+			select {
+			case <-openStackClustersListed:
+			case <-gctx.Done():
+				return microerror.Mask(gctx.Err())
+			}
+
+			clusterCollection := &Collection{}
+			for _, cr := range clusters.Items {
+				o := cr
+
+				if openStackCluster, exists := openStackClusters[cr.GetName()]; exists {
+					o.TypeMeta = metav1.TypeMeta{
+						APIVersion: "cluster.x-k8s.io/v1alpha3",
+						Kind:       "Cluster",
+					}
+					openStackCluster.TypeMeta = metav1.TypeMeta{
+						APIVersion: "infrastructure.cluster.x-k8s.io/v1alpha3",
+						Kind:       "OpenStackCluster",
+					}
+
+					c := Cluster{
+						Cluster:          &o,
+						OpenStackCluster: openStackCluster,
+					}
+					clusterCollection.Items = append(clusterCollection.Items, c)
+				}
+			}
+
+			return handler(clusterCollection)
+		}, inNamespace, runtimeClient.MatchingLabelsSelector{Selector: selector})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	})
+
+	err := g.Wait()
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (s *Service) getByNameOpenStack(ctx context.Context, name, namespace string) (Resource, error) {
+	labelSelector := runtimeClient.MatchingLabels{
+		capiv1alpha3.ClusterLabelName: name,
+	}
+	inNamespace := runtimeClient.InNamespace(namespace)
+
+	clusters := &capiv1alpha3.ClusterList{}
+	openStackClusters := &capov1alpha3.OpenStackClusterList{}
+	{
+		g, gctx := errgroup.WithContext(ctx)
+		g.Go(func() error {
+			err := s.client.Reader().List(gctx, clusters, labelSelector, inNamespace)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			return nil
+		})
+		g.Go(func() error {
+			err := s.client.Reader().List(gctx, openStackClusters, labelSelector, inNamespace)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			return nil
+		})
+
+		err := g.Wait()
+		if err != nil {
+			return nil, microerror.Mask(err)
+		} else if len(clusters.Items) < 1 || len(openStackClusters.Items) < 1 {
+			return nil, microerror.Mask(notFoundError)
+		}
+	}
+
+	cluster := &Cluster{
+		Cluster:          &clusters.Items[0],
+		OpenStackCluster: &openStackClusters.Items[0],
+	}
+	cluster.Cluster.TypeMeta = metav1.TypeMeta{
+		APIVersion: "cluster.x-k8s.io/v1alpha3",
+		Kind:       "Cluster",
+	}
+	cluster.OpenStackCluster.TypeMeta = metav1.TypeMeta{
+		APIVersion: "infrastructure.cluster.x-k8s.io/v1alpha3",
+		Kind:       "OpenStackCluster",
+	}
+
+	return cluster, nil
+}
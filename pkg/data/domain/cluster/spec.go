@@ -6,14 +6,25 @@ import (
 	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
 	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	capov1alpha3 "github.com/giantswarm/kubectl-gs/internal/capo/api/v1alpha3"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/filter"
 )
 
 type GetOptions struct {
 	Name      string
 	Provider  string
 	Namespace string
+
+	// Options filter the clusters by the labels of the CAPI Cluster CR.
+	filter.Options
+
+	// ChunkSize is the maximum number of clusters listed per
+	// request, or 0 to list all of them at once.
+	ChunkSize int64
 }
 
 // Interface represents the contract for the clusters service.
@@ -21,6 +32,15 @@ type GetOptions struct {
 // service in tests much simpler.
 type Interface interface {
 	Get(context.Context, GetOptions) (Resource, error)
+	Stream(context.Context, GetOptions, func(Resource) error) error
+	Watch(context.Context, GetOptions, func(Event) error) error
+}
+
+// Event represents a change of a cluster, or of its
+// provider-specific infrastructure resource.
+type Event struct {
+	Type     watch.EventType
+	Resource Resource
 }
 
 type Resource interface {
@@ -32,8 +52,9 @@ type Resource interface {
 type Cluster struct {
 	Cluster *capiv1alpha3.Cluster
 
-	AWSCluster   *infrastructurev1alpha3.AWSCluster
-	AzureCluster *capzv1alpha3.AzureCluster
+	AWSCluster       *infrastructurev1alpha3.AWSCluster
+	AzureCluster     *capzv1alpha3.AzureCluster
+	OpenStackCluster *capov1alpha3.OpenStackCluster
 }
 
 func (n *Cluster) Object() runtime.Object {
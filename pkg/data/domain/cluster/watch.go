@@ -0,0 +1,210 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/giantswarm/microerror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
+)
+
+var (
+	capiClusterResource = schema.GroupVersionResource{
+		Group:    "cluster.x-k8s.io",
+		Version:  "v1alpha3",
+		Resource: "clusters",
+	}
+	awsClusterResource = schema.GroupVersionResource{
+		Group:    "infrastructure.giantswarm.io",
+		Version:  "v1alpha3",
+		Resource: "awsclusters",
+	}
+	azureClusterResource = schema.GroupVersionResource{
+		Group:    "infrastructure.cluster.x-k8s.io",
+		Version:  "v1alpha3",
+		Resource: "azureclusters",
+	}
+	openStackClusterResource = schema.GroupVersionResource{
+		Group:    "infrastructure.cluster.x-k8s.io",
+		Version:  "v1alpha3",
+		Resource: "openstackclusters",
+	}
+)
+
+// Watch calls the given handler every time a cluster changes. Changes of
+// the provider-specific infrastructure resources are reported as changes
+// of the cluster they belong to. It blocks until the context is cancelled,
+// the watch ends, or the handler returns an error.
+func (s *Service) Watch(ctx context.Context, options GetOptions, handler func(Event) error) error {
+	var resources []schema.GroupVersionResource
+	switch options.Provider {
+	case key.ProviderAWS:
+		resources = []schema.GroupVersionResource{capiClusterResource, awsClusterResource}
+	case key.ProviderAzure:
+		resources = []schema.GroupVersionResource{capiClusterResource, azureClusterResource}
+	case key.ProviderOpenStack:
+		resources = []schema.GroupVersionResource{capiClusterResource, openStackClusterResource}
+	default:
+		return microerror.Mask(invalidProviderError)
+	}
+
+	watchOptions := client.WatchOptions{
+		Namespace: options.Namespace,
+	}
+	if len(options.Name) > 0 {
+		watchOptions.LabelSelector = fmt.Sprintf("%s=%s", capiv1alpha3.ClusterLabelName, options.Name)
+	}
+
+	events, err := s.client.Watch(ctx, watchOptions, resources...)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	known, err := s.getKnown(ctx, options)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = s.watch(ctx, options, events, known, handler)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// watch joins the events of the CAPI and provider-specific resources,
+// and reports them as cluster events.
+func (s *Service) watch(ctx context.Context, options GetOptions, events <-chan watch.Event, known map[string]*Cluster, handler func(Event) error) error {
+	for e := range events {
+		if e.Type == watch.Error {
+			return microerror.Mask(apierrors.FromObject(e.Object))
+		}
+
+		object, err := meta.Accessor(e.Object)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		k := watchKey(object.GetNamespace(), object.GetName())
+
+		var event Event
+		if e.Type == watch.Deleted {
+			c, exists := known[k]
+			if !exists {
+				continue
+			}
+			delete(known, k)
+
+			event = Event{
+				Type:     watch.Deleted,
+				Resource: c,
+			}
+		} else {
+			resource, err := s.getByName(ctx, options.Provider, object.GetName(), object.GetNamespace())
+			if IsNotFound(err) {
+				// The counterpart of this resource has not
+				// been created yet, or it's already gone.
+				continue
+			} else if err != nil {
+				return microerror.Mask(err)
+			}
+			c := resource.(*Cluster)
+
+			if !matches(*c, options) {
+				previous, exists := known[k]
+				if !exists {
+					continue
+				}
+				delete(known, k)
+
+				// The cluster doesn't match the given
+				// options anymore, so it's gone from
+				// the list of watched clusters.
+				event = Event{
+					Type:     watch.Deleted,
+					Resource: previous,
+				}
+				err = handler(event)
+				if err != nil {
+					return microerror.Mask(err)
+				}
+
+				continue
+			}
+
+			eventType := watch.Added
+			if previous, exists := known[k]; exists {
+				if resourceVersion(previous) == resourceVersion(c) {
+					// Already reported through the
+					// counterpart resource.
+					continue
+				}
+				eventType = watch.Modified
+			}
+			known[k] = c
+
+			event = Event{
+				Type:     eventType,
+				Resource: c,
+			}
+		}
+
+		err = handler(event)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	return nil
+}
+
+// getKnown returns the clusters that exist before the watch starts, so
+// that their deletion can be reported even after both resources are gone.
+func (s *Service) getKnown(ctx context.Context, options GetOptions) (map[string]*Cluster, error) {
+	resource, err := s.Get(ctx, options)
+	if IsNotFound(err) || IsNoResources(err) {
+		return map[string]*Cluster{}, nil
+	} else if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	known := map[string]*Cluster{}
+	switch c := resource.(type) {
+	case *Cluster:
+		known[watchKey(c.Cluster.GetNamespace(), c.Cluster.GetName())] = c
+	case *Collection:
+		for i := range c.Items {
+			known[watchKey(c.Items[i].Cluster.GetNamespace(), c.Items[i].Cluster.GetName())] = &c.Items[i]
+		}
+	}
+
+	return known, nil
+}
+
+// resourceVersion combines the resource versions of all
+// the resources that make up a cluster.
+func resourceVersion(c *Cluster) string {
+	v := c.Cluster.GetResourceVersion()
+	if c.AWSCluster != nil {
+		v += "/" + c.AWSCluster.GetResourceVersion()
+	}
+	if c.AzureCluster != nil {
+		v += "/" + c.AzureCluster.GetResourceVersion()
+	}
+	if c.OpenStackCluster != nil {
+		v += "/" + c.OpenStackCluster.GetResourceVersion()
+	}
+
+	return v
+}
+
+func watchKey(namespace, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
@@ -0,0 +1,77 @@
+package event
+
+import (
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// objectSet holds the resources that belong to a cluster, so that
+// events can be matched against the objects they involve.
+type objectSet struct {
+	keys       map[string]bool
+	uids       map[types.UID]bool
+	namespaces map[string]bool
+}
+
+func newObjectSet() *objectSet {
+	return &objectSet{
+		keys:       map[string]bool{},
+		uids:       map[types.UID]bool{},
+		namespaces: map[string]bool{},
+	}
+}
+
+func (s *objectSet) Add(kind string, o metav1.Object) {
+	s.keys[objectKey(kind, o.GetNamespace(), o.GetName())] = true
+	if len(o.GetUID()) > 0 {
+		s.uids[o.GetUID()] = true
+	}
+	s.namespaces[o.GetNamespace()] = true
+}
+
+// AddReference adds the object the given reference points to.
+func (s *objectSet) AddReference(ref corev1.ObjectReference) {
+	s.keys[objectKey(ref.Kind, ref.Namespace, ref.Name)] = true
+	if len(ref.UID) > 0 {
+		s.uids[ref.UID] = true
+	}
+	s.namespaces[ref.Namespace] = true
+}
+
+// Contains is true if the given object reference points to one of the
+// objects in the set, either by UID or by kind, namespace and name.
+func (s *objectSet) Contains(ref corev1.ObjectReference) bool {
+	if len(ref.UID) > 0 && s.uids[ref.UID] {
+		return true
+	}
+
+	return s.keys[objectKey(ref.Kind, ref.Namespace, ref.Name)]
+}
+
+// HasNamespace is true if any of the objects is in the given namespace.
+func (s *objectSet) HasNamespace(namespace string) bool {
+	return s.namespaces[namespace]
+}
+
+func (s *objectSet) Len() int {
+	return len(s.keys)
+}
+
+// Namespaces returns the namespaces of all the objects in the set, sorted.
+func (s *objectSet) Namespaces() []string {
+	namespaces := make([]string, 0, len(s.namespaces))
+	for namespace := range s.namespaces {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	return namespaces
+}
+
+func objectKey(kind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+}
@@ -0,0 +1,246 @@
+package event
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	applicationv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/application/v1alpha1"
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	"github.com/giantswarm/apiextensions/v3/pkg/label"
+	"github.com/giantswarm/microerror"
+	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	capav1alpha3 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capiexpv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
+)
+
+var _ Interface = &Service{}
+
+// Config represent the input parameters that New takes to produce a valid event getter Service.
+type Config struct {
+	Client *client.Client
+}
+
+// Service is the object we'll hang the event getter methods on.
+type Service struct {
+	client *client.Client
+}
+
+// New returns a new event getter Service.
+func New(config Config) (Interface, error) {
+	if config.Client == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Client must not be empty", config)
+	}
+
+	s := &Service{
+		client: config.Client,
+	}
+
+	return s, nil
+}
+
+// clusterKind is a kind of resource that can belong to a cluster.
+type clusterKind struct {
+	Kind    string
+	NewList func() runtime.Object
+}
+
+var (
+	commonKinds = []clusterKind{
+		{Kind: "Cluster", NewList: func() runtime.Object { return &capiv1alpha3.ClusterList{} }},
+		{Kind: "MachineDeployment", NewList: func() runtime.Object { return &capiv1alpha3.MachineDeploymentList{} }},
+		{Kind: "MachinePool", NewList: func() runtime.Object { return &capiexpv1alpha3.MachinePoolList{} }},
+		{Kind: "Machine", NewList: func() runtime.Object { return &capiv1alpha3.MachineList{} }},
+		{Kind: "App", NewList: func() runtime.Object { return &applicationv1alpha1.AppList{} }},
+	}
+	awsKinds = []clusterKind{
+		{Kind: "AWSCluster", NewList: func() runtime.Object { return &infrastructurev1alpha3.AWSClusterList{} }},
+		{Kind: "AWSControlPlane", NewList: func() runtime.Object { return &infrastructurev1alpha3.AWSControlPlaneList{} }},
+		{Kind: "G8sControlPlane", NewList: func() runtime.Object { return &infrastructurev1alpha3.G8sControlPlaneList{} }},
+		{Kind: "AWSMachineDeployment", NewList: func() runtime.Object { return &infrastructurev1alpha3.AWSMachineDeploymentList{} }},
+		{Kind: "AWSMachine", NewList: func() runtime.Object { return &capav1alpha3.AWSMachineList{} }},
+	}
+	azureKinds = []clusterKind{
+		{Kind: "AzureCluster", NewList: func() runtime.Object { return &capzv1alpha3.AzureClusterList{} }},
+		{Kind: "AzureMachine", NewList: func() runtime.Object { return &capzv1alpha3.AzureMachineList{} }},
+		{Kind: "AzureMachinePool", NewList: func() runtime.Object { return &capzexpv1alpha3.AzureMachinePoolList{} }},
+	}
+
+	// clusterLabels are the labels that mark a resource as belonging to
+	// a cluster, set by Giant Swarm and Cluster API controllers respectively.
+	clusterLabels = []string{label.Cluster, capiv1alpha3.ClusterLabelName}
+)
+
+// Get fetches the events of all the resources that belong to a cluster,
+// i.e. the cluster, control plane and node pool resources, and apps.
+func (s *Service) Get(ctx context.Context, options GetOptions) (Resource, error) {
+	objects, err := s.getClusterObjects(ctx, options)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	collection := &Collection{}
+	for _, namespace := range objects.Namespaces() {
+		eventList := &corev1.EventList{}
+		err = s.client.Reader().List(ctx, eventList, runtimeclient.InNamespace(namespace))
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		for i := range eventList.Items {
+			e := &eventList.Items[i]
+			if !objects.Contains(e.InvolvedObject) {
+				continue
+			}
+			if !options.Since.IsZero() && LastSeen(e).Before(options.Since) {
+				continue
+			}
+
+			collection.Items = append(collection.Items, Event{
+				Event: withTypeMeta(e),
+			})
+		}
+	}
+
+	if len(collection.Items) < 1 {
+		return nil, microerror.Mask(noResourcesError)
+	}
+
+	sort.SliceStable(collection.Items, func(i, j int) bool {
+		a, b := collection.Items[i].Event, collection.Items[j].Event
+		if !LastSeen(a).Equal(LastSeen(b)) {
+			return LastSeen(a).Before(LastSeen(b))
+		}
+
+		return a.Name < b.Name
+	})
+
+	return collection, nil
+}
+
+// getClusterObjects finds the resources that belong to the given cluster,
+// by the cluster labels set on them.
+func (s *Service) getClusterObjects(ctx context.Context, options GetOptions) (*objectSet, error) {
+	var kinds []clusterKind
+	switch options.Provider {
+	case key.ProviderAWS:
+		kinds = append(append(kinds, commonKinds...), awsKinds...)
+	case key.ProviderAzure:
+		kinds = append(append(kinds, commonKinds...), azureKinds...)
+	default:
+		return nil, microerror.Mask(invalidProviderError)
+	}
+
+	namespaces := []string{options.Namespace}
+	if len(options.Namespace) > 0 && options.Namespace != options.ClusterName {
+		// Apps of a cluster may be placed in
+		// the namespace named after the cluster.
+		namespaces = append(namespaces, options.ClusterName)
+	}
+
+	// Every kind is listed concurrently. The objects are collected
+	// per kind, and added to the set in the order of the kinds.
+	found := make([][]metav1.Object, len(kinds))
+	g, gctx := errgroup.WithContext(ctx)
+	for i := range kinds {
+		i := i
+		g.Go(func() error {
+			var err error
+			found[i], err = s.listClusterObjects(gctx, kinds[i], namespaces, options)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			return nil
+		})
+	}
+
+	err := g.Wait()
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	objects := newObjectSet()
+	for i, k := range kinds {
+		for _, o := range found[i] {
+			objects.Add(k.Kind, o)
+		}
+	}
+
+	if objects.Len() < 1 {
+		return nil, microerror.Mask(notFoundError)
+	}
+
+	return objects, nil
+}
+
+// listClusterObjects lists the resources of the given kind
+// that belong to the given cluster, in all the given namespaces.
+func (s *Service) listClusterObjects(ctx context.Context, k clusterKind, namespaces []string, options GetOptions) ([]metav1.Object, error) {
+	var objects []metav1.Object
+	for _, namespace := range namespaces {
+		for _, l := range clusterLabels {
+			list := k.NewList()
+			err := s.client.Reader().List(ctx, list, runtimeclient.MatchingLabels{l: options.ClusterName}, runtimeclient.InNamespace(namespace))
+			if apimeta.IsNoMatchError(err) {
+				// The CRD of this kind is not installed.
+				break
+			} else if namespace != options.Namespace && (apierrors.IsForbidden(err) || apierrors.IsNotFound(err)) {
+				break
+			} else if err != nil {
+				return nil, microerror.Mask(err)
+			}
+
+			items, err := apimeta.ExtractList(list)
+			if err != nil {
+				return nil, microerror.Mask(err)
+			}
+
+			for _, item := range items {
+				o, err := apimeta.Accessor(item)
+				if err != nil {
+					return nil, microerror.Mask(err)
+				}
+
+				objects = append(objects, o)
+			}
+		}
+	}
+
+	return objects, nil
+}
+
+// LastSeen returns the time the given event last occurred.
+func LastSeen(e *corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	case !e.FirstTimestamp.IsZero():
+		return e.FirstTimestamp.Time
+	}
+
+	return e.CreationTimestamp.Time
+}
+
+func withTypeMeta(e *corev1.Event) *corev1.Event {
+	e.TypeMeta = metav1.TypeMeta{
+		APIVersion: "v1",
+		Kind:       "Event",
+	}
+
+	return e
+}
@@ -0,0 +1,99 @@
+package event
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger/microloggertest"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
+)
+
+var _ Interface = &FakeService{}
+
+type FakeService struct {
+	service *Service
+	storage []runtime.Object
+}
+
+func NewFakeService(storage []runtime.Object) *FakeService {
+	clientConfig := client.Config{
+		Logger: microloggertest.New(),
+	}
+	fakeClient, _ := client.NewFakeClient(clientConfig)
+
+	underlyingService := &Service{
+		client: fakeClient,
+	}
+
+	ms := &FakeService{
+		service: underlyingService,
+		storage: storage,
+	}
+
+	return ms
+}
+
+func (ms *FakeService) Get(ctx context.Context, options GetOptions) (Resource, error) {
+	err := ms.createStorage(ctx)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	result, err := ms.service.Get(ctx, options)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return result, nil
+}
+
+// Watch reports every event in the storage as a newly added one.
+func (ms *FakeService) Watch(ctx context.Context, options GetOptions, handler func(*Event) error) error {
+	err := ms.createStorage(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	objects, err := ms.service.getClusterObjects(ctx, options)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	events := make(chan watch.Event, len(ms.storage))
+	for _, res := range ms.storage {
+		if _, ok := res.(*corev1.Event); !ok {
+			continue
+		}
+
+		events <- watch.Event{
+			Type:   watch.Added,
+			Object: res,
+		}
+	}
+	close(events)
+
+	err = ms.service.watch(ctx, options, events, objects, handler)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (ms *FakeService) createStorage(ctx context.Context) error {
+	for _, res := range ms.storage {
+		err := ms.service.client.K8sClient.CtrlClient().Create(ctx, res.DeepCopyObject())
+		if apierrors.IsAlreadyExists(err) {
+			// Fall through.
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// GetOptions are the parameters that the Get and Watch methods take.
+type GetOptions struct {
+	// ClusterName is the name of the cluster whose
+	// resources the events are fetched for.
+	ClusterName string
+	Namespace   string
+	Provider    string
+	// Since only selects events that last
+	// occurred at or after this time.
+	Since time.Time
+}
+
+// Interface represents the contract for the events service.
+// Using this instead of a regular 'struct' makes mocking the
+// service in tests much simpler.
+type Interface interface {
+	Get(context.Context, GetOptions) (Resource, error)
+	Watch(context.Context, GetOptions, func(*Event) error) error
+}
+
+type Resource interface {
+	Object() runtime.Object
+}
+
+// Event is a Kubernetes event about one of
+// the resources that belong to a cluster.
+type Event struct {
+	Event *corev1.Event
+}
+
+func (e *Event) Object() runtime.Object {
+	if e.Event != nil {
+		return e.Event
+	}
+
+	return nil
+}
+
+// Collection wraps a list of events, sorted by the
+// time they last occurred, oldest first.
+type Collection struct {
+	Items []Event
+}
+
+func (cc *Collection) Object() runtime.Object {
+	list := &metav1.List{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "List",
+			APIVersion: "v1",
+		},
+		ListMeta: metav1.ListMeta{},
+	}
+
+	for _, item := range cc.Items {
+		obj := item.Object()
+		if obj == nil {
+			continue
+		}
+
+		raw := runtime.RawExtension{
+			Object: obj,
+		}
+		list.Items = append(list.Items, raw)
+	}
+
+	return list
+}
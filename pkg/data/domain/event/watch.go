@@ -0,0 +1,150 @@
+package event
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
+)
+
+var (
+	eventResource = schema.GroupVersionResource{
+		Version:  "v1",
+		Resource: "events",
+	}
+)
+
+// Watch calls the given handler every time an event about one of the
+// resources of a cluster occurs. Resources created after the watch has
+// started are taken into account too. It blocks until the context is
+// cancelled, the watch ends, or the handler returns an error.
+func (s *Service) Watch(ctx context.Context, options GetOptions, handler func(*Event) error) error {
+	objects, err := s.getClusterObjects(ctx, options)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	watchOptions := client.WatchOptions{
+		Namespace: metav1.NamespaceAll,
+	}
+	if namespaces := objects.Namespaces(); len(namespaces) == 1 {
+		watchOptions.Namespace = namespaces[0]
+	}
+
+	events, err := s.client.Watch(ctx, watchOptions, eventResource)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = s.watch(ctx, options, events, objects, handler)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (s *Service) watch(ctx context.Context, options GetOptions, events <-chan watch.Event, objects *objectSet, handler func(*Event) error) error {
+	// unrelated caches the objects found not to belong to
+	// the cluster, so that they are only looked up once.
+	unrelated := map[string]bool{}
+
+	for e := range events {
+		switch e.Type {
+		case watch.Error:
+			return microerror.Mask(apierrors.FromObject(e.Object))
+		case watch.Deleted:
+			// Events are deleted once they expire,
+			// which is not worth reporting.
+			continue
+		}
+
+		event, err := toEvent(e.Object)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		ref := event.InvolvedObject
+		if !objects.Contains(ref) {
+			k := objectKey(ref.Kind, ref.Namespace, ref.Name)
+			if unrelated[k] || !objects.HasNamespace(ref.Namespace) {
+				continue
+			}
+
+			belongs, err := s.belongsToCluster(ctx, options.ClusterName, ref)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+			if !belongs {
+				unrelated[k] = true
+				continue
+			}
+
+			objects.AddReference(ref)
+		}
+
+		err = handler(&Event{Event: withTypeMeta(event)})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	return nil
+}
+
+// belongsToCluster looks up the object the given reference points to,
+// and checks whether it carries one of the cluster labels.
+func (s *Service) belongsToCluster(ctx context.Context, clusterName string, ref corev1.ObjectReference) (bool, error) {
+	if len(ref.APIVersion) < 1 || len(ref.Kind) < 1 {
+		return false, nil
+	}
+
+	object := &unstructured.Unstructured{}
+	object.SetAPIVersion(ref.APIVersion)
+	object.SetKind(ref.Kind)
+
+	err := s.client.K8sClient.CtrlClient().Get(ctx, runtimeclient.ObjectKey{
+		Namespace: ref.Namespace,
+		Name:      ref.Name,
+	}, object)
+	if apierrors.IsNotFound(err) || apimeta.IsNoMatchError(err) {
+		return false, nil
+	} else if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	for _, l := range clusterLabels {
+		if object.GetLabels()[l] == clusterName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func toEvent(object runtime.Object) (*corev1.Event, error) {
+	switch o := object.(type) {
+	case *corev1.Event:
+		return o.DeepCopy(), nil
+	case *unstructured.Unstructured:
+		event := &corev1.Event{}
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.UnstructuredContent(), event)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		return event, nil
+	}
+
+	return nil, microerror.Maskf(invalidObjectError, "%T is not an event", object)
+}
@@ -0,0 +1,73 @@
+// Package filter matches the resources listed by the domain services
+// against the label selector, organization, release and condition filters
+// of the get commands.
+package filter
+
+import (
+	"strings"
+
+	semver "github.com/blang/semver/v4"
+	"github.com/giantswarm/microerror"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	"github.com/giantswarm/kubectl-gs/internal/label"
+)
+
+type Options struct {
+	// LabelSelector is matched against the labels of the resources.
+	LabelSelector labels.Selector
+	// Organization only selects resources
+	// owned by this organization.
+	Organization string
+	// Release only selects resources with a release
+	// version in this range, e.g. "14.x".
+	Release semver.Range
+	// Condition only selects resources whose latest
+	// condition matches this one, e.g. "created".
+	Condition string
+}
+
+// ListSelector combines all the options that can be
+// evaluated by the API server into a label selector.
+func (o Options) ListSelector() (labels.Selector, error) {
+	selector := labels.Everything()
+	if o.LabelSelector != nil {
+		selector = o.LabelSelector
+	}
+
+	if len(o.Organization) > 0 {
+		requirement, err := labels.NewRequirement(label.Organization, selection.Equals, []string{o.Organization})
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+		selector = selector.Add(*requirement)
+	}
+
+	return selector, nil
+}
+
+// Matches tells whether a resource with the given labels, release version
+// and latest condition matches all the options.
+func (o Options) Matches(resourceLabels map[string]string, releaseVersion, latestCondition string) bool {
+	set := labels.Set(resourceLabels)
+	if o.LabelSelector != nil && !o.LabelSelector.Matches(set) {
+		return false
+	}
+	if len(o.Organization) > 0 && set.Get(label.Organization) != o.Organization {
+		return false
+	}
+
+	if o.Release != nil {
+		version, err := semver.ParseTolerant(releaseVersion)
+		if err != nil || !o.Release(version) {
+			return false
+		}
+	}
+
+	if len(o.Condition) > 0 && !strings.EqualFold(latestCondition, o.Condition) {
+		return false
+	}
+
+	return true
+}
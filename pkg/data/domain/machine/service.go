@@ -0,0 +1,329 @@
+package machine
+
+import (
+	"context"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	"github.com/giantswarm/apiextensions/v3/pkg/label"
+	"github.com/giantswarm/microerror"
+	"golang.org/x/sync/errgroup"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capav1alpha3 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	capov1alpha3 "github.com/giantswarm/kubectl-gs/internal/capo/api/v1alpha3"
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
+)
+
+var _ Interface = &Service{}
+
+// Config represent the input parameters that New takes to produce a valid machine getter Service.
+type Config struct {
+	Client *client.Client
+}
+
+// Service is the object we'll hang the machine getter methods on.
+type Service struct {
+	client *client.Client
+}
+
+// New returns a new machine getter Service.
+func New(config Config) (Interface, error) {
+	if config.Client == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Client must not be empty", config)
+	}
+
+	s := &Service{
+		client: config.Client,
+	}
+
+	return s, nil
+}
+
+// Get fetches a list of CAPI machines, optionally filtered by cluster and
+// node pool, or a single one by name. Each machine is joined with its
+// provider-specific infrastructure machine, if there is one. Getting the
+// machines of a Giant Swarm AWS cluster fails, as its nodes are not
+// represented as CAPI machines.
+func (s *Service) Get(ctx context.Context, options GetOptions) (Resource, error) {
+	if options.Provider == key.ProviderAWS && len(options.ClusterName) > 0 {
+		err := s.validateCluster(ctx, options.Namespace, options.ClusterName)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var (
+		machines          []capiv1alpha3.Machine
+		awsMachines       map[string]*capav1alpha3.AWSMachine
+		azureMachines     map[string]*capzv1alpha3.AzureMachine
+		openStackMachines map[string]*capov1alpha3.OpenStackMachine
+	)
+
+	// The infrastructure machines are listed concurrently
+	// with the CAPI machines that they are joined with.
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		machines, err = s.getMachines(gctx, options)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	})
+
+	switch options.Provider {
+	case key.ProviderAWS:
+		g.Go(func() error {
+			var err error
+			awsMachines, err = s.getAWSMachines(gctx, options.Namespace)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			return nil
+		})
+
+	case key.ProviderAzure:
+		g.Go(func() error {
+			var err error
+			azureMachines, err = s.getAzureMachines(gctx, options.Namespace)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			return nil
+		})
+
+	case key.ProviderOpenStack:
+		g.Go(func() error {
+			var err error
+			openStackMachines, err = s.getOpenStackMachines(gctx, options.Namespace)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			return nil
+		})
+
+	default:
+		return nil, microerror.Mask(invalidProviderError)
+	}
+
+	err := g.Wait()
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	collection := &Collection{}
+	for i := range machines {
+		m := Machine{
+			Machine: withTypeMeta(&machines[i]),
+		}
+		switch options.Provider {
+		case key.ProviderAWS:
+			m.AWSMachine = awsMachines[infrastructureKey(&machines[i])]
+		case key.ProviderAzure:
+			m.AzureMachine = azureMachines[infrastructureKey(&machines[i])]
+		case key.ProviderOpenStack:
+			m.OpenStackMachine = openStackMachines[infrastructureKey(&machines[i])]
+		}
+
+		collection.Items = append(collection.Items, m)
+	}
+
+	if len(options.Name) > 0 {
+		return &collection.Items[0], nil
+	}
+
+	return collection, nil
+}
+
+// validateCluster returns an error if the cluster with the given name is a
+// Giant Swarm AWS cluster, i.e. one that is not managed by the Cluster API
+// provider for AWS.
+func (s *Service) validateCluster(ctx context.Context, namespace, name string) error {
+	clusterList := &capiv1alpha3.ClusterList{}
+	err := s.client.Reader().List(ctx, clusterList, runtimeclient.InNamespace(namespace))
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	for _, cluster := range clusterList.Items {
+		if cluster.Name != name || cluster.Spec.InfrastructureRef == nil {
+			continue
+		}
+
+		if cluster.Spec.InfrastructureRef.GroupVersionKind().Group == infrastructurev1alpha3.SchemeGroupVersion.Group {
+			return microerror.Maskf(unsupportedClusterError, "cluster %#q is not managed by the Cluster API provider for AWS", name)
+		}
+	}
+
+	return nil
+}
+
+// getMachines returns the CAPI machine with the given name,
+// or the ones matching the cluster and node pool filters.
+func (s *Service) getMachines(ctx context.Context, options GetOptions) ([]capiv1alpha3.Machine, error) {
+	if len(options.Name) > 0 {
+		machine := &capiv1alpha3.Machine{}
+		err := s.client.Reader().Get(ctx, runtimeclient.ObjectKey{
+			Namespace: options.Namespace,
+			Name:      options.Name,
+		}, machine)
+		if apierrors.IsNotFound(err) {
+			return nil, microerror.Mask(notFoundError)
+		} else if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		return []capiv1alpha3.Machine{*machine}, nil
+	}
+
+	labelSelector := runtimeclient.MatchingLabels{}
+	if len(options.ClusterName) > 0 {
+		labelSelector[capiv1alpha3.ClusterLabelName] = options.ClusterName
+	}
+
+	machineList := &capiv1alpha3.MachineList{}
+	err := s.client.Reader().List(ctx, machineList, labelSelector, runtimeclient.InNamespace(options.Namespace))
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var machines []capiv1alpha3.Machine
+	for _, machine := range machineList.Items {
+		if len(options.NodepoolName) > 0 && nodepoolName(&machine) != options.NodepoolName {
+			continue
+		}
+
+		machines = append(machines, machine)
+	}
+
+	if len(machines) == 0 {
+		return nil, microerror.Mask(noResourcesError)
+	}
+
+	return machines, nil
+}
+
+// getAWSMachines returns the AWS machines in the given namespace, by
+// namespace and name. Clusters that are not managed by the Cluster API
+// provider for AWS don't have any, and the CRD may not even exist.
+func (s *Service) getAWSMachines(ctx context.Context, namespace string) (map[string]*capav1alpha3.AWSMachine, error) {
+	list := &capav1alpha3.AWSMachineList{}
+	err := s.client.Reader().List(ctx, list, runtimeclient.InNamespace(namespace))
+	if apimeta.IsNoMatchError(err) {
+		return map[string]*capav1alpha3.AWSMachine{}, nil
+	} else if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	machines := make(map[string]*capav1alpha3.AWSMachine, len(list.Items))
+	for i := range list.Items {
+		m := &list.Items[i]
+		m.TypeMeta = metav1.TypeMeta{
+			APIVersion: capav1alpha3.GroupVersion.String(),
+			Kind:       "AWSMachine",
+		}
+		machines[objectKey(m.Namespace, m.Name)] = m
+	}
+
+	return machines, nil
+}
+
+// getAzureMachines returns the Azure machines in
+// the given namespace, by namespace and name.
+func (s *Service) getAzureMachines(ctx context.Context, namespace string) (map[string]*capzv1alpha3.AzureMachine, error) {
+	list := &capzv1alpha3.AzureMachineList{}
+	err := s.client.Reader().List(ctx, list, runtimeclient.InNamespace(namespace))
+	if apimeta.IsNoMatchError(err) {
+		return map[string]*capzv1alpha3.AzureMachine{}, nil
+	} else if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	machines := make(map[string]*capzv1alpha3.AzureMachine, len(list.Items))
+	for i := range list.Items {
+		m := &list.Items[i]
+		m.TypeMeta = metav1.TypeMeta{
+			APIVersion: capzv1alpha3.GroupVersion.String(),
+			Kind:       "AzureMachine",
+		}
+		machines[objectKey(m.Namespace, m.Name)] = m
+	}
+
+	return machines, nil
+}
+
+// getOpenStackMachines returns the OpenStack machines in
+// the given namespace, by namespace and name.
+func (s *Service) getOpenStackMachines(ctx context.Context, namespace string) (map[string]*capov1alpha3.OpenStackMachine, error) {
+	list := &capov1alpha3.OpenStackMachineList{}
+	err := s.client.Reader().List(ctx, list, runtimeclient.InNamespace(namespace))
+	if apimeta.IsNoMatchError(err) {
+		return map[string]*capov1alpha3.OpenStackMachine{}, nil
+	} else if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	machines := make(map[string]*capov1alpha3.OpenStackMachine, len(list.Items))
+	for i := range list.Items {
+		m := &list.Items[i]
+		m.TypeMeta = metav1.TypeMeta{
+			APIVersion: capov1alpha3.GroupVersion.String(),
+			Kind:       "OpenStackMachine",
+		}
+		machines[objectKey(m.Namespace, m.Name)] = m
+	}
+
+	return machines, nil
+}
+
+// NodepoolName returns the name of the node pool the given machine
+// belongs to, as set by either Giant Swarm or Cluster API controllers.
+func NodepoolName(m *Machine) string {
+	if m.Machine == nil {
+		return ""
+	}
+
+	return nodepoolName(m.Machine)
+}
+
+func nodepoolName(machine *capiv1alpha3.Machine) string {
+	for _, l := range []string{label.MachineDeployment, label.MachinePool, capiv1alpha3.MachineDeploymentLabelName} {
+		if name := machine.Labels[l]; len(name) > 0 {
+			return name
+		}
+	}
+
+	return ""
+}
+
+func infrastructureKey(machine *capiv1alpha3.Machine) string {
+	namespace := machine.Spec.InfrastructureRef.Namespace
+	if len(namespace) < 1 {
+		namespace = machine.Namespace
+	}
+
+	return objectKey(namespace, machine.Spec.InfrastructureRef.Name)
+}
+
+func objectKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+func withTypeMeta(machine *capiv1alpha3.Machine) *capiv1alpha3.Machine {
+	machine.TypeMeta = metav1.TypeMeta{
+		APIVersion: capiv1alpha3.GroupVersion.String(),
+		Kind:       "Machine",
+	}
+
+	return machine
+}
@@ -0,0 +1,84 @@
+package machine
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	capav1alpha3 "sigs.k8s.io/cluster-api-provider-aws/api/v1alpha3"
+	capzv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha3"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	capov1alpha3 "github.com/giantswarm/kubectl-gs/internal/capo/api/v1alpha3"
+)
+
+// GetOptions are the parameters that the Get method takes.
+type GetOptions struct {
+	// ClusterName limits the result to the machines of this cluster.
+	ClusterName string
+	Name        string
+	Namespace   string
+	// NodepoolName limits the result to the machines of this node pool.
+	NodepoolName string
+	Provider     string
+}
+
+// Interface represents the contract for the machine data service.
+// Using this instead of a regular 'struct' makes mocking the
+// service in tests much simpler.
+type Interface interface {
+	Get(context.Context, GetOptions) (Resource, error)
+}
+
+type Resource interface {
+	Object() runtime.Object
+}
+
+// Machine abstracts away provider-specific
+// node pool machine resources.
+type Machine struct {
+	Machine *capiv1alpha3.Machine
+
+	// AWSMachine is only set for AWS clusters
+	// managed by the Cluster API provider.
+	AWSMachine       *capav1alpha3.AWSMachine
+	AzureMachine     *capzv1alpha3.AzureMachine
+	OpenStackMachine *capov1alpha3.OpenStackMachine
+}
+
+func (m *Machine) Object() runtime.Object {
+	if m.Machine != nil {
+		return m.Machine
+	}
+
+	return nil
+}
+
+// Collection wraps a list of machines.
+type Collection struct {
+	Items []Machine
+}
+
+func (cc *Collection) Object() runtime.Object {
+	list := &metav1.List{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "List",
+			APIVersion: "v1",
+		},
+		ListMeta: metav1.ListMeta{},
+	}
+
+	for _, item := range cc.Items {
+		obj := item.Object()
+		if obj == nil {
+			continue
+		}
+
+		raw := runtime.RawExtension{
+			Object: obj,
+		}
+		list.Items = append(list.Items, raw)
+	}
+
+	return list
+}
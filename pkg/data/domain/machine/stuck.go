@@ -0,0 +1,30 @@
+package machine
+
+import (
+	"time"
+
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+// IsStuck returns true if the given machine has been in a provisioning or
+// deleting phase for longer than the given threshold.
+func IsStuck(m Machine, threshold time.Duration, now time.Time) bool {
+	if m.Machine == nil {
+		return false
+	}
+
+	var since time.Time
+	switch capiv1alpha3.MachinePhase(m.Machine.Status.Phase) {
+	case capiv1alpha3.MachinePhasePending, capiv1alpha3.MachinePhaseProvisioning, capiv1alpha3.MachinePhaseProvisioned:
+		since = m.Machine.CreationTimestamp.Time
+	case capiv1alpha3.MachinePhaseDeleting:
+		if m.Machine.DeletionTimestamp == nil {
+			return false
+		}
+		since = m.Machine.DeletionTimestamp.Time
+	default:
+		return false
+	}
+
+	return now.Sub(since) > threshold
+}
@@ -6,74 +6,97 @@ import (
 	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
 	"github.com/giantswarm/apiextensions/v3/pkg/label"
 	"github.com/giantswarm/microerror"
+	"golang.org/x/sync/errgroup"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func (s *Service) getAllAWS(ctx context.Context, namespace, clusterID string) (Resource, error) {
-	var err error
-
+func (s *Service) streamAllAWS(ctx context.Context, namespace, clusterID string, selector labels.Selector, chunkSize int64, handler func(*Collection) error) error {
 	labelSelector := runtimeClient.MatchingLabels{}
 	if len(clusterID) > 0 {
 		labelSelector[label.Cluster] = clusterID
 	}
 	inNamespace := runtimeClient.InNamespace(namespace)
 
-	var awsMDs map[string]*infrastructurev1alpha3.AWSMachineDeployment
-	{
-		mdCollection := &infrastructurev1alpha3.AWSMachineDeploymentList{}
-		err = s.client.K8sClient.CtrlClient().List(ctx, mdCollection, labelSelector, inNamespace)
-		if err != nil {
-			return nil, microerror.Mask(err)
-		} else if len(mdCollection.Items) == 0 {
-			return nil, microerror.Mask(noResourcesError)
-		}
-
-		awsMDs = make(map[string]*infrastructurev1alpha3.AWSMachineDeployment, len(mdCollection.Items))
-		for _, machineDeployment := range mdCollection.Items {
-			md := machineDeployment
-			awsMDs[machineDeployment.GetName()] = &md
-		}
+	// The given selector is only matched against
+	// the CAPI resource.
+	npSelector := labels.SelectorFromSet(labels.Set(labelSelector))
+	if requirements, selectable := selector.Requirements(); selectable {
+		npSelector = npSelector.Add(requirements...)
 	}
 
-	machineDeployments := &capiv1alpha3.MachineDeploymentList{}
-	{
-		err = s.client.K8sClient.CtrlClient().List(ctx, machineDeployments, labelSelector, inNamespace)
+	// Both resources are listed concurrently, since neither of the lists
+	// depends on the other. The chunks of MachineDeployments can only be
+	// joined once all the AWSMachineDeployments have been listed, though.
+	awsMDs := map[string]*infrastructurev1alpha3.AWSMachineDeployment{}
+	awsMDsListed := make(chan struct{})
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		awsMDList := &infrastructurev1alpha3.AWSMachineDeploymentList{}
+		err := s.client.ListChunks(gctx, awsMDList, chunkSize, func() error {
+			for _, item := range awsMDList.Items {
+				i := item
+				awsMDs[item.GetName()] = &i
+			}
+
+			return nil
+		}, labelSelector, inNamespace)
 		if err != nil {
-			return nil, microerror.Mask(err)
-		} else if len(machineDeployments.Items) == 0 {
-			return nil, microerror.Mask(noResourcesError)
+			return microerror.Mask(err)
 		}
-	}
-
-	npCollection := &Collection{}
-	{
-		for _, cr := range machineDeployments.Items {
-			o := cr
-
-			if awsMD, exists := awsMDs[cr.GetName()]; exists {
-				cr.TypeMeta = metav1.TypeMeta{
-					APIVersion: "cluster.x-k8s.io/v1alpha3",
-					Kind:       "MachineDeployment",
-				}
-				awsMD.TypeMeta = infrastructurev1alpha3.NewAWSMachineDeploymentTypeMeta()
+		close(awsMDsListed)
+
+		return nil
+	})
+	g.Go(func() error {
+		machineDeployments := &capiv1alpha3.MachineDeploymentList{}
+		err := s.client.ListChunks(gctx, machineDeployments, chunkSize, func() error {
+			select {
+			case <-awsMDsListed:
+			case <-gctx.Done():
+				return microerror.Mask(gctx.Err())
+			}
 
-				np := Nodepool{
-					MachineDeployment:    &o,
-					AWSMachineDeployment: awsMD,
+			npCollection := &Collection{}
+			for _, cr := range machineDeployments.Items {
+				o := cr
+
+				if awsMD, exists := awsMDs[cr.GetName()]; exists {
+					o.TypeMeta = metav1.TypeMeta{
+						APIVersion: "cluster.x-k8s.io/v1alpha3",
+						Kind:       "MachineDeployment",
+					}
+					awsMD.TypeMeta = infrastructurev1alpha3.NewAWSMachineDeploymentTypeMeta()
+
+					np := Nodepool{
+						MachineDeployment:    &o,
+						AWSMachineDeployment: awsMD,
+					}
+					npCollection.Items = append(npCollection.Items, np)
 				}
-				npCollection.Items = append(npCollection.Items, np)
 			}
+
+			return handler(npCollection)
+		}, runtimeClient.MatchingLabelsSelector{Selector: npSelector}, inNamespace)
+		if err != nil {
+			return microerror.Mask(err)
 		}
+
+		return nil
+	})
+
+	err := g.Wait()
+	if err != nil {
+		return microerror.Mask(err)
 	}
 
-	return npCollection, nil
+	return nil
 }
 
 func (s *Service) getByIdAWS(ctx context.Context, id, namespace, clusterID string) (Resource, error) {
-	var err error
-
 	labelSelector := runtimeClient.MatchingLabels{
 		label.MachineDeployment: id,
 	}
@@ -82,40 +105,44 @@ func (s *Service) getByIdAWS(ctx context.Context, id, namespace, clusterID strin
 	}
 	inNamespace := runtimeClient.InNamespace(namespace)
 
-	np := &Nodepool{}
-
+	capiCRs := &capiv1alpha3.MachineDeploymentList{}
+	infraCRs := &infrastructurev1alpha3.AWSMachineDeploymentList{}
 	{
-		crs := &capiv1alpha3.MachineDeploymentList{}
-		err = s.client.K8sClient.CtrlClient().List(ctx, crs, labelSelector, inNamespace)
-		if err != nil {
-			return nil, microerror.Mask(err)
-		}
+		g, gctx := errgroup.WithContext(ctx)
+		g.Go(func() error {
+			err := s.client.Reader().List(gctx, capiCRs, labelSelector, inNamespace)
+			if err != nil {
+				return microerror.Mask(err)
+			}
 
-		if len(crs.Items) < 1 {
-			return nil, microerror.Mask(notFoundError)
-		}
-		np.MachineDeployment = &crs.Items[0]
+			return nil
+		})
+		g.Go(func() error {
+			err := s.client.Reader().List(gctx, infraCRs, labelSelector, inNamespace)
+			if err != nil {
+				return microerror.Mask(err)
+			}
 
-		np.MachineDeployment.TypeMeta = metav1.TypeMeta{
-			APIVersion: "cluster.x-k8s.io/v1alpha3",
-			Kind:       "MachineDeployment",
-		}
-	}
+			return nil
+		})
 
-	{
-		crs := &infrastructurev1alpha3.AWSMachineDeploymentList{}
-		err = s.client.K8sClient.CtrlClient().List(ctx, crs, labelSelector, inNamespace)
+		err := g.Wait()
 		if err != nil {
 			return nil, microerror.Mask(err)
-		}
-
-		if len(crs.Items) < 1 {
+		} else if len(capiCRs.Items) < 1 || len(infraCRs.Items) < 1 {
 			return nil, microerror.Mask(notFoundError)
 		}
-		np.AWSMachineDeployment = &crs.Items[0]
+	}
 
-		np.AWSMachineDeployment.TypeMeta = infrastructurev1alpha3.NewAWSMachineDeploymentTypeMeta()
+	np := &Nodepool{
+		MachineDeployment:    &capiCRs.Items[0],
+		AWSMachineDeployment: &infraCRs.Items[0],
+	}
+	np.MachineDeployment.TypeMeta = metav1.TypeMeta{
+		APIVersion: "cluster.x-k8s.io/v1alpha3",
+		Kind:       "MachineDeployment",
 	}
+	np.AWSMachineDeployment.TypeMeta = infrastructurev1alpha3.NewAWSMachineDeploymentTypeMeta()
 
 	return np, nil
 }
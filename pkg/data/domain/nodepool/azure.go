@@ -5,79 +5,102 @@ import (
 
 	"github.com/giantswarm/apiextensions/v3/pkg/label"
 	"github.com/giantswarm/microerror"
+	"golang.org/x/sync/errgroup"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
 	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	capiexpv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func (s *Service) getAllAzure(ctx context.Context, namespace, clusterID string) (Resource, error) {
-	var err error
-
+func (s *Service) streamAllAzure(ctx context.Context, namespace, clusterID string, selector labels.Selector, chunkSize int64, handler func(*Collection) error) error {
 	labelSelector := runtimeClient.MatchingLabels{}
 	if len(clusterID) > 0 {
 		labelSelector[capiv1alpha3.ClusterLabelName] = clusterID
 	}
 	inNamespace := runtimeClient.InNamespace(namespace)
 
-	var azureMPs map[string]*capzexpv1alpha3.AzureMachinePool
-	{
-		mpCollection := &capzexpv1alpha3.AzureMachinePoolList{}
-		err = s.client.K8sClient.CtrlClient().List(ctx, mpCollection, labelSelector, inNamespace)
-		if err != nil {
-			return nil, microerror.Mask(err)
-		} else if len(mpCollection.Items) == 0 {
-			return nil, microerror.Mask(noResourcesError)
-		}
-
-		azureMPs = make(map[string]*capzexpv1alpha3.AzureMachinePool, len(mpCollection.Items))
-		for _, machineDeployment := range mpCollection.Items {
-			md := machineDeployment
-			azureMPs[machineDeployment.GetName()] = &md
-		}
+	// The given selector is only matched against
+	// the CAPI resource.
+	npSelector := labels.SelectorFromSet(labels.Set(labelSelector))
+	if requirements, selectable := selector.Requirements(); selectable {
+		npSelector = npSelector.Add(requirements...)
 	}
 
-	machinePools := &capiexpv1alpha3.MachinePoolList{}
-	{
-		err = s.client.K8sClient.CtrlClient().List(ctx, machinePools, labelSelector, inNamespace)
+	// Both resources are listed concurrently, since neither of the
+	// lists depends on the other. The chunks of MachinePools can only
+	// be joined once all the AzureMachinePools have been listed, though.
+	azureMPs := map[string]*capzexpv1alpha3.AzureMachinePool{}
+	azureMPsListed := make(chan struct{})
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		azureMPList := &capzexpv1alpha3.AzureMachinePoolList{}
+		err := s.client.ListChunks(gctx, azureMPList, chunkSize, func() error {
+			for _, item := range azureMPList.Items {
+				i := item
+				azureMPs[item.GetName()] = &i
+			}
+
+			return nil
+		}, labelSelector, inNamespace)
 		if err != nil {
-			return nil, microerror.Mask(err)
-		} else if len(machinePools.Items) == 0 {
-			return nil, microerror.Mask(noResourcesError)
+			return microerror.Mask(err)
 		}
-	}
-
-	npCollection := &Collection{}
-	{
-		for _, cr := range machinePools.Items {
-			o := cr
-
-			if azureMP, exists := azureMPs[cr.GetName()]; exists {
-				cr.TypeMeta = metav1.TypeMeta{
-					APIVersion: "exp.cluster.x-k8s.io/v1alpha3",
-					Kind:       "MachinePool",
-				}
-				azureMP.TypeMeta = metav1.TypeMeta{
-					APIVersion: "exp.infrastructure.cluster.x-k8s.io/v1alpha3",
-					Kind:       "AzureMachinePool",
-				}
+		close(azureMPsListed)
+
+		return nil
+	})
+	g.Go(func() error {
+		machinePools := &capiexpv1alpha3.MachinePoolList{}
+		err := s.client.ListChunks(gctx, machinePools, chunkSize, func() error {
+			select {
+			case <-azureMPsListed:
+			case <-gctx.Done():
+				return microerror.Mask(gctx.Err())
+			}
 
-				np := Nodepool{
-					MachinePool:      &o,
-					AzureMachinePool: azureMP,
+			npCollection := &Collection{}
+			for _, cr := range machinePools.Items {
+				o := cr
+
+				if azureMP, exists := azureMPs[cr.GetName()]; exists {
+					o.TypeMeta = metav1.TypeMeta{
+						APIVersion: "exp.cluster.x-k8s.io/v1alpha3",
+						Kind:       "MachinePool",
+					}
+					azureMP.TypeMeta = metav1.TypeMeta{
+						APIVersion: "exp.infrastructure.cluster.x-k8s.io/v1alpha3",
+						Kind:       "AzureMachinePool",
+					}
+
+					np := Nodepool{
+						MachinePool:      &o,
+						AzureMachinePool: azureMP,
+					}
+					npCollection.Items = append(npCollection.Items, np)
 				}
-				npCollection.Items = append(npCollection.Items, np)
 			}
+
+			return handler(npCollection)
+		}, runtimeClient.MatchingLabelsSelector{Selector: npSelector}, inNamespace)
+		if err != nil {
+			return microerror.Mask(err)
 		}
+
+		return nil
+	})
+
+	err := g.Wait()
+	if err != nil {
+		return microerror.Mask(err)
 	}
 
-	return npCollection, nil
+	return nil
 }
 
 func (s *Service) getByIdAzure(ctx context.Context, id, namespace, clusterID string) (Resource, error) {
-	var err error
-
 	labelSelector := runtimeClient.MatchingLabels{
 		label.MachinePool: id,
 	}
@@ -86,42 +109,46 @@ func (s *Service) getByIdAzure(ctx context.Context, id, namespace, clusterID str
 	}
 	inNamespace := runtimeClient.InNamespace(namespace)
 
-	np := &Nodepool{}
-
+	capiCRs := &capiexpv1alpha3.MachinePoolList{}
+	infraCRs := &capzexpv1alpha3.AzureMachinePoolList{}
 	{
-		crs := &capiexpv1alpha3.MachinePoolList{}
-		err = s.client.K8sClient.CtrlClient().List(ctx, crs, labelSelector, inNamespace)
-		if err != nil {
-			return nil, microerror.Mask(err)
-		}
+		g, gctx := errgroup.WithContext(ctx)
+		g.Go(func() error {
+			err := s.client.Reader().List(gctx, capiCRs, labelSelector, inNamespace)
+			if err != nil {
+				return microerror.Mask(err)
+			}
 
-		if len(crs.Items) < 1 {
-			return nil, microerror.Mask(notFoundError)
-		}
-		np.MachinePool = &crs.Items[0]
+			return nil
+		})
+		g.Go(func() error {
+			err := s.client.Reader().List(gctx, infraCRs, labelSelector, inNamespace)
+			if err != nil {
+				return microerror.Mask(err)
+			}
 
-		np.MachinePool.TypeMeta = metav1.TypeMeta{
-			APIVersion: "exp.cluster.x-k8s.io/v1alpha3",
-			Kind:       "MachinePool",
-		}
-	}
+			return nil
+		})
 
-	{
-		crs := &capzexpv1alpha3.AzureMachinePoolList{}
-		err = s.client.K8sClient.CtrlClient().List(ctx, crs, labelSelector, inNamespace)
+		err := g.Wait()
 		if err != nil {
 			return nil, microerror.Mask(err)
-		}
-
-		if len(crs.Items) < 1 {
+		} else if len(capiCRs.Items) < 1 || len(infraCRs.Items) < 1 {
 			return nil, microerror.Mask(notFoundError)
 		}
-		np.AzureMachinePool = &crs.Items[0]
+	}
 
-		np.AzureMachinePool.TypeMeta = metav1.TypeMeta{
-			APIVersion: "exp.infrastructure.cluster.x-k8s.io/v1alpha3",
-			Kind:       "AzureMachinePool",
-		}
+	np := &Nodepool{
+		MachinePool:      &capiCRs.Items[0],
+		AzureMachinePool: &infraCRs.Items[0],
+	}
+	np.MachinePool.TypeMeta = metav1.TypeMeta{
+		APIVersion: "exp.cluster.x-k8s.io/v1alpha3",
+		Kind:       "MachinePool",
+	}
+	np.AzureMachinePool.TypeMeta = metav1.TypeMeta{
+		APIVersion: "exp.infrastructure.cluster.x-k8s.io/v1alpha3",
+		Kind:       "AzureMachinePool",
 	}
 
 	return np, nil
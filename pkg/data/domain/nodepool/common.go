@@ -4,27 +4,78 @@ import (
 	"context"
 
 	"github.com/giantswarm/microerror"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/giantswarm/kubectl-gs/internal/key"
 )
 
 func (s *Service) Get(ctx context.Context, options GetOptions) (Resource, error) {
-	var resource Resource
-	var err error
-
 	if len(options.Name) > 0 {
-		resource, err = s.getByName(ctx, options.Provider, options.Name, options.Namespace, options.ClusterName)
+		resource, err := s.getByName(ctx, options.Provider, options.Name, options.Namespace, options.ClusterName)
 		if err != nil {
 			return nil, microerror.Mask(err)
 		}
-	} else {
-		resource, err = s.getAll(ctx, options.Provider, options.Namespace, options.ClusterName)
+
+		return resource, nil
+	}
+
+	npCollection := &Collection{}
+	err := s.stream(ctx, options, func(chunk *Collection) error {
+		npCollection.Items = append(npCollection.Items, chunk.Items...)
+		return nil
+	})
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return npCollection, nil
+}
+
+// Stream fetches the same node pools as Get, and calls the handler
+// with every chunk of them as soon as it has been listed.
+func (s *Service) Stream(ctx context.Context, options GetOptions, handler func(Resource) error) error {
+	if len(options.Name) > 0 {
+		resource, err := s.getByName(ctx, options.Provider, options.Name, options.Namespace, options.ClusterName)
 		if err != nil {
-			return nil, microerror.Mask(err)
+			return microerror.Mask(err)
 		}
+
+		return handler(resource)
+	}
+
+	err := s.stream(ctx, options, func(chunk *Collection) error {
+		return handler(chunk)
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (s *Service) stream(ctx context.Context, options GetOptions, handler func(*Collection) error) error {
+	selector, err := options.ListSelector()
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	var found bool
+	err = s.streamAll(ctx, options.Provider, options.Namespace, options.ClusterName, selector, options.ChunkSize, func(chunk *Collection) error {
+		filtered := filterCollection(chunk, options)
+		if len(filtered.Items) < 1 {
+			return nil
+		}
+		found = true
+
+		return handler(filtered)
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	} else if !found {
+		return microerror.Mask(noResourcesError)
 	}
 
-	return resource, nil
+	return nil
 }
 
 func (s *Service) getByName(ctx context.Context, provider, name, namespace, clusterName string) (Resource, error) {
@@ -43,6 +94,11 @@ func (s *Service) getByName(ctx context.Context, provider, name, namespace, clus
 			if err != nil {
 				return nil, microerror.Mask(err)
 			}
+		case key.ProviderOpenStack:
+			np, err = s.getByIdOpenStack(ctx, name, namespace, clusterName)
+			if err != nil {
+				return nil, microerror.Mask(err)
+			}
 		default:
 			return nil, microerror.Mask(invalidProviderError)
 		}
@@ -52,28 +108,31 @@ func (s *Service) getByName(ctx context.Context, provider, name, namespace, clus
 	return np, nil
 }
 
-func (s *Service) getAll(ctx context.Context, provider, namespace, clusterID string) (Resource, error) {
+func (s *Service) streamAll(ctx context.Context, provider, namespace, clusterID string, selector labels.Selector, chunkSize int64, handler func(*Collection) error) error {
 	var err error
 
-	var npCollection Resource
-	{
-		switch provider {
-		case key.ProviderAWS:
-			npCollection, err = s.getAllAWS(ctx, namespace, clusterID)
-			if err != nil {
-				return nil, microerror.Mask(err)
-			}
+	switch provider {
+	case key.ProviderAWS:
+		err = s.streamAllAWS(ctx, namespace, clusterID, selector, chunkSize, handler)
+		if err != nil {
+			return microerror.Mask(err)
+		}
 
-		case key.ProviderAzure:
-			npCollection, err = s.getAllAzure(ctx, namespace, clusterID)
-			if err != nil {
-				return nil, microerror.Mask(err)
-			}
+	case key.ProviderAzure:
+		err = s.streamAllAzure(ctx, namespace, clusterID, selector, chunkSize, handler)
+		if err != nil {
+			return microerror.Mask(err)
+		}
 
-		default:
-			return nil, microerror.Mask(invalidProviderError)
+	case key.ProviderOpenStack:
+		err = s.streamAllOpenStack(ctx, namespace, clusterID, selector, chunkSize, handler)
+		if err != nil {
+			return microerror.Mask(err)
 		}
+
+	default:
+		return microerror.Mask(invalidProviderError)
 	}
 
-	return npCollection, nil
+	return nil
 }
@@ -0,0 +1,41 @@
+package nodepool
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	"github.com/giantswarm/kubectl-gs/internal/label"
+)
+
+// filterCollection removes all the node pools that don't match the given
+// options.
+func filterCollection(collection *Collection, options GetOptions) *Collection {
+	filtered := &Collection{}
+	for _, np := range collection.Items {
+		if matches(np, options) {
+			filtered.Items = append(filtered.Items, np)
+		}
+	}
+
+	return filtered
+}
+
+func matches(np Nodepool, options GetOptions) bool {
+	object, err := meta.Accessor(np.Object())
+	if err != nil {
+		return false
+	}
+
+	npLabels := object.GetLabels()
+
+	return options.Matches(npLabels, npLabels[label.ReleaseVersion], latestCondition(np))
+}
+
+// latestCondition returns the latest condition reported for a node pool.
+// Conditions are only available for node pools based on MachinePools.
+func latestCondition(np Nodepool) string {
+	if np.MachinePool != nil && len(np.MachinePool.Status.Conditions) > 0 {
+		return string(np.MachinePool.Status.Conditions[0].Type)
+	}
+
+	return ""
+}
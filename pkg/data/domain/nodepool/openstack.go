@@ -0,0 +1,157 @@
+package nodepool
+
+import (
+	"context"
+
+	"github.com/giantswarm/apiextensions/v3/pkg/label"
+	"github.com/giantswarm/microerror"
+	"golang.org/x/sync/errgroup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	capov1alpha3 "github.com/giantswarm/kubectl-gs/internal/capo/api/v1alpha3"
+)
+
+func (s *Service) streamAllOpenStack(ctx context.Context, namespace, clusterID string, selector labels.Selector, chunkSize int64, handler func(*Collection) error) error {
+	labelSelector := runtimeClient.MatchingLabels{}
+	if len(clusterID) > 0 {
+		labelSelector[capiv1alpha3.ClusterLabelName] = clusterID
+	}
+	inNamespace := runtimeClient.InNamespace(namespace)
+
+	// The given selector is only matched against
+	// the CAPI resource.
+	npSelector := labels.SelectorFromSet(labels.Set(labelSelector))
+	if requirements, selectable := selector.Requirements(); selectable {
+		npSelector = npSelector.Add(requirements...)
+	}
+
+	// Both resources are listed concurrently, since neither of the lists
+	// depends on the other. The chunks of MachineDeployments can only be
+	// joined once all the OpenStackMachineTemplates have been listed, though.
+	// The template of the control plane matches no MachineDeployment, so it
+	// is left out.
+	openStackMTs := map[string]*capov1alpha3.OpenStackMachineTemplate{}
+	openStackMTsListed := make(chan struct{})
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		openStackMTList := &capov1alpha3.OpenStackMachineTemplateList{}
+		err := s.client.ListChunks(gctx, openStackMTList, chunkSize, func() error {
+			for _, item := range openStackMTList.Items {
+				i := item
+				openStackMTs[item.GetName()] = &i
+			}
+
+			return nil
+		}, labelSelector, inNamespace)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		close(openStackMTsListed)
+
+		return nil
+	})
+	g.Go(func() error {
+		machineDeployments := &capiv1alpha3.MachineDeploymentList{}
+		err := s.client.ListChunks(gctx, machineDeployments, chunkSize, func() error {
+			select {
+			case <-openStackMTsListed:
+			case <-gctx.Done():
+				return microerror.Mask(gctx.Err())
+			}
+
+			npCollection := &Collection{}
+			for _, cr := range machineDeployments.Items {
+				o := cr
+
+				if openStackMT, exists := openStackMTs[cr.GetName()]; exists {
+					o.TypeMeta = metav1.TypeMeta{
+						APIVersion: "cluster.x-k8s.io/v1alpha3",
+						Kind:       "MachineDeployment",
+					}
+					openStackMT.TypeMeta = metav1.TypeMeta{
+						APIVersion: "infrastructure.cluster.x-k8s.io/v1alpha3",
+						Kind:       "OpenStackMachineTemplate",
+					}
+
+					np := Nodepool{
+						MachineDeployment:        &o,
+						OpenStackMachineTemplate: openStackMT,
+					}
+					npCollection.Items = append(npCollection.Items, np)
+				}
+			}
+
+			return handler(npCollection)
+		}, runtimeClient.MatchingLabelsSelector{Selector: npSelector}, inNamespace)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	})
+
+	err := g.Wait()
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (s *Service) getByIdOpenStack(ctx context.Context, id, namespace, clusterID string) (Resource, error) {
+	labelSelector := runtimeClient.MatchingLabels{
+		label.MachineDeployment: id,
+	}
+	if len(clusterID) > 0 {
+		labelSelector[capiv1alpha3.ClusterLabelName] = clusterID
+	}
+	inNamespace := runtimeClient.InNamespace(namespace)
+
+	capiCRs := &capiv1alpha3.MachineDeploymentList{}
+	infraCRs := &capov1alpha3.OpenStackMachineTemplateList{}
+	{
+		g, gctx := errgroup.WithContext(ctx)
+		g.Go(func() error {
+			err := s.client.Reader().List(gctx, capiCRs, labelSelector, inNamespace)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			return nil
+		})
+		g.Go(func() error {
+			err := s.client.Reader().List(gctx, infraCRs, labelSelector, inNamespace)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			return nil
+		})
+
+		err := g.Wait()
+		if err != nil {
+			return nil, microerror.Mask(err)
+		} else if len(capiCRs.Items) < 1 || len(infraCRs.Items) < 1 {
+			return nil, microerror.Mask(notFoundError)
+		}
+	}
+
+	np := &Nodepool{
+		MachineDeployment:        &capiCRs.Items[0],
+		OpenStackMachineTemplate: &infraCRs.Items[0],
+	}
+	np.MachineDeployment.TypeMeta = metav1.TypeMeta{
+		APIVersion: "cluster.x-k8s.io/v1alpha3",
+		Kind:       "MachineDeployment",
+	}
+	np.OpenStackMachineTemplate.TypeMeta = metav1.TypeMeta{
+		APIVersion: "infrastructure.cluster.x-k8s.io/v1alpha3",
+		Kind:       "OpenStackMachineTemplate",
+	}
+
+	return np, nil
+}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger/microloggertest"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/giantswarm/kubectl-gs/pkg/data/client"
 )
@@ -38,8 +40,10 @@ func NewFakeService(storage []runtime.Object) *FakeService {
 func (ms *FakeService) Get(ctx context.Context, options GetOptions) (Resource, error) {
 	var err error
 	for _, res := range ms.storage {
-		err = ms.service.client.K8sClient.CtrlClient().Create(ctx, res)
-		if err != nil {
+		err = ms.service.client.K8sClient.CtrlClient().Create(ctx, res.DeepCopyObject())
+		if apierrors.IsAlreadyExists(err) {
+			// Fall through.
+		} else if err != nil {
 			return nil, microerror.Mask(err)
 		}
 	}
@@ -51,3 +55,48 @@ func (ms *FakeService) Get(ctx context.Context, options GetOptions) (Resource, e
 
 	return result, nil
 }
+
+func (ms *FakeService) Stream(ctx context.Context, options GetOptions, handler func(Resource) error) error {
+	var err error
+	for _, res := range ms.storage {
+		err = ms.service.client.K8sClient.CtrlClient().Create(ctx, res.DeepCopyObject())
+		if apierrors.IsAlreadyExists(err) {
+			// Fall through.
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	err = ms.service.Stream(ctx, options, handler)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// Watch reports every object in the storage as a newly added one.
+func (ms *FakeService) Watch(ctx context.Context, options GetOptions, handler func(Event) error) error {
+	events := make(chan watch.Event, len(ms.storage))
+	for _, res := range ms.storage {
+		err := ms.service.client.K8sClient.CtrlClient().Create(ctx, res.DeepCopyObject())
+		if apierrors.IsAlreadyExists(err) {
+			// Fall through.
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+
+		events <- watch.Event{
+			Type:   watch.Added,
+			Object: res,
+		}
+	}
+	close(events)
+
+	err := ms.service.watch(ctx, options, events, map[string]*Nodepool{}, handler)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
@@ -6,9 +6,13 @@ import (
 	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	capzexpv1alpha3 "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1alpha3"
 	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	capiexpv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+
+	capov1alpha3 "github.com/giantswarm/kubectl-gs/internal/capo/api/v1alpha3"
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/filter"
 )
 
 type GetOptions struct {
@@ -16,10 +20,27 @@ type GetOptions struct {
 	ClusterName string
 	Provider    string
 	Namespace   string
+
+	// Options filter the node pools by the labels of the CAPI
+	// MachineDeployment or MachinePool CR.
+	filter.Options
+
+	// ChunkSize is the maximum number of node pools listed per
+	// request, or 0 to list all of them at once.
+	ChunkSize int64
 }
 
 type Interface interface {
 	Get(context.Context, GetOptions) (Resource, error)
+	Stream(context.Context, GetOptions, func(Resource) error) error
+	Watch(context.Context, GetOptions, func(Event) error) error
+}
+
+// Event represents a change of a node pool, or of its
+// provider-specific infrastructure resource.
+type Event struct {
+	Type     watch.EventType
+	Resource Resource
 }
 
 type Resource interface {
@@ -32,8 +53,9 @@ type Nodepool struct {
 	MachineDeployment *capiv1alpha3.MachineDeployment
 	MachinePool       *capiexpv1alpha3.MachinePool
 
-	AWSMachineDeployment *infrastructurev1alpha3.AWSMachineDeployment
-	AzureMachinePool     *capzexpv1alpha3.AzureMachinePool
+	AWSMachineDeployment     *infrastructurev1alpha3.AWSMachineDeployment
+	AzureMachinePool         *capzexpv1alpha3.AzureMachinePool
+	OpenStackMachineTemplate *capov1alpha3.OpenStackMachineTemplate
 }
 
 func (n *Nodepool) Object() runtime.Object {
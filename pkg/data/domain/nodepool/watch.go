@@ -0,0 +1,249 @@
+package nodepool
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/giantswarm/apiextensions/v3/pkg/label"
+	"github.com/giantswarm/microerror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
+)
+
+var (
+	machineDeploymentResource = schema.GroupVersionResource{
+		Group:    "cluster.x-k8s.io",
+		Version:  "v1alpha3",
+		Resource: "machinedeployments",
+	}
+	awsMachineDeploymentResource = schema.GroupVersionResource{
+		Group:    "infrastructure.giantswarm.io",
+		Version:  "v1alpha3",
+		Resource: "awsmachinedeployments",
+	}
+	machinePoolResource = schema.GroupVersionResource{
+		Group:    "exp.cluster.x-k8s.io",
+		Version:  "v1alpha3",
+		Resource: "machinepools",
+	}
+	azureMachinePoolResource = schema.GroupVersionResource{
+		Group:    "exp.infrastructure.cluster.x-k8s.io",
+		Version:  "v1alpha3",
+		Resource: "azuremachinepools",
+	}
+	openStackMachineTemplateResource = schema.GroupVersionResource{
+		Group:    "infrastructure.cluster.x-k8s.io",
+		Version:  "v1alpha3",
+		Resource: "openstackmachinetemplates",
+	}
+)
+
+// Watch calls the given handler every time a node pool changes. Changes of
+// the provider-specific infrastructure resources are reported as changes
+// of the node pool they belong to. It blocks until the context is cancelled,
+// the watch ends, or the handler returns an error.
+func (s *Service) Watch(ctx context.Context, options GetOptions, handler func(Event) error) error {
+	var resources []schema.GroupVersionResource
+	selector := labels.Set{}
+	switch options.Provider {
+	case key.ProviderAWS:
+		resources = []schema.GroupVersionResource{machineDeploymentResource, awsMachineDeploymentResource}
+		if len(options.Name) > 0 {
+			selector[label.MachineDeployment] = options.Name
+		}
+		if len(options.ClusterName) > 0 {
+			selector[label.Cluster] = options.ClusterName
+		}
+	case key.ProviderAzure:
+		resources = []schema.GroupVersionResource{machinePoolResource, azureMachinePoolResource}
+		if len(options.Name) > 0 {
+			selector[label.MachinePool] = options.Name
+		}
+		if len(options.ClusterName) > 0 {
+			selector[capiv1alpha3.ClusterLabelName] = options.ClusterName
+		}
+	case key.ProviderOpenStack:
+		resources = []schema.GroupVersionResource{machineDeploymentResource, openStackMachineTemplateResource}
+		if len(options.Name) > 0 {
+			selector[label.MachineDeployment] = options.Name
+		}
+		if len(options.ClusterName) > 0 {
+			selector[capiv1alpha3.ClusterLabelName] = options.ClusterName
+		}
+	default:
+		return microerror.Mask(invalidProviderError)
+	}
+
+	watchOptions := client.WatchOptions{
+		LabelSelector: selector.String(),
+		Namespace:     options.Namespace,
+	}
+
+	events, err := s.client.Watch(ctx, watchOptions, resources...)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	known, err := s.getKnown(ctx, options)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = s.watch(ctx, options, events, known, handler)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// watch joins the events of the CAPI and provider-specific resources,
+// and reports them as node pool events.
+func (s *Service) watch(ctx context.Context, options GetOptions, events <-chan watch.Event, known map[string]*Nodepool, handler func(Event) error) error {
+	for e := range events {
+		if e.Type == watch.Error {
+			return microerror.Mask(apierrors.FromObject(e.Object))
+		}
+
+		object, err := meta.Accessor(e.Object)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		k := watchKey(object.GetNamespace(), object.GetName())
+
+		var event Event
+		if e.Type == watch.Deleted {
+			np, exists := known[k]
+			if !exists {
+				continue
+			}
+			delete(known, k)
+
+			event = Event{
+				Type:     watch.Deleted,
+				Resource: np,
+			}
+		} else {
+			resource, err := s.getByName(ctx, options.Provider, object.GetName(), object.GetNamespace(), options.ClusterName)
+			if IsNotFound(err) {
+				// The counterpart of this resource has not
+				// been created yet, or it's already gone.
+				continue
+			} else if err != nil {
+				return microerror.Mask(err)
+			}
+			np := resource.(*Nodepool)
+
+			if !matches(*np, options) {
+				previous, exists := known[k]
+				if !exists {
+					continue
+				}
+				delete(known, k)
+
+				// The node pool doesn't match the given
+				// options anymore, so it's gone from the
+				// list of watched node pools.
+				event = Event{
+					Type:     watch.Deleted,
+					Resource: previous,
+				}
+				err = handler(event)
+				if err != nil {
+					return microerror.Mask(err)
+				}
+
+				continue
+			}
+
+			eventType := watch.Added
+			if previous, exists := known[k]; exists {
+				if resourceVersion(previous) == resourceVersion(np) {
+					// Already reported through the
+					// counterpart resource.
+					continue
+				}
+				eventType = watch.Modified
+			}
+			known[k] = np
+
+			event = Event{
+				Type:     eventType,
+				Resource: np,
+			}
+		}
+
+		err = handler(event)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	return nil
+}
+
+// getKnown returns the node pools that exist before the watch starts, so
+// that their deletion can be reported even after both resources are gone.
+func (s *Service) getKnown(ctx context.Context, options GetOptions) (map[string]*Nodepool, error) {
+	resource, err := s.Get(ctx, options)
+	if IsNotFound(err) || IsNoResources(err) {
+		return map[string]*Nodepool{}, nil
+	} else if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	known := map[string]*Nodepool{}
+	switch n := resource.(type) {
+	case *Nodepool:
+		known[nodepoolKey(n)] = n
+	case *Collection:
+		for i := range n.Items {
+			known[nodepoolKey(&n.Items[i])] = &n.Items[i]
+		}
+	}
+
+	return known, nil
+}
+
+func nodepoolKey(np *Nodepool) string {
+	object, err := meta.Accessor(np.Object())
+	if err != nil {
+		return ""
+	}
+
+	return watchKey(object.GetNamespace(), object.GetName())
+}
+
+// resourceVersion combines the resource versions of all
+// the resources that make up a node pool.
+func resourceVersion(np *Nodepool) string {
+	var v string
+	if np.MachineDeployment != nil {
+		v += "/" + np.MachineDeployment.GetResourceVersion()
+	}
+	if np.MachinePool != nil {
+		v += "/" + np.MachinePool.GetResourceVersion()
+	}
+	if np.AWSMachineDeployment != nil {
+		v += "/" + np.AWSMachineDeployment.GetResourceVersion()
+	}
+	if np.AzureMachinePool != nil {
+		v += "/" + np.AzureMachinePool.GetResourceVersion()
+	}
+	if np.OpenStackMachineTemplate != nil {
+		v += "/" + np.OpenStackMachineTemplate.GetResourceVersion()
+	}
+
+	return v
+}
+
+func watchKey(namespace, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
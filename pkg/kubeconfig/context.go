@@ -16,6 +16,12 @@ func GenerateKubeContextName(installationCodeName string) string {
 	return fmt.Sprintf("%s%s", ContextPrefix, installationCodeName)
 }
 
+// GenerateWCKubeContextName creates a context name for a workload
+// cluster, from an installation's code name and the cluster's name.
+func GenerateWCKubeContextName(installationCodeName, clusterName string) string {
+	return fmt.Sprintf("%s%s-%s", ContextPrefix, installationCodeName, clusterName)
+}
+
 // IsKubeContext checks whether the name provided,
 // matches our pattern for naming kubernetes contexts.
 func IsKubeContext(s string) bool {
@@ -14,6 +14,15 @@ func TestGenerateKubeContextName(t *testing.T) {
 	}
 }
 
+func TestGenerateWCKubeContextName(t *testing.T) {
+	result := GenerateWCKubeContextName("test", "f83ir")
+	expected := "gs-test-f83ir"
+
+	if result != expected {
+		t.Fatalf("Value not expected, got: %s", result)
+	}
+}
+
 func TestIsKubeContext(t *testing.T) {
 	testCases := []struct {
 		name     string
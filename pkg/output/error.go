@@ -0,0 +1,14 @@
+package output
+
+import (
+	"github.com/giantswarm/microerror"
+)
+
+var invalidColumnError = &microerror.Error{
+	Kind: "invalidColumnError",
+}
+
+// IsInvalidColumn asserts invalidColumnError.
+func IsInvalidColumn(err error) bool {
+	return microerror.Cause(err) == invalidColumnError
+}
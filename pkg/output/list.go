@@ -0,0 +1,39 @@
+package output
+
+import (
+	"io"
+
+	"github.com/giantswarm/microerror"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+// PrintEmptyList prints an empty list for the machine-readable output
+// formats, so that their output can still be parsed when there are no
+// resources. Nothing is printed for the table and name output formats.
+func PrintEmptyList(out io.Writer, printFlags *genericclioptions.PrintFlags) error {
+	if IsOutputTable(printFlags.OutputFormat) || IsOutputName(printFlags.OutputFormat) {
+		return nil
+	}
+
+	printer, err := printFlags.ToPrinter()
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	list := &metav1.List{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "List",
+			APIVersion: "v1",
+		},
+		Items: []runtime.RawExtension{},
+	}
+
+	err = printer.PrintObj(list, out)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
@@ -1,5 +1,9 @@
 package output
 
+import (
+	"strings"
+)
+
 const (
 	TypeDefault        = ""
 	TypeJSON           = "json"
@@ -12,12 +16,32 @@ const (
 	TypeJsonPath       = "jsonpath"
 	TypeJsonPathFile   = "jsonpath-file"
 	TypeReport         = "report"
+	TypeWide           = "wide"
+	TypeCustomColumns  = "custom-columns"
 )
 
 func IsOutputDefault(output *string) bool {
 	return output == nil || *output == TypeDefault
 }
 
+// IsOutputWide is true for the table output format
+// that includes additional columns.
+func IsOutputWide(output *string) bool {
+	return output != nil && *output == TypeWide
+}
+
+// IsOutputCustomColumns is true for the table output
+// format with user-defined columns.
+func IsOutputCustomColumns(output *string) bool {
+	return output != nil && strings.HasPrefix(*output, TypeCustomColumns+"=")
+}
+
+// IsOutputTable is true for all the output formats
+// that are printed using PrintTable.
+func IsOutputTable(output *string) bool {
+	return IsOutputDefault(output) || IsOutputWide(output) || IsOutputCustomColumns(output)
+}
+
 func IsOutputName(output *string) bool {
 	return output == nil || *output == TypeName
 }
@@ -0,0 +1,389 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/giantswarm/microerror"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const (
+	noneValue = "<none>"
+)
+
+// TableOptions are the parameters that PrintTable takes.
+type TableOptions struct {
+	// OutputFormat is the value of the --output flag. The wide and
+	// custom columns output formats are handled by PrintTable.
+	OutputFormat *string
+	// SortBy is either the name of a column, or a JSONPath expression
+	// evaluated against the object of each row, e.g.
+	// '.metadata.creationTimestamp'.
+	SortBy string
+	// NoHeaders omits the column names, e.g. when printing
+	// single rows in watch mode.
+	NoHeaders bool
+	// WithNamespace adds the namespace of each
+	// row object as the first column.
+	WithNamespace bool
+}
+
+// PrintTable prints a table built by one of the commands, after sorting its
+// rows and selecting its columns according to the given options. Columns
+// with a priority higher than 0 are only printed with the wide output format.
+func PrintTable(out io.Writer, table *metav1.Table, options TableOptions) error {
+	var err error
+
+	if len(options.SortBy) > 0 {
+		err = sortTable(table, options.SortBy)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	printOptions := printers.PrintOptions{
+		NoHeaders:     options.NoHeaders,
+		Wide:          IsOutputWide(options.OutputFormat),
+		WithNamespace: options.WithNamespace,
+	}
+
+	if IsOutputCustomColumns(options.OutputFormat) {
+		spec := strings.TrimPrefix(*options.OutputFormat, TypeCustomColumns+"=")
+		table, err = getCustomColumnsTable(table, spec)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		// Custom columns are printed exactly as requested.
+		printOptions.WithNamespace = false
+	}
+
+	printer := printers.NewTablePrinter(printOptions)
+	err = printer.PrintObj(table, out)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// TablePrinter prints the tables of one command, e.g. the chunks of a list
+// followed by the events of a watch. Only the first table is printed with
+// headers, and the column widths are kept across tables, so that the rows
+// of all tables line up with the headers.
+type TablePrinter struct {
+	out            tableWriter
+	headersPrinted bool
+}
+
+// tableWriter is the tab writer of the table printer. Passing the same one
+// to each print lets it remember the column widths.
+type tableWriter interface {
+	io.Writer
+	Flush() error
+}
+
+func NewTablePrinter(out io.Writer) *TablePrinter {
+	p := &TablePrinter{
+		out: printers.GetNewTabWriter(out),
+	}
+
+	return p
+}
+
+// PrintTable prints the table like PrintTable, omitting the headers once
+// they have been printed.
+func (p *TablePrinter) PrintTable(table *metav1.Table, options TableOptions) error {
+	options.NoHeaders = options.NoHeaders || p.headersPrinted
+
+	err := PrintTable(p.out, table, options)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = p.out.Flush()
+	if err != nil {
+		return microerror.Mask(err)
+	}
+	p.headersPrinted = true
+
+	return nil
+}
+
+func sortTable(table *metav1.Table, sortBy string) error {
+	var getValue func(row metav1.TableRow) (interface{}, error)
+	if isJSONPath(sortBy) {
+		parser, err := newJSONPath(sortBy)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		getValue = func(row metav1.TableRow) (interface{}, error) {
+			values, err := findJSONPathValues(parser, row.Object.Object)
+			if err != nil || len(values) < 1 {
+				return nil, microerror.Mask(err)
+			}
+
+			return values[0], nil
+		}
+	} else {
+		index := getColumnIndex(table, sortBy)
+		if index < 0 {
+			return microerror.Maskf(invalidColumnError, "cannot sort by unknown column %#q, must be one of %s", sortBy, strings.Join(getColumnNames(table), ", "))
+		}
+
+		getValue = func(row metav1.TableRow) (interface{}, error) {
+			if index >= len(row.Cells) {
+				return nil, nil
+			}
+
+			return row.Cells[index], nil
+		}
+	}
+
+	type sortableRow struct {
+		row   metav1.TableRow
+		value interface{}
+	}
+
+	rows := make([]sortableRow, 0, len(table.Rows))
+	for _, row := range table.Rows {
+		value, err := getValue(row)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		rows = append(rows, sortableRow{row: row, value: value})
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return isLess(rows[i].value, rows[j].value)
+	})
+
+	for i := range rows {
+		table.Rows[i] = rows[i].row
+	}
+
+	return nil
+}
+
+// getCustomColumnsTable builds a new table out of the rows of the given
+// one, with columns defined like 'NAME:.metadata.name,AGE:.status.age'.
+func getCustomColumnsTable(table *metav1.Table, spec string) (*metav1.Table, error) {
+	if len(spec) < 1 {
+		return nil, microerror.Maskf(invalidColumnError, "custom columns format must be specified, e.g. %s=NAME:.metadata.name", TypeCustomColumns)
+	}
+
+	var parsers []*jsonpath.JSONPath
+	customTable := &metav1.Table{}
+	for _, column := range strings.Split(spec, ",") {
+		parts := strings.SplitN(column, ":", 2)
+		if len(parts) != 2 || len(parts[0]) < 1 || len(parts[1]) < 1 {
+			return nil, microerror.Maskf(invalidColumnError, "custom column %#q must be in the HEADER:JSONPATH format", column)
+		}
+
+		parser, err := newJSONPath(parts[1])
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+		parsers = append(parsers, parser)
+
+		customTable.ColumnDefinitions = append(customTable.ColumnDefinitions, metav1.TableColumnDefinition{
+			Name: parts[0],
+			Type: "string",
+		})
+	}
+
+	for _, row := range table.Rows {
+		customRow := metav1.TableRow{
+			Object: row.Object,
+		}
+
+		for _, parser := range parsers {
+			values, err := findJSONPathValues(parser, row.Object.Object)
+			if err != nil {
+				return nil, microerror.Mask(err)
+			}
+
+			cell := noneValue
+			if len(values) > 0 {
+				formatted := make([]string, 0, len(values))
+				for _, value := range values {
+					formatted = append(formatted, fmt.Sprint(value))
+				}
+				cell = strings.Join(formatted, ",")
+			}
+			customRow.Cells = append(customRow.Cells, cell)
+		}
+
+		customTable.Rows = append(customTable.Rows, customRow)
+	}
+
+	return customTable, nil
+}
+
+func getColumnIndex(table *metav1.Table, name string) int {
+	for i, column := range table.ColumnDefinitions {
+		if normalizeColumnName(column.Name) == normalizeColumnName(name) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func getColumnNames(table *metav1.Table) []string {
+	var names []string
+	for _, column := range table.ColumnDefinitions {
+		names = append(names, strings.ToLower(strings.ReplaceAll(column.Name, " ", "-")))
+	}
+
+	return names
+}
+
+// normalizeColumnName makes 'Cluster Name',
+// 'cluster-name' and 'CLUSTER_NAME' equivalent.
+func normalizeColumnName(name string) string {
+	name = strings.ToLower(name)
+	for _, separator := range []string{" ", "-", "_"} {
+		name = strings.ReplaceAll(name, separator, "")
+	}
+
+	return name
+}
+
+func isJSONPath(value string) bool {
+	return strings.HasPrefix(value, ".") || strings.HasPrefix(value, "{")
+}
+
+// newJSONPath parses a JSONPath expression, also
+// accepting the relaxed '.metadata.name' syntax.
+func newJSONPath(expression string) (*jsonpath.JSONPath, error) {
+	if !strings.HasPrefix(expression, "{") {
+		expression = fmt.Sprintf("{%s}", expression)
+	}
+
+	parser := jsonpath.New("column").AllowMissingKeys(true)
+	err := parser.Parse(expression)
+	if err != nil {
+		return nil, microerror.Maskf(invalidColumnError, "invalid JSONPath expression %#q: %s", expression, err)
+	}
+
+	return parser, nil
+}
+
+func findJSONPathValues(parser *jsonpath.JSONPath, object runtime.Object) ([]interface{}, error) {
+	if object == nil {
+		return nil, nil
+	} else if v := reflect.ValueOf(object); v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil, nil
+	}
+
+	var content map[string]interface{}
+	if u, ok := object.(*unstructured.Unstructured); ok {
+		content = u.UnstructuredContent()
+	} else {
+		var err error
+		content, err = runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	results, err := parser.FindResults(content)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var values []interface{}
+	for _, result := range results {
+		for _, value := range result {
+			if !value.IsValid() || !value.CanInterface() {
+				continue
+			}
+			values = append(values, value.Interface())
+		}
+	}
+
+	return values, nil
+}
+
+// isLess compares numbers, timestamps and durations by their
+// value, and everything else by its string representation.
+// Empty values are always sorted first.
+func isLess(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b != nil
+	}
+
+	if aNumber, ok := toNumber(a); ok {
+		if bNumber, ok := toNumber(b); ok {
+			return aNumber < bNumber
+		}
+	}
+
+	if aTime, ok := toTime(a); ok {
+		if bTime, ok := toTime(b); ok {
+			return aTime.Before(bTime)
+		}
+	}
+
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
+
+func toNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case time.Duration:
+		return float64(v), true
+	}
+
+	return 0, false
+}
+
+func toTime(value interface{}) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case metav1.Time:
+		return v.Time, true
+	case *metav1.Time:
+		if v != nil {
+			return v.Time, true
+		}
+	case Timestamp:
+		return v.Time.Time, true
+	}
+
+	return time.Time{}, false
+}
+
+// AddTableFormatsUsage documents the output formats handled by PrintTable
+// in the usage of the --output flag added by genericclioptions.PrintFlags.
+func AddTableFormatsUsage(cmd *cobra.Command) {
+	outputFlag := cmd.Flags().Lookup("output")
+	if outputFlag == nil {
+		return
+	}
+
+	outputFlag.Usage = strings.Replace(outputFlag.Usage, "One of: ", fmt.Sprintf("One of: %s|%s=...|", TypeWide, TypeCustomColumns), 1)
+}
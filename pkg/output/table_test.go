@@ -0,0 +1,173 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/giantswarm/microerror"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/giantswarm/kubectl-gs/test/goldenfile"
+)
+
+// TestPrintTable uses golden files.
+//
+//  go test ./pkg/output -run TestPrintTable -update
+//
+func TestPrintTable(t *testing.T) {
+	testCases := []struct {
+		name               string
+		outputFormat       string
+		sortBy             string
+		noHeaders          bool
+		expectedGoldenFile string
+		errorMatcher       func(error) bool
+	}{
+		{
+			name:               "case 0: print table",
+			outputFormat:       TypeDefault,
+			expectedGoldenFile: "print_table.golden",
+		},
+		{
+			name:               "case 1: print table, sorted by column name",
+			outputFormat:       TypeDefault,
+			sortBy:             "display-name",
+			expectedGoldenFile: "print_table_sorted_by_column.golden",
+		},
+		{
+			name:               "case 2: print table, sorted by number column",
+			outputFormat:       TypeDefault,
+			sortBy:             "REPLICAS",
+			expectedGoldenFile: "print_table_sorted_by_number.golden",
+		},
+		{
+			name:               "case 3: print table, sorted by JSONPath expression",
+			outputFormat:       TypeDefault,
+			sortBy:             ".metadata.creationTimestamp",
+			expectedGoldenFile: "print_table_sorted_by_jsonpath.golden",
+		},
+		{
+			name:               "case 4: print wide table",
+			outputFormat:       TypeWide,
+			expectedGoldenFile: "print_table_wide.golden",
+		},
+		{
+			name:               "case 5: print table with custom columns",
+			outputFormat:       "custom-columns=NAME:.metadata.name,APP:.metadata.labels.app,MISSING:.metadata.labels.missing",
+			sortBy:             "name",
+			expectedGoldenFile: "print_table_custom_columns.golden",
+		},
+		{
+			name:               "case 6: print table without headers",
+			outputFormat:       TypeDefault,
+			noHeaders:          true,
+			expectedGoldenFile: "print_table_no_headers.golden",
+		},
+		{
+			name:         "case 7: sort by unknown column",
+			outputFormat: TypeDefault,
+			sortBy:       "unknown",
+			errorMatcher: IsInvalidColumn,
+		},
+		{
+			name:         "case 8: custom columns with invalid format",
+			outputFormat: "custom-columns=NAME",
+			errorMatcher: IsInvalidColumn,
+		},
+		{
+			name:         "case 9: custom columns with invalid JSONPath expression",
+			outputFormat: "custom-columns=NAME:{.metadata.name",
+			errorMatcher: IsInvalidColumn,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			options := TableOptions{
+				OutputFormat: &tc.outputFormat,
+				SortBy:       tc.sortBy,
+				NoHeaders:    tc.noHeaders,
+			}
+
+			out := new(bytes.Buffer)
+			err := PrintTable(out, newTable(), options)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", microerror.Pretty(err, true))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			gf := goldenfile.New("testdata", tc.expectedGoldenFile)
+			expectedResult, err := gf.Read()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if *update {
+				err = gf.Update(out.Bytes())
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+			}
+
+			diff := cmp.Diff(string(expectedResult), out.String())
+			if diff != "" {
+				t.Fatalf("value not expected, got:\n %s", diff)
+			}
+		})
+	}
+}
+
+func newTable() *metav1.Table {
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Display Name", Type: "string"},
+			{Name: "Replicas", Type: "integer"},
+			{Name: "App", Type: "string", Priority: 1},
+		},
+	}
+
+	rows := []struct {
+		name        string
+		displayName string
+		replicas    int64
+		app         string
+		created     time.Time
+	}{
+		{"d01s1", "beta", 3, "nginx", time.Date(2021, 1, 3, 15, 4, 32, 0, time.UTC)},
+		{"asbv2", "gamma", 12, "", time.Date(2021, 1, 1, 15, 4, 32, 0, time.UTC)},
+		{"ffs1s", "alpha", 1, "redis", time.Date(2021, 1, 2, 15, 4, 32, 0, time.UTC)},
+	}
+
+	for _, row := range rows {
+		resource := newResource(row.name)
+		resource.CreationTimestamp = metav1.NewTime(row.created)
+		if len(row.app) > 0 {
+			resource.Labels = map[string]string{
+				"app": row.app,
+			}
+		}
+
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells: []interface{}{
+				row.name,
+				row.displayName,
+				row.replicas,
+				row.app,
+			},
+			Object: runtime.RawExtension{
+				Object: resource,
+			},
+		})
+	}
+
+	return table
+}
@@ -0,0 +1,51 @@
+package output
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	TimestampsAbsolute = "absolute"
+	TimestampsRelative = "relative"
+)
+
+// TimestampFormats are the values accepted by the --timestamps flag.
+var TimestampFormats = []string{TimestampsAbsolute, TimestampsRelative}
+
+// IsTimestampFormat is true for the values accepted by the --timestamps flag.
+func IsTimestampFormat(format string) bool {
+	for _, f := range TimestampFormats {
+		if format == f {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Timestamp is a table cell holding a point in time. It is printed either
+// as the time elapsed since then, like the AGE column of kubectl, or as an
+// absolute date in UTC. Either way, tables are sorted by the time itself.
+type Timestamp struct {
+	Time     metav1.Time
+	Absolute bool
+}
+
+// NewTimestamp creates a table cell printing the given
+// time in one of the TimestampFormats.
+func NewTimestamp(t metav1.Time, format string) Timestamp {
+	return Timestamp{
+		Time:     t,
+		Absolute: format == TimestampsAbsolute,
+	}
+}
+
+func (t Timestamp) String() string {
+	if t.Absolute && !t.Time.IsZero() {
+		return t.Time.UTC().Format(time.RFC3339)
+	}
+
+	return TranslateTimestampSince(t.Time)
+}
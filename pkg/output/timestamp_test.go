@@ -0,0 +1,62 @@
+package output
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTimestamp(t *testing.T) {
+	testCases := []struct {
+		name           string
+		time           metav1.Time
+		format         string
+		expectedResult string
+	}{
+		{
+			name:           "case 0: relative timestamp",
+			time:           metav1.NewTime(time.Now().Add(-50 * time.Hour)),
+			format:         TimestampsRelative,
+			expectedResult: "2d2h",
+		},
+		{
+			name:           "case 1: absolute timestamp",
+			time:           metav1.NewTime(time.Date(2021, 1, 2, 15, 4, 32, 0, time.FixedZone("CET", 3600))),
+			format:         TimestampsAbsolute,
+			expectedResult: "2021-01-02T14:04:32Z",
+		},
+		{
+			name:           "case 2: relative timestamp, without time",
+			format:         TimestampsRelative,
+			expectedResult: "<unknown>",
+		},
+		{
+			name:           "case 3: absolute timestamp, without time",
+			format:         TimestampsAbsolute,
+			expectedResult: "<unknown>",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := NewTimestamp(tc.time, tc.format).String()
+			if result != tc.expectedResult {
+				t.Fatalf("expected %#q, got %#q", tc.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestTimestamp_isLess(t *testing.T) {
+	older := NewTimestamp(metav1.NewTime(time.Now().Add(-9*24*time.Hour)), TimestampsRelative)
+	newer := NewTimestamp(metav1.NewTime(time.Now().Add(-10*time.Hour)), TimestampsRelative)
+
+	// Printed as "9d" and "10h", which would sort the other way around as strings.
+	if !isLess(older, newer) {
+		t.Fatalf("expected %s to be sorted before %s", older, newer)
+	}
+	if isLess(newer, older) {
+		t.Fatalf("expected %s to be sorted after %s", newer, older)
+	}
+}
@@ -0,0 +1,264 @@
+// Package apply submits templated manifests to the management cluster, for
+// the template commands to create the objects they render.
+package apply
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	applicationv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/application/v1alpha1"
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	securityv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/security/v1alpha1"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capiexpv1alpha3 "sigs.k8s.io/cluster-api/exp/api/v1alpha3"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/giantswarm/kubectl-gs/pkg/data/domain/app"
+	"github.com/giantswarm/kubectl-gs/pkg/template/outputdir"
+)
+
+const (
+	// DryRunNone creates the objects.
+	DryRunNone = "none"
+	// DryRunServer submits the objects to the API server without
+	// persisting them, which validates them against the CRDs and webhooks.
+	DryRunServer = "server"
+
+	waitInterval = 10 * time.Second
+)
+
+// DryRunStrategies are the supported values of the --dry-run flag.
+var DryRunStrategies = []string{DryRunNone, DryRunServer}
+
+// IsDryRunStrategy tells whether the given value of the --dry-run flag is
+// supported.
+func IsDryRunStrategy(s string) bool {
+	for _, strategy := range DryRunStrategies {
+		if s == strategy {
+			return true
+		}
+	}
+
+	return false
+}
+
+type Config struct {
+	Client runtimeclient.Client
+	DryRun bool
+	// Stdout is where the names of the created and ready objects are
+	// printed to.
+	Stdout io.Writer
+}
+
+// Writer collects the multi-document YAML stream written to it. Apply then
+// creates the objects of the stream, in the order they were written.
+type Writer struct {
+	client runtimeclient.Client
+	dryRun bool
+	stdout io.Writer
+
+	buffer  bytes.Buffer
+	objects []*unstructured.Unstructured
+}
+
+func New(config Config) (*Writer, error) {
+	if config.Client == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Client must not be empty", config)
+	}
+	if config.Stdout == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Stdout must not be empty", config)
+	}
+
+	w := &Writer{
+		client: config.Client,
+		dryRun: config.DryRun,
+		stdout: config.Stdout,
+	}
+
+	return w, nil
+}
+
+// NewConfigFlags returns the kubeconfig flags of the template commands. Only
+// --kubeconfig and --context are offered, as the template commands define
+// flags like --namespace and --cluster for the objects they render.
+func NewConfigFlags() *genericclioptions.ConfigFlags {
+	kubeConfig := ""
+	kubeContext := ""
+
+	return &genericclioptions.ConfigFlags{
+		KubeConfig: &kubeConfig,
+		Context:    &kubeContext,
+	}
+}
+
+func (w *Writer) Write(p []byte) (int, error) {
+	return w.buffer.Write(p)
+}
+
+// Apply creates the objects collected so far and prints their names. It
+// stops at the first object that cannot be created, the objects created
+// until then are kept.
+func (w *Writer) Apply(ctx context.Context) error {
+	var objects []*unstructured.Unstructured
+	for _, document := range outputdir.SplitDocuments(w.buffer.Bytes()) {
+		jsonData, err := yaml.YAMLToJSON(document)
+		if err != nil {
+			return microerror.Maskf(invalidManifestError, "%s", err)
+		}
+
+		object := &unstructured.Unstructured{}
+		err = object.UnmarshalJSON(jsonData)
+		if err != nil {
+			return microerror.Maskf(invalidManifestError, "%s", err)
+		}
+
+		objects = append(objects, object)
+	}
+	w.buffer.Reset()
+
+	var options []runtimeclient.CreateOption
+	suffix := ""
+	if w.dryRun {
+		options = append(options, runtimeclient.DryRunAll)
+		suffix = " (server dry run)"
+	}
+
+	for _, object := range objects {
+		err := w.client.Create(ctx, object, options...)
+		if apierrors.IsAlreadyExists(err) {
+			return microerror.Maskf(alreadyExistsError, "%s already exists", Reference(object))
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+
+		w.objects = append(w.objects, object)
+		fmt.Fprintf(w.stdout, "%s created%s\n", Reference(object), suffix)
+	}
+
+	return nil
+}
+
+// Wait polls the objects created by Apply until all of them are ready, see
+// Ready, and prints their names as they become ready.
+func (w *Writer) Wait(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	pending := w.objects
+	condition := func() (bool, error) {
+		var stillPending []*unstructured.Unstructured
+		for _, object := range pending {
+			current := &unstructured.Unstructured{}
+			current.SetGroupVersionKind(object.GroupVersionKind())
+
+			err := w.client.Get(ctx, runtimeclient.ObjectKey{Namespace: object.GetNamespace(), Name: object.GetName()}, current)
+			if apierrors.IsNotFound(err) {
+				return false, microerror.Maskf(notFoundError, "%s was deleted while waiting for it", Reference(object))
+			} else if err != nil {
+				return false, microerror.Mask(err)
+			}
+
+			if Ready(current) {
+				fmt.Fprintf(w.stdout, "%s ready\n", Reference(object))
+			} else {
+				stillPending = append(stillPending, object)
+			}
+		}
+		pending = stillPending
+
+		return len(pending) == 0, nil
+	}
+
+	err := wait.PollImmediateUntil(waitInterval, condition, ctx.Done())
+	if err == wait.ErrWaitTimeout {
+		var names []string
+		for _, object := range pending {
+			names = append(names, Reference(object))
+		}
+
+		return microerror.Maskf(waitTimeoutError, "%s not ready after %s", strings.Join(names, ", "), timeout)
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// Ready tells whether the controllers are done with the given object, along
+// the lines of get cluster-health. Apps are ready once their release is
+// deployed and organizations once their namespace exists. Clusters are ready
+// once their Ready condition is true or they are provisioned, Giant Swarm
+// AWS clusters once they are created. Node pools are ready once all their
+// desired nodes are ready. Any other object is ready once it exists.
+func Ready(object *unstructured.Unstructured) bool {
+	gvk := object.GroupVersionKind()
+
+	switch {
+	case gvk.Group == applicationv1alpha1.SchemeGroupVersion.Group && gvk.Kind == "App":
+		status, _, _ := unstructured.NestedString(object.Object, "status", "release", "status")
+		return status == app.ReleaseStatusDeployed
+
+	case gvk.Group == securityv1alpha1.SchemeGroupVersion.Group && gvk.Kind == "Organization":
+		namespace, _, _ := unstructured.NestedString(object.Object, "status", "namespace")
+		return namespace != ""
+
+	case gvk.Group == infrastructurev1alpha3.SchemeGroupVersion.Group && gvk.Kind == "AWSCluster":
+		conditions, _, _ := unstructured.NestedSlice(object.Object, "status", "cluster", "conditions")
+		if len(conditions) < 1 {
+			return false
+		}
+		condition, _, _ := unstructured.NestedString(toMap(conditions[0]), "condition")
+		return condition == infrastructurev1alpha3.ClusterStatusConditionCreated || condition == infrastructurev1alpha3.ClusterStatusConditionUpdated
+
+	case gvk.Group == capiv1alpha3.GroupVersion.Group && gvk.Kind == "Cluster":
+		conditions, _, _ := unstructured.NestedSlice(object.Object, "status", "conditions")
+		for _, c := range conditions {
+			condition := toMap(c)
+			if condition["type"] == string(capiv1alpha3.ReadyCondition) {
+				return condition["status"] == string(corev1.ConditionTrue)
+			}
+		}
+		phase, _, _ := unstructured.NestedString(object.Object, "status", "phase")
+		return phase == string(capiv1alpha3.ClusterPhaseProvisioned)
+
+	case (gvk.Group == capiv1alpha3.GroupVersion.Group && gvk.Kind == "MachineDeployment") ||
+		(gvk.Group == capiexpv1alpha3.GroupVersion.Group && gvk.Kind == "MachinePool"):
+		replicas, found, _ := unstructured.NestedInt64(object.Object, "spec", "replicas")
+		if !found {
+			replicas, _, _ = unstructured.NestedInt64(object.Object, "status", "replicas")
+		}
+		readyReplicas, _, _ := unstructured.NestedInt64(object.Object, "status", "readyReplicas")
+		return readyReplicas >= replicas
+	}
+
+	return true
+}
+
+func toMap(v interface{}) map[string]interface{} {
+	m, _ := v.(map[string]interface{})
+	return m
+}
+
+// Reference returns the name kubectl uses for the given object, e.g.
+// app.application.giantswarm.io/nginx-ingress-controller-app.
+func Reference(object *unstructured.Unstructured) string {
+	gvk := object.GroupVersionKind()
+
+	resource := strings.ToLower(gvk.Kind)
+	if gvk.Group != "" {
+		resource += "." + gvk.Group
+	}
+
+	return resource + "/" + object.GetName()
+}
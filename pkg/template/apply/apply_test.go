@@ -0,0 +1,311 @@
+package apply
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/giantswarm/micrologger/microloggertest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
+)
+
+const manifests = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: nginx-ingress-controller-app-userconfig-a1b2c
+  namespace: a1b2c
+data:
+  values: |
+    replicas: 2
+---
+apiVersion: application.giantswarm.io/v1alpha1
+kind: App
+metadata:
+  name: nginx-ingress-controller-app
+  namespace: a1b2c
+spec:
+  catalog: giantswarm
+  name: nginx-ingress-controller-app
+  namespace: kube-system
+  version: 1.17.0
+`
+
+func TestWriter_Apply(t *testing.T) {
+	testCases := []struct {
+		name           string
+		dryRun         bool
+		existing       runtime.Object
+		expectedOutput string
+		errorMatcher   func(error) bool
+	}{
+		{
+			name: "case 0: objects created",
+			expectedOutput: `configmap/nginx-ingress-controller-app-userconfig-a1b2c created
+app.application.giantswarm.io/nginx-ingress-controller-app created
+`,
+		},
+		{
+			name:   "case 1: server dry run",
+			dryRun: true,
+			expectedOutput: `configmap/nginx-ingress-controller-app-userconfig-a1b2c created (server dry run)
+app.application.giantswarm.io/nginx-ingress-controller-app created (server dry run)
+`,
+		},
+		{
+			name: "case 2: object existing already",
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "nginx-ingress-controller-app-userconfig-a1b2c",
+					Namespace: "a1b2c",
+				},
+			},
+			errorMatcher: IsAlreadyExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			fakeClient, err := client.NewFakeClient(client.Config{Logger: microloggertest.New()})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			k8sClient := fakeClient.K8sClient.CtrlClient()
+
+			if tc.existing != nil {
+				err = k8sClient.Create(ctx, tc.existing)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+			}
+
+			out := new(bytes.Buffer)
+			w, err := New(Config{
+				Client: k8sClient,
+				DryRun: tc.dryRun,
+				Stdout: out,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			_, err = w.Write([]byte(manifests))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			err = w.Apply(ctx)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			diff := cmp.Diff(tc.expectedOutput, out.String())
+			if diff != "" {
+				t.Fatalf("value not expected, got:\n %s", diff)
+			}
+
+			app := &unstructured.Unstructured{}
+			app.SetAPIVersion("application.giantswarm.io/v1alpha1")
+			app.SetKind("App")
+			err = k8sClient.Get(ctx, runtimeclient.ObjectKey{Namespace: "a1b2c", Name: "nginx-ingress-controller-app"}, app)
+			if tc.dryRun && !apierrors.IsNotFound(err) {
+				t.Fatalf("expected the app not to be created, got: %v", err)
+			} else if !tc.dryRun && err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+		})
+	}
+}
+
+func TestWriter_Wait(t *testing.T) {
+	testCases := []struct {
+		name           string
+		manifests      string
+		expectedOutput string
+		errorMatcher   func(error) bool
+	}{
+		{
+			name: "case 0: objects ready on creation",
+			manifests: `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: a1b2c-values
+  namespace: a1b2c
+`,
+			expectedOutput: `configmap/a1b2c-values created
+configmap/a1b2c-values ready
+`,
+		},
+		{
+			name:         "case 1: app not deployed in time",
+			manifests:    manifests,
+			errorMatcher: IsWaitTimeout,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			fakeClient, err := client.NewFakeClient(client.Config{Logger: microloggertest.New()})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			out := new(bytes.Buffer)
+			w, err := New(Config{
+				Client: fakeClient.K8sClient.CtrlClient(),
+				Stdout: out,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			_, err = w.Write([]byte(tc.manifests))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			err = w.Apply(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			err = w.Wait(ctx, 10*time.Millisecond)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			diff := cmp.Diff(tc.expectedOutput, out.String())
+			if diff != "" {
+				t.Fatalf("value not expected, got:\n %s", diff)
+			}
+		})
+	}
+}
+
+func Test_Ready(t *testing.T) {
+	testCases := []struct {
+		name          string
+		object        string
+		expectedReady bool
+	}{
+		{
+			name: "case 0: deployed app",
+			object: `apiVersion: application.giantswarm.io/v1alpha1
+kind: App
+status:
+  release:
+    status: deployed
+`,
+			expectedReady: true,
+		},
+		{
+			name: "case 1: app not deployed yet",
+			object: `apiVersion: application.giantswarm.io/v1alpha1
+kind: App
+`,
+		},
+		{
+			name: "case 2: organization with namespace",
+			object: `apiVersion: security.giantswarm.io/v1alpha1
+kind: Organization
+status:
+  namespace: org-acme
+`,
+			expectedReady: true,
+		},
+		{
+			name: "case 3: cluster not ready",
+			object: `apiVersion: cluster.x-k8s.io/v1alpha3
+kind: Cluster
+status:
+  conditions:
+  - type: Ready
+    status: "False"
+  phase: Provisioned
+`,
+		},
+		{
+			name: "case 4: provisioned cluster without conditions",
+			object: `apiVersion: cluster.x-k8s.io/v1alpha3
+kind: Cluster
+status:
+  phase: Provisioned
+`,
+			expectedReady: true,
+		},
+		{
+			name: "case 5: created Giant Swarm AWS cluster",
+			object: `apiVersion: infrastructure.giantswarm.io/v1alpha3
+kind: AWSCluster
+status:
+  cluster:
+    conditions:
+    - condition: Created
+`,
+			expectedReady: true,
+		},
+		{
+			name: "case 6: machine pool with nodes missing",
+			object: `apiVersion: exp.cluster.x-k8s.io/v1alpha3
+kind: MachinePool
+spec:
+  replicas: 3
+status:
+  readyReplicas: 2
+`,
+		},
+		{
+			name: "case 7: config map",
+			object: `apiVersion: v1
+kind: ConfigMap
+`,
+			expectedReady: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			jsonData, err := yaml.YAMLToJSON([]byte(tc.object))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			object := &unstructured.Unstructured{}
+			err = object.UnmarshalJSON(jsonData)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			ready := Ready(object)
+			if ready != tc.expectedReady {
+				t.Fatalf("expected ready %t, got %t", tc.expectedReady, ready)
+			}
+		})
+	}
+}
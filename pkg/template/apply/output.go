@@ -0,0 +1,158 @@
+package apply
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/giantswarm/microerror"
+	"github.com/spf13/afero"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/kubectl-gs/pkg/template/outputdir"
+)
+
+type OutputConfig struct {
+	// Apply creates the CRs in the management cluster.
+	Apply bool
+	// DryRun is the --dry-run strategy of Apply.
+	DryRun string
+	// GetClient returns the client of the management cluster. It is only
+	// called with Apply, so that writing the CRs works offline.
+	GetClient func() (runtimeclient.Client, error)
+	// Wait makes Flush wait for the created CRs to become
+	// ready, for at most WaitTimeout.
+	Wait        bool
+	WaitTimeout time.Duration
+
+	Fs afero.Fs
+	// Dir is the directory to write the CRs into, one file per CR.
+	Dir string
+	// Force allows overwriting existing files in Dir.
+	Force bool
+	// File is the file to write the CRs into.
+	File string
+
+	Stdout io.Writer
+}
+
+// Output is where the template commands write the CRs they render to. They
+// are created in the management cluster with Apply, written into Dir or File
+// if given, and printed to Stdout otherwise. Except for Stdout, nothing
+// happens before Flush is called.
+type Output struct {
+	io.Writer
+
+	applier     *Writer
+	wait        bool
+	waitTimeout time.Duration
+
+	outputDir *outputdir.Writer
+	dir       string
+
+	fs   afero.Fs
+	file string
+	data *bytes.Buffer
+
+	stdout io.Writer
+}
+
+func NewOutput(config OutputConfig) (*Output, error) {
+	if config.Apply && config.GetClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.GetClient must not be empty", config)
+	}
+	if (config.Dir != "" || config.File != "") && config.Fs == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Fs must not be empty", config)
+	}
+	if config.Stdout == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Stdout must not be empty", config)
+	}
+
+	o := &Output{
+		stdout: config.Stdout,
+	}
+
+	switch {
+	case config.Apply:
+		c, err := config.GetClient()
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		o.applier, err = New(Config{
+			Client: c,
+			DryRun: config.DryRun == DryRunServer,
+			Stdout: config.Stdout,
+		})
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+		o.wait = config.Wait
+		o.waitTimeout = config.WaitTimeout
+
+		o.Writer = o.applier
+	case config.Dir != "":
+		var err error
+		o.outputDir, err = outputdir.New(outputdir.Config{
+			Fs:    config.Fs,
+			Dir:   config.Dir,
+			Force: config.Force,
+		})
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+		o.dir = config.Dir
+
+		o.Writer = o.outputDir
+	case config.File != "":
+		o.fs = config.Fs
+		o.file = config.File
+		o.data = &bytes.Buffer{}
+
+		o.Writer = o.data
+	default:
+		o.Writer = config.Stdout
+	}
+
+	return o, nil
+}
+
+// Flush creates the CRs written so far in the management cluster and
+// optionally waits for them, or writes them into the directory and prints
+// the names of the files, or writes them into the file.
+func (o *Output) Flush(ctx context.Context) error {
+	switch {
+	case o.applier != nil:
+		err := o.applier.Apply(ctx)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		if o.wait {
+			err = o.applier.Wait(ctx, o.waitTimeout)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+		}
+	case o.outputDir != nil:
+		files, err := o.outputDir.Flush()
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		for _, file := range files {
+			fmt.Fprintln(o.stdout, filepath.Join(o.dir, file))
+		}
+	case o.data != nil:
+		err := afero.WriteFile(o.fs, o.file, o.data.Bytes(), 0644)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		o.data.Reset()
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package apply
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/giantswarm/micrologger/microloggertest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/kubectl-gs/pkg/data/client"
+)
+
+func TestOutput_Flush(t *testing.T) {
+	testCases := []struct {
+		name           string
+		config         OutputConfig
+		expectedOutput string
+		expectedFiles  map[string]string
+	}{
+		{
+			name: "case 0: apply",
+			config: OutputConfig{
+				Apply: true,
+			},
+			expectedOutput: `configmap/nginx-ingress-controller-app-userconfig-a1b2c created
+app.application.giantswarm.io/nginx-ingress-controller-app created
+`,
+		},
+		{
+			name: "case 1: output directory",
+			config: OutputConfig{
+				Dir: "a1b2c",
+			},
+			expectedOutput: `a1b2c/app-nginx-ingress-controller-app.yaml
+a1b2c/configmap-nginx-ingress-controller-app-userconfig-a1b2c.yaml
+`,
+			expectedFiles: map[string]string{
+				"a1b2c/kustomization.yaml": `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- app-nginx-ingress-controller-app.yaml
+- configmap-nginx-ingress-controller-app-userconfig-a1b2c.yaml
+`,
+			},
+		},
+		{
+			name: "case 2: output file",
+			config: OutputConfig{
+				File: "a1b2c.yaml",
+			},
+			expectedFiles: map[string]string{
+				"a1b2c.yaml": manifests,
+			},
+		},
+		{
+			name:           "case 3: stdout",
+			expectedOutput: manifests,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			fakeClient, err := client.NewFakeClient(client.Config{Logger: microloggertest.New()})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			fs := afero.NewMemMapFs()
+			out := new(bytes.Buffer)
+
+			config := tc.config
+			config.GetClient = func() (runtimeclient.Client, error) {
+				return fakeClient.K8sClient.CtrlClient(), nil
+			}
+			config.Fs = fs
+			config.Stdout = out
+
+			o, err := NewOutput(config)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			_, err = o.Write([]byte(manifests))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			err = o.Flush(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			diff := cmp.Diff(tc.expectedOutput, out.String())
+			if diff != "" {
+				t.Fatalf("value not expected, got:\n %s", diff)
+			}
+
+			for path, expected := range tc.expectedFiles {
+				data, err := afero.ReadFile(fs, path)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+
+				diff = cmp.Diff(expected, string(data))
+				if diff != "" {
+					t.Fatalf("value of %s not expected, got:\n %s", path, diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+// Package capv renders the CAPV cluster template, from which the vSphere
+// cluster and node pool CRs are taken.
+package capv
+
+import (
+	_ "embed"
+	"io/ioutil"
+	"os"
+	"strconv"
+
+	"github.com/giantswarm/microerror"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	clusterctlconfig "sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/repository"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/yamlprocessor"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+)
+
+// defaultClusterTemplate is the cluster template of the CAPV v0.7.8 release.
+// It is embedded so that templating works without access to GitHub.
+//
+//go:embed cluster-template.yaml
+var defaultClusterTemplate []byte
+
+type TemplateConfig struct {
+	ClusterName string
+	Namespace   string
+	// ClusterTemplate is the path of a CAPV cluster template to use instead
+	// of the embedded one.
+	ClusterTemplate string
+
+	ControlPlaneEndpoint string
+	Datacenter           string
+	Datastore            string
+	Network              string
+	ResourcePool         string
+	Server               string
+	Template             string
+
+	WorkerMachineCount int
+}
+
+// GetClusterTemplate returns the objects of the CAPV cluster template,
+// rendered with the given values. The template variables not set by
+// kubectl-gs are rendered as empty strings.
+func GetClusterTemplate(config TemplateConfig) ([]unstructured.Unstructured, error) {
+	var err error
+
+	if config.ClusterName == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.ClusterName must not be empty", config)
+	}
+	if config.Namespace == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Namespace must not be empty", config)
+	}
+
+	raw := defaultClusterTemplate
+	if config.ClusterTemplate != "" {
+		raw, err = ioutil.ReadFile(config.ClusterTemplate)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	c, err := clusterctlconfig.New("")
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	// The upstream client reads the template variables from the
+	// environment. Set them to the given values, or to empty strings for
+	// the ones not set by kubectl-gs, and make sure that they are reset.
+	values := map[string]string{
+		"CLUSTER_NAME":                config.ClusterName,
+		"NAMESPACE":                   config.Namespace,
+		"KUBERNETES_VERSION":          key.VSphereKubernetesVersion,
+		"CONTROL_PLANE_MACHINE_COUNT": "1",
+		"WORKER_MACHINE_COUNT":        strconv.Itoa(config.WorkerMachineCount),
+		"CONTROL_PLANE_ENDPOINT_IP":   config.ControlPlaneEndpoint,
+		"VSPHERE_DATACENTER":          config.Datacenter,
+		"VSPHERE_DATASTORE":           config.Datastore,
+		"VSPHERE_NETWORK":             config.Network,
+		"VSPHERE_RESOURCE_POOL":       config.ResourcePool,
+		"VSPHERE_SERVER":              config.Server,
+		"VSPHERE_TEMPLATE":            config.Template,
+	}
+	envVars := []string{
+		"CLUSTER_NAME",
+		"NAMESPACE",
+		"KUBERNETES_VERSION",
+		"CONTROL_PLANE_MACHINE_COUNT",
+		"WORKER_MACHINE_COUNT",
+	}
+	envVars = append(envVars, key.GetCAPVEnvVars()...)
+	for _, envVar := range envVars {
+		if prevEnv, ok := os.LookupEnv(envVar); ok {
+			defer os.Setenv(envVar, prevEnv)
+		} else {
+			defer os.Unsetenv(envVar)
+		}
+		os.Setenv(envVar, values[envVar])
+	}
+
+	clusterTemplate, err := repository.NewTemplate(repository.TemplateInput{
+		RawArtifact:           raw,
+		ConfigVariablesClient: c.Variables(),
+		Processor:             yamlprocessor.NewSimpleProcessor(),
+		TargetNamespace:       config.Namespace,
+	})
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return clusterTemplate.Objs(), nil
+}
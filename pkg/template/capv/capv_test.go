@@ -0,0 +1,110 @@
+package capv
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func Test_GetClusterTemplate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		config        TemplateConfig
+		expectedKinds []string
+		errorMatcher  func(error) bool
+	}{
+		{
+			name: "case 0: built-in template",
+			config: TemplateConfig{
+				ClusterName:          "a1b2c",
+				Namespace:            "org-acme",
+				ControlPlaneEndpoint: "10.0.0.10",
+				Datacenter:           "dc0",
+				Server:               "vcenter.example.com",
+				WorkerMachineCount:   2,
+			},
+			expectedKinds: []string{
+				"Cluster",
+				"VSphereCluster",
+				"VSphereMachineTemplate",
+				"KubeadmControlPlane",
+				"KubeadmConfigTemplate",
+				"MachineDeployment",
+				"ClusterResourceSet",
+				"Secret",
+			},
+		},
+		{
+			name: "case 1: missing cluster name",
+			config: TemplateConfig{
+				Namespace: "org-acme",
+			},
+			errorMatcher: IsInvalidConfig,
+		},
+		{
+			name: "case 2: missing namespace",
+			config: TemplateConfig{
+				ClusterName: "a1b2c",
+			},
+			errorMatcher: IsInvalidConfig,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := os.Setenv("VSPHERE_SERVER", "previous.example.com")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			defer os.Unsetenv("VSPHERE_SERVER")
+
+			objects, err := GetClusterTemplate(tc.config)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(errors.Cause(err)) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if os.Getenv("VSPHERE_SERVER") != "previous.example.com" {
+				t.Fatalf("expected VSPHERE_SERVER to be reset, got %#q", os.Getenv("VSPHERE_SERVER"))
+			}
+
+			var kinds []string
+			for _, o := range objects {
+				kinds = append(kinds, o.GetKind())
+				if o.GetNamespace() != tc.config.Namespace {
+					t.Fatalf("expected namespace %#q for %s, got %#q", tc.config.Namespace, o.GetKind(), o.GetNamespace())
+				}
+			}
+			if diff := cmp.Diff(tc.expectedKinds, kinds); diff != "" {
+				t.Fatalf("kinds not expected, got:\n %s", diff)
+			}
+
+			for _, o := range objects {
+				switch o.GetKind() {
+				case "VSphereCluster":
+					server, _, _ := unstructured.NestedString(o.Object, "spec", "server")
+					if server != tc.config.Server {
+						t.Fatalf("expected server %#q, got %#q", tc.config.Server, server)
+					}
+					host, _, _ := unstructured.NestedString(o.Object, "spec", "controlPlaneEndpoint", "host")
+					if host != tc.config.ControlPlaneEndpoint {
+						t.Fatalf("expected control plane endpoint %#q, got %#q", tc.config.ControlPlaneEndpoint, host)
+					}
+				case "MachineDeployment":
+					replicas, _, _ := unstructured.NestedFieldNoCopy(o.Object, "spec", "replicas")
+					if replicas != float64(tc.config.WorkerMachineCount) {
+						t.Fatalf("expected %d replicas, got %v", tc.config.WorkerMachineCount, replicas)
+					}
+				}
+			}
+		})
+	}
+}
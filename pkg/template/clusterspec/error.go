@@ -0,0 +1,14 @@
+package clusterspec
+
+import (
+	"github.com/giantswarm/microerror"
+)
+
+var invalidSpecError = &microerror.Error{
+	Kind: "invalidSpecError",
+}
+
+// IsInvalidSpec asserts invalidSpecError.
+func IsInvalidSpec(err error) bool {
+	return microerror.Cause(err) == invalidSpecError
+}
@@ -0,0 +1,164 @@
+package clusterspec
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/giantswarm/microerror"
+	"github.com/spf13/afero"
+	"github.com/xeipuuv/gojsonschema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+)
+
+// ReadFile reads the cluster spec stored at the given path. See Parse.
+func ReadFile(fs afero.Fs, path string) (*Spec, error) {
+	data, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	s, err := Parse(data)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return s, nil
+}
+
+// Parse decodes a YAML or JSON cluster spec after validating it against
+// the schema of the supported spec version.
+func Parse(data []byte) (*Spec, error) {
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, microerror.Maskf(invalidSpecError, "spec is not valid YAML: %s", err)
+	}
+
+	err = validateSchema(jsonData)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	s := &Spec{}
+	{
+		decoder := json.NewDecoder(bytes.NewReader(jsonData))
+		decoder.DisallowUnknownFields()
+
+		err = decoder.Decode(s)
+		if err != nil {
+			return nil, microerror.Maskf(invalidSpecError, "%s", err)
+		}
+	}
+
+	return s, nil
+}
+
+// Validate checks the spec against the schema, and the node pool rules that
+// the schema cannot express, like settings that only apply to one provider.
+// It is meant to be called on the effective spec, after flags have been
+// applied and defaults filled in.
+func (s *Spec) Validate() error {
+	{
+		jsonData, err := json.Marshal(s)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = validateSchema(jsonData)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	if s.Provider == key.ProviderKVM {
+		if len(s.NodePools) > 0 {
+			return microerror.Maskf(invalidSpecError, "nodePools cannot be used with provider %s, KVM clusters have no node pools, set the workers in kvm instead", s.Provider)
+		}
+	} else if s.KVM != nil {
+		return microerror.Maskf(invalidSpecError, "kvm settings cannot be used with provider %s", s.Provider)
+	}
+
+	if s.Provider != key.ProviderVSphere && s.VSphere != nil {
+		return microerror.Maskf(invalidSpecError, "vsphere settings cannot be used with provider %s", s.Provider)
+	}
+	if s.Provider != key.ProviderOpenStack && s.OpenStack != nil {
+		return microerror.Maskf(invalidSpecError, "openstack settings cannot be used with provider %s", s.Provider)
+	}
+
+	for i, np := range s.NodePools {
+		if np.NodesMin != nil && np.NodesMax != nil && *np.NodesMin > *np.NodesMax {
+			return microerror.Maskf(invalidSpecError, "nodePools.%d: nodesMin must be <= nodesMax", i)
+		}
+
+		switch s.Provider {
+		case key.ProviderAWS:
+			if np.Azure != nil {
+				return microerror.Maskf(invalidSpecError, "nodePools.%d: azure settings cannot be used with provider %s", i, s.Provider)
+			}
+			// XXX: The availability zones can be left out on Azure only.
+			if len(np.AvailabilityZones) < 1 {
+				return microerror.Maskf(invalidSpecError, "nodePools.%d: availabilityZones must contain at least 1 AZ", i)
+			}
+		case key.ProviderAzure:
+			if np.AWS != nil {
+				return microerror.Maskf(invalidSpecError, "nodePools.%d: aws settings cannot be used with provider %s", i, s.Provider)
+			}
+		case key.ProviderOpenStack:
+			if np.AWS != nil || np.Azure != nil {
+				return microerror.Maskf(invalidSpecError, "nodePools.%d: aws and azure settings cannot be used with provider %s", i, s.Provider)
+			}
+			if len(np.AvailabilityZones) > 1 {
+				return microerror.Maskf(invalidSpecError, "nodePools.%d: availabilityZones must contain at most 1 AZ on provider %s", i, s.Provider)
+			}
+		case key.ProviderVSphere:
+			if np.AWS != nil || np.Azure != nil {
+				return microerror.Maskf(invalidSpecError, "nodePools.%d: aws and azure settings cannot be used with provider %s", i, s.Provider)
+			}
+		}
+	}
+
+	names := map[string]bool{}
+	for i, np := range s.NodePools {
+		if np.Name == "" {
+			continue
+		}
+		if names[np.Name] {
+			return microerror.Maskf(invalidSpecError, "nodePools.%d: name %#q is used more than once", i, np.Name)
+		}
+		names[np.Name] = true
+	}
+
+	appNames := map[string]bool{}
+	for i, app := range s.Apps {
+		name := app.AppName
+		if name == "" {
+			name = app.Name
+		}
+		if appNames[name] {
+			return microerror.Maskf(invalidSpecError, "apps.%d: app %#q is listed more than once, set appName to tell them apart", i, name)
+		}
+		appNames[name] = true
+	}
+
+	return nil
+}
+
+func validateSchema(jsonData []byte) error {
+	result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(schema), gojsonschema.NewBytesLoader(jsonData))
+	if err != nil {
+		return microerror.Maskf(invalidSpecError, "%s", err)
+	}
+
+	if !result.Valid() {
+		var messages []string
+		for _, e := range result.Errors() {
+			messages = append(messages, e.String())
+		}
+
+		return microerror.Maskf(invalidSpecError, "%s", strings.Join(messages, ", "))
+	}
+
+	return nil
+}
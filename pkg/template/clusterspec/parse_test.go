@@ -0,0 +1,294 @@
+package clusterspec
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func Test_Parse(t *testing.T) {
+	testCases := []struct {
+		name         string
+		data         string
+		expectedSpec *Spec
+		errorMatcher func(error) bool
+	}{
+		{
+			name: "case 0: full spec",
+			data: `apiVersion: kubectl-gs.giantswarm.io/v1alpha1
+kind: ClusterSpec
+provider: aws
+name: a1b2c
+description: Production cluster
+owner: acme
+release: 16.0.1
+labels:
+  team: storage
+controlPlane:
+  availabilityZones: [eu-central-1a]
+  subnetSize: 24
+network:
+  podsCIDR: 10.2.0.0/16
+nodePools:
+- name: np001
+  description: Workers
+  availabilityZones: [eu-central-1b]
+  nodesMin: 0
+  aws:
+    instanceType: m5.2xlarge
+apps:
+- name: nginx-ingress-controller-app
+  catalog: giantswarm
+  namespace: kube-system
+  version: 1.17.0
+`,
+			expectedSpec: &Spec{
+				APIVersion:  APIVersion,
+				Kind:        Kind,
+				Provider:    "aws",
+				Name:        "a1b2c",
+				Description: "Production cluster",
+				Owner:       "acme",
+				Release:     "16.0.1",
+				Labels:      map[string]string{"team": "storage"},
+				ControlPlane: &ControlPlane{
+					AvailabilityZones: []string{"eu-central-1a"},
+					SubnetSize:        24,
+				},
+				Network: &Network{
+					PodsCIDR: "10.2.0.0/16",
+				},
+				NodePools: []NodePool{
+					{
+						Name:              "np001",
+						Description:       "Workers",
+						AvailabilityZones: []string{"eu-central-1b"},
+						NodesMin:          toIntPtr(0),
+						AWS: &AWSNodePool{
+							InstanceType: "m5.2xlarge",
+						},
+					},
+				},
+				Apps: []App{
+					{
+						Name:      "nginx-ingress-controller-app",
+						Catalog:   "giantswarm",
+						Namespace: "kube-system",
+						Version:   "1.17.0",
+					},
+				},
+			},
+		},
+		{
+			name: "case 1: minimal spec",
+			data: `apiVersion: kubectl-gs.giantswarm.io/v1alpha1
+kind: ClusterSpec
+`,
+			expectedSpec: &Spec{
+				APIVersion: APIVersion,
+				Kind:       Kind,
+			},
+		},
+		{
+			name: "case 2: unsupported version",
+			data: `apiVersion: kubectl-gs.giantswarm.io/v1alpha2
+kind: ClusterSpec
+`,
+			errorMatcher: IsInvalidSpec,
+		},
+		{
+			name: "case 3: unknown field",
+			data: `apiVersion: kubectl-gs.giantswarm.io/v1alpha1
+kind: ClusterSpec
+organization: acme
+`,
+			errorMatcher: IsInvalidSpec,
+		},
+		{
+			name: "case 4: release given as a number",
+			data: `apiVersion: kubectl-gs.giantswarm.io/v1alpha1
+kind: ClusterSpec
+release: 16.0
+`,
+			errorMatcher: IsInvalidSpec,
+		},
+		{
+			name: "case 5: node pool without description",
+			data: `apiVersion: kubectl-gs.giantswarm.io/v1alpha1
+kind: ClusterSpec
+nodePools:
+- name: np001
+`,
+			errorMatcher: IsInvalidSpec,
+		},
+		{
+			name:         "case 6: not YAML",
+			data:         "apiVersion: [",
+			errorMatcher: IsInvalidSpec,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec, err := Parse([]byte(tc.data))
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			diff := cmp.Diff(tc.expectedSpec, spec)
+			if diff != "" {
+				t.Fatalf("value not expected, got:\n %s", diff)
+			}
+		})
+	}
+}
+
+func TestSpec_Validate(t *testing.T) {
+	testCases := []struct {
+		name         string
+		spec         Spec
+		errorMatcher func(error) bool
+	}{
+		{
+			name: "case 0: valid node pools",
+			spec: Spec{
+				APIVersion: APIVersion,
+				Kind:       Kind,
+				Provider:   "aws",
+				NodePools: []NodePool{
+					{Name: "np001", Description: "Workers", AvailabilityZones: []string{"eu-central-1a"}},
+					{Name: "np002", Description: "Workers", AvailabilityZones: []string{"eu-central-1b"}},
+				},
+			},
+		},
+		{
+			name: "case 1: more minimum than maximum nodes",
+			spec: Spec{
+				APIVersion: APIVersion,
+				Kind:       Kind,
+				Provider:   "azure",
+				NodePools: []NodePool{
+					{Description: "Workers", NodesMin: toIntPtr(5), NodesMax: toIntPtr(3)},
+				},
+			},
+			errorMatcher: IsInvalidSpec,
+		},
+		{
+			name: "case 2: azure settings on aws",
+			spec: Spec{
+				APIVersion: APIVersion,
+				Kind:       Kind,
+				Provider:   "aws",
+				NodePools: []NodePool{
+					{Description: "Workers", AvailabilityZones: []string{"eu-central-1a"}, Azure: &AzureNodePool{SpotVMs: true}},
+				},
+			},
+			errorMatcher: IsInvalidSpec,
+		},
+		{
+			name: "case 3: aws node pool without availability zones",
+			spec: Spec{
+				APIVersion: APIVersion,
+				Kind:       Kind,
+				Provider:   "aws",
+				NodePools:  []NodePool{{Description: "Workers"}},
+			},
+			errorMatcher: IsInvalidSpec,
+		},
+		{
+			name: "case 4: duplicate node pool names",
+			spec: Spec{
+				APIVersion: APIVersion,
+				Kind:       Kind,
+				Provider:   "azure",
+				NodePools: []NodePool{
+					{Name: "np001", Description: "Workers"},
+					{Name: "np001", Description: "Workers"},
+				},
+			},
+			errorMatcher: IsInvalidSpec,
+		},
+		{
+			name: "case 5: name not matching the schema",
+			spec: Spec{
+				APIVersion: APIVersion,
+				Kind:       Kind,
+				Provider:   "azure",
+				Name:       "Production",
+			},
+			errorMatcher: IsInvalidSpec,
+		},
+		{
+			name: "case 6: kvm workers",
+			spec: Spec{
+				APIVersion: APIVersion,
+				Kind:       Kind,
+				Provider:   "kvm",
+				KVM:        &KVM{Workers: 5, CPUs: 8, Memory: 32, Storage: 100},
+			},
+		},
+		{
+			name: "case 7: node pools on kvm",
+			spec: Spec{
+				APIVersion: APIVersion,
+				Kind:       Kind,
+				Provider:   "kvm",
+				NodePools:  []NodePool{{Description: "Workers"}},
+			},
+			errorMatcher: IsInvalidSpec,
+		},
+		{
+			name: "case 8: kvm settings on azure",
+			spec: Spec{
+				APIVersion: APIVersion,
+				Kind:       Kind,
+				Provider:   "azure",
+				KVM:        &KVM{Workers: 5},
+			},
+			errorMatcher: IsInvalidSpec,
+		},
+		{
+			name: "case 9: aws settings on vsphere",
+			spec: Spec{
+				APIVersion: APIVersion,
+				Kind:       Kind,
+				Provider:   "vsphere",
+				VSphere:    &VSphere{Datacenter: "dc0"},
+				NodePools: []NodePool{
+					{Description: "Workers", AWS: &AWSNodePool{InstanceType: "m5.xlarge"}},
+				},
+			},
+			errorMatcher: IsInvalidSpec,
+		},
+		{
+			name: "case 10: openstack settings on vsphere",
+			spec: Spec{
+				APIVersion: APIVersion,
+				Kind:       Kind,
+				Provider:   "vsphere",
+				OpenStack:  &OpenStack{Cloud: "openstack"},
+			},
+			errorMatcher: IsInvalidSpec,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.spec.Validate()
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package clusterspec
+
+// schema is the JSON schema cluster spec files are validated against. It
+// covers the structure and the value ranges. Rules spanning several fields
+// are checked in Validate.
+const schema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "additionalProperties": false,
+  "required": ["apiVersion", "kind"],
+  "properties": {
+    "apiVersion": {"type": "string", "enum": ["kubectl-gs.giantswarm.io/v1alpha1"]},
+    "kind": {"type": "string", "enum": ["ClusterSpec"]},
+    "provider": {"type": "string", "enum": ["aws", "azure", "kvm", "openstack", "vsphere"]},
+    "name": {"type": "string", "pattern": "^[a-z][a-z0-9]{4}$"},
+    "description": {"type": "string"},
+    "owner": {"type": "string", "minLength": 1},
+    "release": {"type": "string", "pattern": "^v?[0-9]+\\.[0-9]+\\.[0-9]+(-.+)?$"},
+    "labels": {
+      "type": "object",
+      "additionalProperties": {"type": "string"}
+    },
+    "controlPlane": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "availabilityZones": {
+          "type": "array",
+          "uniqueItems": true,
+          "items": {"type": "string", "minLength": 1}
+        },
+        "subnetSize": {"type": "integer", "minimum": 20, "maximum": 25}
+      }
+    },
+    "network": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "externalSNAT": {"type": "boolean"},
+        "podsCIDR": {"type": "string", "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}/[0-9]{1,2}$"}
+      }
+    },
+    "kvm": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "workers": {"type": "integer", "minimum": 1},
+        "cpus": {"type": "integer", "minimum": 1},
+        "memory": {"type": "integer", "minimum": 1},
+        "storage": {"type": "integer", "minimum": 1}
+      }
+    },
+    "vsphere": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "server": {"type": "string"},
+        "datacenter": {"type": "string"},
+        "datastore": {"type": "string"},
+        "network": {"type": "string"},
+        "resourcePool": {"type": "string"},
+        "template": {"type": "string"},
+        "controlPlaneEndpoint": {"type": "string"}
+      }
+    },
+    "openstack": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "cloud": {"type": "string"},
+        "externalNetworkID": {"type": "string"},
+        "dnsServers": {
+          "type": "array",
+          "items": {"type": "string", "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}$"}
+        },
+        "flavor": {"type": "string"},
+        "image": {"type": "string"}
+      }
+    },
+    "nodePools": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "additionalProperties": false,
+        "required": ["description"],
+        "properties": {
+          "name": {"type": "string", "pattern": "^[a-z0-9]{5}$"},
+          "description": {"type": "string", "minLength": 1},
+          "availabilityZones": {
+            "type": "array",
+            "uniqueItems": true,
+            "items": {"type": "string", "minLength": 1}
+          },
+          "nodesMin": {"type": "integer", "minimum": 0},
+          "nodesMax": {"type": "integer", "minimum": 0},
+          "aws": {
+            "type": "object",
+            "additionalProperties": false,
+            "properties": {
+              "instanceType": {"type": "string", "minLength": 1},
+              "subnetSize": {"type": "integer", "minimum": 20, "maximum": 28},
+              "onDemandBaseCapacity": {"type": "integer", "minimum": 0},
+              "onDemandPercentageAboveBaseCapacity": {"type": "integer", "minimum": 0, "maximum": 100},
+              "useAlikeInstanceTypes": {"type": "boolean"}
+            }
+          },
+          "azure": {
+            "type": "object",
+            "additionalProperties": false,
+            "properties": {
+              "vmSize": {"type": "string", "minLength": 1},
+              "spotVMs": {"type": "boolean"},
+              "spotVMsMaxPrice": {"type": "number"}
+            }
+          }
+        }
+      }
+    },
+    "apps": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "additionalProperties": false,
+        "required": ["name", "catalog", "namespace", "version"],
+        "properties": {
+          "name": {"type": "string", "minLength": 1},
+          "appName": {"type": "string"},
+          "catalog": {"type": "string", "minLength": 1},
+          "namespace": {"type": "string", "minLength": 1},
+          "version": {"type": "string", "minLength": 1},
+          "values": {"type": "object"}
+        }
+      }
+    }
+  }
+}`
@@ -0,0 +1,183 @@
+package clusterspec
+
+import (
+	"github.com/giantswarm/kubectl-gs/internal/key"
+)
+
+const (
+	// APIVersion is the version of the cluster spec file format understood
+	// by this version of kubectl-gs.
+	APIVersion = "kubectl-gs.giantswarm.io/v1alpha1"
+	// Kind is the kind of the cluster spec file.
+	Kind = "ClusterSpec"
+)
+
+const (
+	DefaultAWSInstanceType                     = "m5.xlarge"
+	DefaultAzureVMSize                         = "Standard_D4s_v3"
+	DefaultKVMCPUs                             = 4
+	DefaultKVMMemory                           = 16
+	DefaultKVMStorage                          = 50
+	DefaultKVMWorkers                          = 3
+	DefaultNodesMax                            = 10
+	DefaultNodesMin                            = 3
+	DefaultOnDemandPercentageAboveBaseCapacity = 100
+)
+
+// Spec describes a workload cluster, its node pools and the apps installed
+// into it, in a form that can be kept in version control.
+type Spec struct {
+	APIVersion   string            `json:"apiVersion"`
+	Kind         string            `json:"kind"`
+	Provider     string            `json:"provider"`
+	Name         string            `json:"name,omitempty"`
+	Description  string            `json:"description,omitempty"`
+	Owner        string            `json:"owner,omitempty"`
+	Release      string            `json:"release,omitempty"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	ControlPlane *ControlPlane     `json:"controlPlane,omitempty"`
+	Network      *Network          `json:"network,omitempty"`
+	KVM          *KVM              `json:"kvm,omitempty"`
+	VSphere      *VSphere          `json:"vsphere,omitempty"`
+	OpenStack    *OpenStack        `json:"openstack,omitempty"`
+	NodePools    []NodePool        `json:"nodePools,omitempty"`
+	Apps         []App             `json:"apps,omitempty"`
+}
+
+type ControlPlane struct {
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+	// SubnetSize is the size of the control plane subnet. AWS only.
+	SubnetSize int `json:"subnetSize,omitempty"`
+}
+
+// Network holds the cluster network settings. AWS only.
+type Network struct {
+	ExternalSNAT bool   `json:"externalSNAT,omitempty"`
+	PodsCIDR     string `json:"podsCIDR,omitempty"`
+}
+
+// KVM holds the worker settings of KVM clusters, which have no node pools.
+// Memory and storage are given in GB per node.
+type KVM struct {
+	Workers int `json:"workers,omitempty"`
+	CPUs    int `json:"cpus,omitempty"`
+	Memory  int `json:"memory,omitempty"`
+	Storage int `json:"storage,omitempty"`
+}
+
+// VSphere holds the vCenter placement of the machines of vSphere clusters,
+// shared by the control plane and the node pools. Template is the VM
+// template the machines are cloned from.
+type VSphere struct {
+	Server               string `json:"server,omitempty"`
+	Datacenter           string `json:"datacenter,omitempty"`
+	Datastore            string `json:"datastore,omitempty"`
+	Network              string `json:"network,omitempty"`
+	ResourcePool         string `json:"resourcePool,omitempty"`
+	Template             string `json:"template,omitempty"`
+	ControlPlaneEndpoint string `json:"controlPlaneEndpoint,omitempty"`
+}
+
+// OpenStack holds the cloud settings of OpenStack clusters. Cloud is the
+// name of the cloud in the clouds.yaml of the cluster. Flavor and image are
+// used by the control plane and the node pools.
+type OpenStack struct {
+	Cloud             string   `json:"cloud,omitempty"`
+	ExternalNetworkID string   `json:"externalNetworkID,omitempty"`
+	DNSServers        []string `json:"dnsServers,omitempty"`
+	Flavor            string   `json:"flavor,omitempty"`
+	Image             string   `json:"image,omitempty"`
+}
+
+type NodePool struct {
+	Name              string         `json:"name,omitempty"`
+	Description       string         `json:"description"`
+	AvailabilityZones []string       `json:"availabilityZones,omitempty"`
+	NodesMin          *int           `json:"nodesMin,omitempty"`
+	NodesMax          *int           `json:"nodesMax,omitempty"`
+	AWS               *AWSNodePool   `json:"aws,omitempty"`
+	Azure             *AzureNodePool `json:"azure,omitempty"`
+}
+
+type AWSNodePool struct {
+	InstanceType                        string `json:"instanceType,omitempty"`
+	SubnetSize                          int    `json:"subnetSize,omitempty"`
+	OnDemandBaseCapacity                int    `json:"onDemandBaseCapacity,omitempty"`
+	OnDemandPercentageAboveBaseCapacity *int   `json:"onDemandPercentageAboveBaseCapacity,omitempty"`
+	UseAlikeInstanceTypes               bool   `json:"useAlikeInstanceTypes,omitempty"`
+}
+
+type AzureNodePool struct {
+	VMSize          string  `json:"vmSize,omitempty"`
+	SpotVMs         bool    `json:"spotVMs,omitempty"`
+	SpotVMsMaxPrice float32 `json:"spotVMsMaxPrice,omitempty"`
+}
+
+type App struct {
+	Name      string `json:"name"`
+	AppName   string `json:"appName,omitempty"`
+	Catalog   string `json:"catalog"`
+	Namespace string `json:"namespace"`
+	Version   string `json:"version"`
+	// Values are the user values of the app, rendered into a config map
+	// referenced by the App CR.
+	Values map[string]interface{} `json:"values,omitempty"`
+}
+
+// Default fills in the node pool and KVM worker settings that were left
+// out, using the same defaults as the flags of the template nodepool and
+// template cluster commands.
+func (s *Spec) Default() {
+	if s.Provider == key.ProviderKVM {
+		if s.KVM == nil {
+			s.KVM = &KVM{}
+		}
+		if s.KVM.Workers == 0 {
+			s.KVM.Workers = DefaultKVMWorkers
+		}
+		if s.KVM.CPUs == 0 {
+			s.KVM.CPUs = DefaultKVMCPUs
+		}
+		if s.KVM.Memory == 0 {
+			s.KVM.Memory = DefaultKVMMemory
+		}
+		if s.KVM.Storage == 0 {
+			s.KVM.Storage = DefaultKVMStorage
+		}
+	}
+
+	for i := range s.NodePools {
+		np := &s.NodePools[i]
+
+		if np.NodesMin == nil {
+			np.NodesMin = toIntPtr(DefaultNodesMin)
+		}
+		if np.NodesMax == nil {
+			np.NodesMax = toIntPtr(DefaultNodesMax)
+		}
+
+		switch s.Provider {
+		case key.ProviderAWS:
+			if np.AWS == nil {
+				np.AWS = &AWSNodePool{}
+			}
+			if np.AWS.InstanceType == "" {
+				np.AWS.InstanceType = DefaultAWSInstanceType
+			}
+			if np.AWS.OnDemandPercentageAboveBaseCapacity == nil {
+				np.AWS.OnDemandPercentageAboveBaseCapacity = toIntPtr(DefaultOnDemandPercentageAboveBaseCapacity)
+			}
+		case key.ProviderAzure:
+			if np.Azure == nil {
+				np.Azure = &AzureNodePool{}
+			}
+			if np.Azure.VMSize == "" {
+				np.Azure.VMSize = DefaultAzureVMSize
+			}
+		}
+	}
+}
+
+func toIntPtr(i int) *int {
+	return &i
+}
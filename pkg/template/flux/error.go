@@ -0,0 +1,23 @@
+package flux
+
+import (
+	"github.com/giantswarm/microerror"
+)
+
+var notInRepositoryError = &microerror.Error{
+	Kind: "notInRepositoryError",
+}
+
+// IsNotInRepository asserts notInRepositoryError.
+func IsNotInRepository(err error) bool {
+	return microerror.Cause(err) == notInRepositoryError
+}
+
+var invalidFlagError = &microerror.Error{
+	Kind: "invalidFlagError",
+}
+
+// IsInvalidFlag asserts invalidFlagError.
+func IsInvalidFlag(err error) bool {
+	return microerror.Cause(err) == invalidFlagError
+}
@@ -0,0 +1,93 @@
+package flux
+
+import (
+	"io"
+
+	"github.com/giantswarm/microerror"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagFluxGitBranch = "flux-git-branch"
+	flagFluxGitURL    = "flux-git-url"
+	flagFluxNamespace = "flux-namespace"
+	flagFluxPath      = "flux-path"
+	flagFluxSource    = "flux-source"
+	flagGitOps        = "gitops"
+
+	// flagOutputDir is the flag of the template commands setting the
+	// directory reconciled by the Kustomization.
+	flagOutputDir = "output-dir"
+)
+
+// Flag holds the --gitops and --flux-* flags of the template commands
+// writing into --output-dir.
+type Flag struct {
+	GitBranch string
+	GitOps    string
+	GitURL    string
+	Namespace string
+	Path      string
+	Source    string
+}
+
+func (f *Flag) Init(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.GitBranch, flagFluxGitBranch, DefaultBranch, "Branch of the Git repository followed by the Flux GitRepository.")
+	cmd.Flags().StringVar(&f.GitOps, flagGitOps, "", "Template the objects for the given GitOps tool as well. Only flux is supported, which adds a Flux Kustomization reconciling --output-dir. The Kustomization is written into --output-dir itself, so once applied it is kept in sync from Git like the other CRs.")
+	cmd.Flags().StringVar(&f.GitURL, flagFluxGitURL, "", "URL of the Git repository. If given, a Flux GitRepository is templated as well.")
+	cmd.Flags().StringVar(&f.Namespace, flagFluxNamespace, DefaultNamespace, "Namespace of the Flux objects.")
+	cmd.Flags().StringVar(&f.Path, flagFluxPath, "", "Path of --output-dir relative to the root of the Git repository. Detected from the repository containing --output-dir if not given.")
+	cmd.Flags().StringVar(&f.Source, flagFluxSource, DefaultSourceName, "Name of the Flux GitRepository holding --output-dir.")
+}
+
+// Enabled returns whether the Flux objects are templated.
+func (f *Flag) Enabled() bool {
+	return f.GitOps == GitOps
+}
+
+// Validate validates the flags, given the value of --output-dir.
+func (f *Flag) Validate(outputDir string) error {
+	if f.GitOps != "" {
+		if f.GitOps != GitOps {
+			return microerror.Maskf(invalidFlagError, "--%s must be %s if given", flagGitOps, GitOps)
+		}
+		if outputDir == "" {
+			return microerror.Maskf(invalidFlagError, "--%s requires --%s", flagGitOps, flagOutputDir)
+		}
+	} else if f.Path != "" || f.GitURL != "" {
+		return microerror.Maskf(invalidFlagError, "--%s and --%s require --%s=%s", flagFluxPath, flagFluxGitURL, flagGitOps, GitOps)
+	}
+
+	return nil
+}
+
+// WriteKustomization writes the Flux objects reconciling outputDir. The path
+// of the directory in the Git repository is taken from --flux-path, or
+// detected from the repository containing outputDir on the given file
+// system.
+func (f *Flag) WriteKustomization(out io.Writer, fs afero.Fs, outputDir string) error {
+	path := f.Path
+	if path == "" {
+		var err error
+		path, err = RepositoryPath(fs, outputDir)
+		if IsNotInRepository(err) {
+			return microerror.Maskf(invalidFlagError, "--%s is not inside a Git repository, use --%s to set the path of the directory in the repository", flagOutputDir, flagFluxPath)
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	err := WriteKustomization(out, KustomizationConfig{
+		Path:       path,
+		Namespace:  f.Namespace,
+		SourceName: f.Source,
+		GitURL:     f.GitURL,
+		GitBranch:  f.GitBranch,
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,368 @@
+// Package flux templates the Flux objects which let Flux reconcile the
+// manifests generated by the template commands from a Git repository.
+package flux
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/giantswarm/microerror"
+	"github.com/spf13/afero"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+)
+
+const (
+	// GitOps is the value of the --gitops flag enabling the Flux objects.
+	GitOps = "flux"
+
+	// DefaultNamespace is the namespace Flux is installed into by
+	// `flux bootstrap`.
+	DefaultNamespace = "flux-system"
+	// DefaultSourceName is the name of the GitRepository created by
+	// `flux bootstrap`.
+	DefaultSourceName = "flux-system"
+	// DefaultBranch is the branch the GitRepository follows.
+	DefaultBranch = "main"
+
+	gitRepositoryInterval = "1m"
+	reconcileInterval     = "10m"
+)
+
+var invalidNameCharacters = regexp.MustCompile(`[^a-z0-9-]+`)
+
+type KustomizationConfig struct {
+	// Path is the directory to reconcile, relative to the root of the Git
+	// repository.
+	Path       string
+	Namespace  string
+	SourceName string
+	// GitURL is the URL of the Git repository. If given, the GitRepository
+	// named SourceName is templated along with the Kustomization.
+	GitURL    string
+	GitBranch string
+}
+
+type HelmReleaseConfig struct {
+	// Catalog is the name of the catalog, used for the HelmRepository.
+	Catalog string
+	// CatalogURL is the storage URL of the catalog.
+	CatalogURL string
+	Chart      string
+	Cluster    string
+	// Name is the name of the HelmRelease, which is also the name of the
+	// Helm release in the workload cluster.
+	Name                    string
+	TargetNamespace         string
+	UserConfigConfigMapName string
+	UserConfigSecretName    string
+	Version                 string
+}
+
+type CrossNamespaceSourceReference struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+type GitRepository struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              GitRepositorySpec `json:"spec"`
+}
+
+type GitRepositorySpec struct {
+	Interval string           `json:"interval"`
+	Ref      GitRepositoryRef `json:"ref"`
+	URL      string           `json:"url"`
+}
+
+type GitRepositoryRef struct {
+	Branch string `json:"branch"`
+}
+
+type Kustomization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              KustomizationSpec `json:"spec"`
+}
+
+type KustomizationSpec struct {
+	Interval  string                        `json:"interval"`
+	Path      string                        `json:"path"`
+	Prune     bool                          `json:"prune"`
+	SourceRef CrossNamespaceSourceReference `json:"sourceRef"`
+}
+
+type HelmRepository struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              HelmRepositorySpec `json:"spec"`
+}
+
+type HelmRepositorySpec struct {
+	Interval string `json:"interval"`
+	URL      string `json:"url"`
+}
+
+type HelmRelease struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              HelmReleaseSpec `json:"spec"`
+}
+
+type HelmReleaseSpec struct {
+	Chart           HelmChartTemplate `json:"chart"`
+	Install         *Install          `json:"install,omitempty"`
+	Interval        string            `json:"interval"`
+	KubeConfig      *KubeConfig       `json:"kubeConfig,omitempty"`
+	ReleaseName     string            `json:"releaseName,omitempty"`
+	TargetNamespace string            `json:"targetNamespace,omitempty"`
+	ValuesFrom      []ValuesReference `json:"valuesFrom,omitempty"`
+}
+
+type HelmChartTemplate struct {
+	Spec HelmChartTemplateSpec `json:"spec"`
+}
+
+type HelmChartTemplateSpec struct {
+	Chart     string                        `json:"chart"`
+	SourceRef CrossNamespaceSourceReference `json:"sourceRef"`
+	Version   string                        `json:"version,omitempty"`
+}
+
+type Install struct {
+	CreateNamespace bool `json:"createNamespace,omitempty"`
+}
+
+type KubeConfig struct {
+	SecretRef SecretKeyReference `json:"secretRef"`
+}
+
+type SecretKeyReference struct {
+	Name string `json:"name"`
+	Key  string `json:"key,omitempty"`
+}
+
+type ValuesReference struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	ValuesKey string `json:"valuesKey,omitempty"`
+}
+
+// RepositoryPath returns the path of the given directory relative to the
+// root of the Git repository containing it, in the form expected by the
+// path of a Flux Kustomization.
+func RepositoryPath(fs afero.Fs, dir string) (string, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	for root := absDir; ; root = filepath.Dir(root) {
+		exists, err := afero.Exists(fs, filepath.Join(root, ".git"))
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+
+		if exists {
+			path, err := filepath.Rel(root, absDir)
+			if err != nil {
+				return "", microerror.Mask(err)
+			}
+
+			if path == "." {
+				return "./", nil
+			}
+
+			return "./" + filepath.ToSlash(path), nil
+		}
+
+		if root == filepath.Dir(root) {
+			return "", microerror.Maskf(notInRepositoryError, "%#q is not inside a Git repository", dir)
+		}
+	}
+}
+
+// KustomizationName returns the name of the Kustomization reconciling the
+// given repository path. Deriving the name from the path makes all the
+// template commands writing into one directory agree on a single
+// Kustomization.
+func KustomizationName(path string) string {
+	name := strings.ToLower(strings.Trim(path, "./"))
+	name = invalidNameCharacters.ReplaceAllString(name, "-")
+	name = strings.Trim(name, "-")
+
+	if name == "" {
+		return "root"
+	}
+
+	return name
+}
+
+// WriteKustomization writes the Flux Kustomization reconciling the
+// directory at config.Path, preceded by the GitRepository if a Git URL is
+// given. The objects are written as additional documents of a YAML stream.
+//
+// The template commands write the Kustomization into the directory it
+// reconciles, the way `flux bootstrap` does with its own Kustomization.
+// Applying the directory once creates it, and from then on Flux keeps it in
+// sync with Git along with the other CRs of the directory.
+func WriteKustomization(out io.Writer, config KustomizationConfig) error {
+	if config.GitURL != "" {
+		gitRepository := &GitRepository{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "GitRepository",
+				APIVersion: "source.toolkit.fluxcd.io/v1beta1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      config.SourceName,
+				Namespace: config.Namespace,
+			},
+			Spec: GitRepositorySpec{
+				Interval: gitRepositoryInterval,
+				Ref: GitRepositoryRef{
+					Branch: config.GitBranch,
+				},
+				URL: config.GitURL,
+			},
+		}
+
+		err := writeDocument(out, gitRepository)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	kustomization := &Kustomization{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Kustomization",
+			APIVersion: "kustomize.toolkit.fluxcd.io/v1beta1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      KustomizationName(config.Path),
+			Namespace: config.Namespace,
+		},
+		Spec: KustomizationSpec{
+			Interval: reconcileInterval,
+			Path:     config.Path,
+			Prune:    true,
+			SourceRef: CrossNamespaceSourceReference{
+				Kind: "GitRepository",
+				Name: config.SourceName,
+			},
+		},
+	}
+
+	err := writeDocument(out, kustomization)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// WriteHelmRelease writes a HelmRelease installing an app into a workload
+// cluster, as an alternative to the App CR, preceded by the HelmRepository
+// of the catalog the app is taken from. Like the App CR, the HelmRelease
+// lives in the namespace of the cluster, next to its kubeconfig secret and
+// the user values.
+func WriteHelmRelease(out io.Writer, config HelmReleaseConfig) error {
+	helmRepository := &HelmRepository{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HelmRepository",
+			APIVersion: "source.toolkit.fluxcd.io/v1beta1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      config.Catalog,
+			Namespace: config.Cluster,
+		},
+		Spec: HelmRepositorySpec{
+			Interval: reconcileInterval,
+			URL:      config.CatalogURL,
+		},
+	}
+
+	err := writeDocument(out, helmRepository)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	var valuesFrom []ValuesReference
+	if config.UserConfigConfigMapName != "" {
+		valuesFrom = append(valuesFrom, ValuesReference{
+			Kind:      "ConfigMap",
+			Name:      config.UserConfigConfigMapName,
+			ValuesKey: "values",
+		})
+	}
+	if config.UserConfigSecretName != "" {
+		valuesFrom = append(valuesFrom, ValuesReference{
+			Kind:      "Secret",
+			Name:      config.UserConfigSecretName,
+			ValuesKey: "values",
+		})
+	}
+
+	helmRelease := &HelmRelease{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HelmRelease",
+			APIVersion: "helm.toolkit.fluxcd.io/v2beta1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      config.Name,
+			Namespace: config.Cluster,
+		},
+		Spec: HelmReleaseSpec{
+			Chart: HelmChartTemplate{
+				Spec: HelmChartTemplateSpec{
+					Chart: config.Chart,
+					SourceRef: CrossNamespaceSourceReference{
+						Kind: "HelmRepository",
+						Name: config.Catalog,
+					},
+					Version: config.Version,
+				},
+			},
+			Install: &Install{
+				CreateNamespace: true,
+			},
+			Interval: reconcileInterval,
+			KubeConfig: &KubeConfig{
+				SecretRef: SecretKeyReference{
+					Name: key.KubeconfigSecretName(config.Cluster),
+					Key:  key.KubeconfigSecretKey,
+				},
+			},
+			ReleaseName:     config.Name,
+			TargetNamespace: config.TargetNamespace,
+			ValuesFrom:      valuesFrom,
+		},
+	}
+
+	err = writeDocument(out, helmRelease)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func writeDocument(out io.Writer, object interface{}) error {
+	data, err := yaml.Marshal(object)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	_, err = fmt.Fprintf(out, "---\n%s", data)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
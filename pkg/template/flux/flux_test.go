@@ -0,0 +1,238 @@
+package flux
+
+import (
+	"bytes"
+	goflag "flag"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+
+	"github.com/giantswarm/kubectl-gs/test/goldenfile"
+)
+
+var update = goflag.Bool("update", false, "update .golden reference test files")
+
+func Test_RepositoryPath(t *testing.T) {
+	testCases := []struct {
+		name         string
+		dir          string
+		expectedPath string
+		errorMatcher func(error) bool
+	}{
+		{
+			name:         "case 0: directory inside the repository",
+			dir:          "/src/gitops/management-clusters/gauss/clusters/a1b2c",
+			expectedPath: "./management-clusters/gauss/clusters/a1b2c",
+		},
+		{
+			name:         "case 1: root of the repository",
+			dir:          "/src/gitops",
+			expectedPath: "./",
+		},
+		{
+			name:         "case 2: directory outside of any repository",
+			dir:          "/tmp/a1b2c",
+			errorMatcher: IsNotInRepository,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			err := fs.MkdirAll("/src/gitops/.git", 0755)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			path, err := RepositoryPath(fs, tc.dir)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if path != tc.expectedPath {
+				t.Fatalf("expected path %#q, got %#q", tc.expectedPath, path)
+			}
+		})
+	}
+}
+
+func Test_KustomizationName(t *testing.T) {
+	testCases := []struct {
+		path         string
+		expectedName string
+	}{
+		{path: "./management-clusters/gauss/clusters/a1b2c", expectedName: "management-clusters-gauss-clusters-a1b2c"},
+		{path: "./Apps/nginx_ingress", expectedName: "apps-nginx-ingress"},
+		{path: "./", expectedName: "root"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			name := KustomizationName(tc.path)
+			if name != tc.expectedName {
+				t.Fatalf("expected name %#q, got %#q", tc.expectedName, name)
+			}
+		})
+	}
+}
+
+func Test_Flag_Validate(t *testing.T) {
+	testCases := []struct {
+		name         string
+		flag         Flag
+		outputDir    string
+		errorMatcher func(error) bool
+	}{
+		{
+			name:      "case 0: flux with output directory",
+			flag:      Flag{GitOps: GitOps, Path: "./clusters/a1b2c"},
+			outputDir: "clusters/a1b2c",
+		},
+		{
+			name: "case 1: no gitops",
+			flag: Flag{Namespace: DefaultNamespace, Source: DefaultSourceName},
+		},
+		{
+			name:         "case 2: unknown gitops tool",
+			flag:         Flag{GitOps: "argocd"},
+			outputDir:    "clusters/a1b2c",
+			errorMatcher: IsInvalidFlag,
+		},
+		{
+			name:         "case 3: flux without output directory",
+			flag:         Flag{GitOps: GitOps},
+			errorMatcher: IsInvalidFlag,
+		},
+		{
+			name:         "case 4: flux path without gitops",
+			flag:         Flag{Path: "./clusters/a1b2c"},
+			outputDir:    "clusters/a1b2c",
+			errorMatcher: IsInvalidFlag,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.flag.Validate(tc.outputDir)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+		})
+	}
+}
+
+// Test_Write uses golden files.
+//
+//  go test ./pkg/template/flux -run Test_Write -update
+//
+func Test_Write(t *testing.T) {
+	testCases := []struct {
+		name               string
+		write              func(out *bytes.Buffer) error
+		expectedGoldenFile string
+	}{
+		{
+			name: "case 0: kustomization",
+			write: func(out *bytes.Buffer) error {
+				return WriteKustomization(out, KustomizationConfig{
+					Path:       "./clusters/a1b2c",
+					Namespace:  DefaultNamespace,
+					SourceName: DefaultSourceName,
+				})
+			},
+			expectedGoldenFile: "kustomization.golden",
+		},
+		{
+			name: "case 1: kustomization with git repository",
+			write: func(out *bytes.Buffer) error {
+				return WriteKustomization(out, KustomizationConfig{
+					Path:       "./clusters/a1b2c",
+					Namespace:  DefaultNamespace,
+					SourceName: "gitops",
+					GitURL:     "ssh://git@github.com/acme/gitops",
+					GitBranch:  DefaultBranch,
+				})
+			},
+			expectedGoldenFile: "kustomization_with_git_repository.golden",
+		},
+		{
+			name: "case 2: helm release with user values",
+			write: func(out *bytes.Buffer) error {
+				return WriteHelmRelease(out, HelmReleaseConfig{
+					Catalog:                 "giantswarm",
+					CatalogURL:              "https://giantswarm.github.io/giantswarm-catalog/",
+					Chart:                   "nginx-ingress-controller-app",
+					Cluster:                 "a1b2c",
+					Name:                    "nginx-ingress-controller-app",
+					TargetNamespace:         "kube-system",
+					UserConfigConfigMapName: "nginx-ingress-controller-app-userconfig-a1b2c",
+					Version:                 "1.17.0",
+				})
+			},
+			expectedGoldenFile: "helm_release_with_user_values.golden",
+		},
+		{
+			name: "case 3: kustomization from flags, with the path detected",
+			write: func(out *bytes.Buffer) error {
+				fs := afero.NewMemMapFs()
+				err := fs.MkdirAll("/src/gitops/.git", 0755)
+				if err != nil {
+					return err
+				}
+
+				f := &Flag{
+					GitOps:    GitOps,
+					Namespace: DefaultNamespace,
+					Source:    DefaultSourceName,
+				}
+				return f.WriteKustomization(out, fs, "/src/gitops/clusters/a1b2c")
+			},
+			expectedGoldenFile: "kustomization.golden",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := new(bytes.Buffer)
+
+			err := tc.write(out)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			var expectedResult []byte
+			{
+				gf := goldenfile.New("testdata", tc.expectedGoldenFile)
+				if *update {
+					expectedResult = out.Bytes()
+					err = gf.Update(expectedResult)
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err.Error())
+					}
+				} else {
+					expectedResult, err = gf.Read()
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err.Error())
+					}
+				}
+			}
+
+			diff := cmp.Diff(string(expectedResult), out.String())
+			if diff != "" {
+				t.Fatalf("value not expected, got:\n %s", diff)
+			}
+		})
+	}
+}
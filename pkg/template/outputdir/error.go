@@ -0,0 +1,32 @@
+package outputdir
+
+import (
+	"github.com/giantswarm/microerror"
+)
+
+var invalidConfigError = &microerror.Error{
+	Kind: "invalidConfigError",
+}
+
+// IsInvalidConfig asserts invalidConfigError.
+func IsInvalidConfig(err error) bool {
+	return microerror.Cause(err) == invalidConfigError
+}
+
+var invalidManifestError = &microerror.Error{
+	Kind: "invalidManifestError",
+}
+
+// IsInvalidManifest asserts invalidManifestError.
+func IsInvalidManifest(err error) bool {
+	return microerror.Cause(err) == invalidManifestError
+}
+
+var fileExistsError = &microerror.Error{
+	Kind: "fileExistsError",
+}
+
+// IsFileExists asserts fileExistsError.
+func IsFileExists(err error) bool {
+	return microerror.Cause(err) == fileExistsError
+}
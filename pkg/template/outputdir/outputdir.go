@@ -0,0 +1,259 @@
+// Package outputdir writes templated manifests into a directory, with one
+// file per object and a kustomization listing them, as used in GitOps
+// repositories.
+package outputdir
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/giantswarm/microerror"
+	"github.com/spf13/afero"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// KustomizationFileName is the name of the kustomization file listing
+	// the files written into the directory.
+	KustomizationFileName = "kustomization.yaml"
+
+	kustomizationAPIVersion = "kustomize.config.k8s.io/v1beta1"
+	kustomizationKind       = "Kustomization"
+)
+
+type Config struct {
+	Fs  afero.Fs
+	Dir string
+	// Force allows overwriting existing files.
+	Force bool
+}
+
+// Writer collects the multi-document YAML stream written to it. Flush then
+// writes each object of the stream into its own file, named
+// <kind>-<name>.yaml, and adds the files to the kustomization of the
+// directory, creating it if needed.
+type Writer struct {
+	fs    afero.Fs
+	dir   string
+	force bool
+
+	buffer bytes.Buffer
+}
+
+func New(config Config) (*Writer, error) {
+	if config.Fs == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Fs must not be empty", config)
+	}
+	if config.Dir == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Dir must not be empty", config)
+	}
+
+	w := &Writer{
+		fs:    config.Fs,
+		dir:   config.Dir,
+		force: config.Force,
+	}
+
+	return w, nil
+}
+
+func (w *Writer) Write(p []byte) (int, error) {
+	return w.buffer.Write(p)
+}
+
+// Flush writes the objects collected so far into the directory and returns
+// the names of the files written. Nothing is written when one of the files
+// exists already with a different content, unless overwriting was allowed.
+func (w *Writer) Flush() ([]string, error) {
+	files := map[string]file{}
+	var fileNames []string
+	for _, document := range SplitDocuments(w.buffer.Bytes()) {
+		f, err := newFile(document)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		if _, ok := files[f.name]; ok {
+			return nil, microerror.Maskf(invalidManifestError, "more than one object would be written to %#q", f.name)
+		}
+
+		files[f.name] = f
+		fileNames = append(fileNames, f.name)
+	}
+	w.buffer.Reset()
+
+	sort.Strings(fileNames)
+
+	if !w.force {
+		var existing []string
+		for _, fileName := range fileNames {
+			exists, err := afero.Exists(w.fs, filepath.Join(w.dir, fileName))
+			if err != nil {
+				return nil, microerror.Mask(err)
+			}
+			if !exists {
+				continue
+			}
+
+			// Files with the very same content are not a conflict, e.g. the
+			// objects shared by several apps templated into one directory.
+			data, err := afero.ReadFile(w.fs, filepath.Join(w.dir, fileName))
+			if err != nil {
+				return nil, microerror.Mask(err)
+			}
+			if !bytes.Equal(data, files[fileName].data) {
+				existing = append(existing, fileName)
+			}
+		}
+
+		if len(existing) > 0 {
+			return nil, microerror.Maskf(fileExistsError, "%s already exist in %#q, use --force to overwrite", strings.Join(existing, ", "), w.dir)
+		}
+	}
+
+	err := w.fs.MkdirAll(w.dir, 0755)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	for _, fileName := range fileNames {
+		f := files[fileName]
+		err = afero.WriteFile(w.fs, filepath.Join(w.dir, fileName), f.data, f.mode)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	err = w.updateKustomization(fileNames)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return fileNames, nil
+}
+
+// updateKustomization adds the given files to the resources of the
+// kustomization in the directory. Resources listed already are kept, so
+// that several template commands can write into the same directory.
+func (w *Writer) updateKustomization(fileNames []string) error {
+	path := filepath.Join(w.dir, KustomizationFileName)
+
+	kustomization := map[string]interface{}{
+		"apiVersion": kustomizationAPIVersion,
+		"kind":       kustomizationKind,
+	}
+	{
+		exists, err := afero.Exists(w.fs, path)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		if exists {
+			data, err := afero.ReadFile(w.fs, path)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			err = yaml.Unmarshal(data, &kustomization)
+			if err != nil {
+				return microerror.Maskf(invalidManifestError, "%#q is not valid YAML: %s", path, err)
+			}
+		}
+	}
+
+	var resources []interface{}
+	listed := map[string]bool{}
+	{
+		if existing, ok := kustomization["resources"].([]interface{}); ok {
+			for _, r := range existing {
+				resources = append(resources, r)
+				listed[fmt.Sprint(r)] = true
+			}
+		}
+
+		for _, fileName := range fileNames {
+			if !listed[fileName] {
+				resources = append(resources, fileName)
+			}
+		}
+	}
+	kustomization["resources"] = resources
+
+	data, err := yaml.Marshal(kustomization)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = afero.WriteFile(w.fs, path, data, 0644)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// SplitDocuments splits a multi-document YAML stream into its documents,
+// leaving out the empty ones.
+func SplitDocuments(data []byte) [][]byte {
+	var documents [][]byte
+
+	var current []string
+	flush := func() {
+		document := strings.TrimSpace(strings.Join(current, "\n"))
+		if document != "" {
+			documents = append(documents, []byte(document+"\n"))
+		}
+		current = nil
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.TrimRight(line, " \t\r") == "---" {
+			flush()
+			continue
+		}
+		current = append(current, line)
+	}
+	flush()
+
+	return documents
+}
+
+type file struct {
+	name string
+	data []byte
+	mode os.FileMode
+}
+
+func newFile(document []byte) (file, error) {
+	var object struct {
+		Kind     string `json:"kind"`
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+	}
+
+	err := yaml.Unmarshal(document, &object)
+	if err != nil {
+		return file{}, microerror.Maskf(invalidManifestError, "%s", err)
+	}
+	if object.Kind == "" || object.Metadata.Name == "" {
+		return file{}, microerror.Maskf(invalidManifestError, "object without kind or name")
+	}
+
+	f := file{
+		name: fmt.Sprintf("%s-%s.yaml", strings.ToLower(object.Kind), object.Metadata.Name),
+		data: document,
+		mode: 0644,
+	}
+
+	// Secrets hold credentials, so keep them private to the user.
+	if object.Kind == "Secret" {
+		f.mode = 0600
+	}
+
+	return f, nil
+}
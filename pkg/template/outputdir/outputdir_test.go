@@ -0,0 +1,194 @@
+package outputdir
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+const (
+	clusterManifests = `apiVersion: cluster.x-k8s.io/v1alpha3
+kind: Cluster
+metadata:
+  name: a1b2c
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: a1b2c-values
+`
+	appManifests = `---
+---
+apiVersion: application.giantswarm.io/v1alpha1
+kind: App
+metadata:
+  name: nginx
+`
+)
+
+func TestWriter_Flush(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		existingFiles         map[string]string
+		force                 bool
+		manifests             string
+		expectedFileNames     []string
+		expectedKustomization string
+		errorMatcher          func(error) bool
+	}{
+		{
+			name:              "case 0: empty directory",
+			manifests:         clusterManifests,
+			expectedFileNames: []string{"cluster-a1b2c.yaml", "secret-a1b2c-values.yaml"},
+			expectedKustomization: `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- cluster-a1b2c.yaml
+- secret-a1b2c-values.yaml
+`,
+		},
+		{
+			name: "case 1: existing kustomization is extended",
+			existingFiles: map[string]string{
+				"cluster-a1b2c.yaml": clusterManifests,
+				"kustomization.yaml": `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+namespace: org-acme
+resources:
+- cluster-a1b2c.yaml
+`,
+			},
+			manifests:         appManifests,
+			expectedFileNames: []string{"app-nginx.yaml"},
+			expectedKustomization: `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+namespace: org-acme
+resources:
+- cluster-a1b2c.yaml
+- app-nginx.yaml
+`,
+		},
+		{
+			name: "case 2: existing file",
+			existingFiles: map[string]string{
+				"cluster-a1b2c.yaml": clusterManifests,
+			},
+			manifests:    clusterManifests,
+			errorMatcher: IsFileExists,
+		},
+		{
+			name: "case 3: existing file overwritten",
+			existingFiles: map[string]string{
+				"cluster-a1b2c.yaml": "",
+			},
+			force:             true,
+			manifests:         clusterManifests,
+			expectedFileNames: []string{"cluster-a1b2c.yaml", "secret-a1b2c-values.yaml"},
+			expectedKustomization: `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- cluster-a1b2c.yaml
+- secret-a1b2c-values.yaml
+`,
+		},
+		{
+			name: "case 4: existing file with the same content",
+			existingFiles: map[string]string{
+				"app-nginx.yaml": "apiVersion: application.giantswarm.io/v1alpha1\nkind: App\nmetadata:\n  name: nginx\n",
+			},
+			manifests:         appManifests,
+			expectedFileNames: []string{"app-nginx.yaml"},
+			expectedKustomization: `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- app-nginx.yaml
+`,
+		},
+		{
+			name:         "case 5: object without name",
+			manifests:    "apiVersion: v1\nkind: ConfigMap\n",
+			errorMatcher: IsInvalidManifest,
+		},
+		{
+			name:         "case 6: same object twice",
+			manifests:    appManifests + "---\n" + appManifests,
+			errorMatcher: IsInvalidManifest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			dir := "clusters/a1b2c"
+
+			for name, content := range tc.existingFiles {
+				err := afero.WriteFile(fs, filepath.Join(dir, name), []byte(content), 0644)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+			}
+
+			w, err := New(Config{
+				Fs:    fs,
+				Dir:   dir,
+				Force: tc.force,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			_, err = w.Write([]byte(tc.manifests))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			fileNames, err := w.Flush()
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			diff := cmp.Diff(tc.expectedFileNames, fileNames)
+			if diff != "" {
+				t.Fatalf("file names not expected, got:\n %s", diff)
+			}
+
+			kustomization, err := afero.ReadFile(fs, filepath.Join(dir, KustomizationFileName))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			diff = cmp.Diff(tc.expectedKustomization, string(kustomization))
+			if diff != "" {
+				t.Fatalf("kustomization not expected, got:\n %s", diff)
+			}
+
+			secret, err := fs.Stat(filepath.Join(dir, "secret-a1b2c-values.yaml"))
+			if err == nil && secret.Mode().Perm() != 0600 {
+				t.Fatalf("expected secret to be private to the user, got mode %s", secret.Mode())
+			}
+		})
+	}
+}
+
+func Test_SplitDocuments(t *testing.T) {
+	documents := SplitDocuments([]byte("---\nkind: A\n---\n\n---\nkind: B\n--- \n"))
+
+	var got []string
+	for _, d := range documents {
+		got = append(got, string(d))
+	}
+
+	diff := cmp.Diff([]string{"kind: A\n", "kind: B\n"}, got)
+	if diff != "" {
+		t.Fatalf("value not expected, got:\n %s", diff)
+	}
+}
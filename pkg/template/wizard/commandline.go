@@ -0,0 +1,50 @@
+package wizard
+
+import (
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandLine returns the command line for the given command with the flags
+// set so far, either given on the command line or answered, so that the
+// same CRs can be templated again without the wizard. Deprecated flags and
+// the excluded ones, like --interactive itself, are left out.
+func CommandLine(command string, flags *pflag.FlagSet, exclude ...string) string {
+	excluded := map[string]bool{}
+	for _, e := range exclude {
+		excluded[e] = true
+	}
+
+	// The root command is named kubectl gs with a non-breaking space.
+	args := []string{strings.ReplaceAll(command, "\u00a0", " ")}
+	flags.VisitAll(func(f *pflag.Flag) {
+		if !f.Changed || f.Deprecated != "" || excluded[f.Name] {
+			return
+		}
+
+		value := f.Value.String()
+		if s, ok := f.Value.(pflag.SliceValue); ok {
+			value = strings.Join(s.GetSlice(), ",")
+		}
+
+		if f.Value.Type() == "bool" && value == "true" {
+			args = append(args, "--"+f.Name)
+		} else if f.Value.Type() == "bool" {
+			args = append(args, "--"+f.Name+"="+value)
+		} else {
+			args = append(args, "--"+f.Name, quote(value))
+		}
+	})
+
+	return strings.Join(args, " ")
+}
+
+// quote quotes the value for POSIX shells, if needed.
+func quote(value string) string {
+	if value != "" && strings.Trim(value, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_.,:/=@+") == "" {
+		return value
+	}
+
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,43 @@
+package wizard
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/cobra"
+)
+
+func Test_CommandLine(t *testing.T) {
+	var name, description string
+	var azs []string
+	var interactive, snat bool
+
+	cmd := &cobra.Command{Use: "cluster"}
+	cmd.Flags().StringVar(&name, "name", "", "")
+	cmd.Flags().StringVar(&description, "description", "", "")
+	cmd.Flags().StringSliceVar(&azs, "control-plane-az", nil, "")
+	cmd.Flags().BoolVar(&interactive, "interactive", false, "")
+	cmd.Flags().BoolVar(&snat, "external-snat", false, "")
+
+	err := cmd.ParseFlags([]string{"--interactive", "--name", "a1b2c"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	for name, value := range map[string]string{
+		"description":      "Bob's cluster",
+		"control-plane-az": "eu-west-1a,eu-west-1b",
+		"external-snat":    "true",
+	} {
+		err = cmd.Flags().Set(name, value)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+
+	commandLine := CommandLine("kubectl gs template cluster", cmd.Flags(), "interactive")
+
+	expected := `kubectl gs template cluster --control-plane-az eu-west-1a,eu-west-1b --description 'Bob'\''s cluster' --external-snat --name a1b2c`
+	if diff := cmp.Diff(expected, commandLine); diff != "" {
+		t.Fatalf("command line not expected, got:\n %s", diff)
+	}
+}
@@ -0,0 +1,32 @@
+package wizard
+
+import (
+	"github.com/giantswarm/microerror"
+)
+
+var abortedError = &microerror.Error{
+	Kind: "abortedError",
+}
+
+// IsAborted asserts abortedError.
+func IsAborted(err error) bool {
+	return microerror.Cause(err) == abortedError
+}
+
+var invalidAnswerError = &microerror.Error{
+	Kind: "invalidAnswerError",
+}
+
+// IsInvalidAnswer asserts invalidAnswerError.
+func IsInvalidAnswer(err error) bool {
+	return microerror.Cause(err) == invalidAnswerError
+}
+
+var invalidConfigError = &microerror.Error{
+	Kind: "invalidConfigError",
+}
+
+// IsInvalidConfig asserts invalidConfigError.
+func IsInvalidConfig(err error) bool {
+	return microerror.Cause(err) == invalidConfigError
+}
@@ -0,0 +1,150 @@
+package wizard
+
+import (
+	"context"
+	"sort"
+
+	"github.com/blang/semver/v4"
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	releasev1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/release/v1alpha1"
+	securityv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/security/v1alpha1"
+	"github.com/giantswarm/microerror"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/kubectl-gs/internal/key"
+	"github.com/giantswarm/kubectl-gs/internal/label"
+)
+
+// Cluster is a workload cluster offered when templating a node pool.
+type Cluster struct {
+	Name      string
+	Namespace string
+	Release   string
+}
+
+// Organizations returns the names of the organizations in the management
+// cluster, sorted by name.
+func Organizations(ctx context.Context, reader runtimeclient.Reader) ([]string, error) {
+	organizations := &securityv1alpha1.OrganizationList{}
+	err := reader.List(ctx, organizations)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var names []string
+	for _, o := range organizations.Items {
+		names = append(names, o.Name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// Releases returns the versions of the active releases in the management
+// cluster, newest first and without the leading v.
+func Releases(ctx context.Context, reader runtimeclient.Reader) ([]string, error) {
+	releases := &releasev1alpha1.ReleaseList{}
+	err := reader.List(ctx, releases)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var versions []semver.Version
+	for _, r := range releases.Items {
+		if r.Spec.State != releasev1alpha1.StateActive {
+			continue
+		}
+
+		v, err := semver.ParseTolerant(r.Name)
+		if err != nil {
+			continue
+		}
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].GT(versions[j])
+	})
+
+	var names []string
+	for _, v := range versions {
+		names = append(names, v.String())
+	}
+
+	return names, nil
+}
+
+// Region returns the AWS region of the existing clusters in the management
+// cluster, which is the region new clusters are created in. It is empty if
+// there are no clusters yet.
+func Region(ctx context.Context, reader runtimeclient.Reader) (string, error) {
+	clusters := &infrastructurev1alpha3.AWSClusterList{}
+	err := reader.List(ctx, clusters)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	for _, c := range clusters.Items {
+		if c.Spec.Provider.Region != "" {
+			return c.Spec.Provider.Region, nil
+		}
+	}
+
+	return "", nil
+}
+
+// Clusters returns the workload clusters of the given organization, sorted
+// by name.
+func Clusters(ctx context.Context, reader runtimeclient.Reader, organization string) ([]Cluster, error) {
+	clusters := &capiv1alpha3.ClusterList{}
+	err := reader.List(ctx, clusters, runtimeclient.MatchingLabels{label.Organization: organization})
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var result []Cluster
+	for _, c := range clusters.Items {
+		result = append(result, Cluster{
+			Name:      c.Name,
+			Namespace: c.Namespace,
+			Release:   c.Labels[label.ReleaseVersion],
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+// AvailabilityZones returns the availability zones offered for the given
+// provider. On AWS, these are the first three zones of the region.
+func AvailabilityZones(provider, region string) []string {
+	switch provider {
+	case key.ProviderAWS:
+		if region == "" {
+			return nil
+		}
+
+		var zones []string
+		for _, suffix := range []string{"a", "b", "c"} {
+			zones = append(zones, region+suffix)
+		}
+
+		return zones
+	case key.ProviderAzure:
+		return []string{"1", "2", "3"}
+	}
+
+	return nil
+}
+
+// ClusterNames returns the names of the given clusters.
+func ClusterNames(clusters []Cluster) []string {
+	var names []string
+	for _, c := range clusters {
+		names = append(names, c.Name)
+	}
+
+	return names
+}
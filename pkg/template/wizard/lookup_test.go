@@ -0,0 +1,97 @@
+package wizard
+
+import (
+	"context"
+	"testing"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	releasev1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/release/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	capiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/giantswarm/kubectl-gs/internal/label"
+)
+
+func Test_Lookups(t *testing.T) {
+	scheme := runtime.NewScheme()
+	for _, addToScheme := range []func(*runtime.Scheme) error{
+		capiv1alpha3.AddToScheme,
+		infrastructurev1alpha3.AddToScheme,
+		releasev1alpha1.AddToScheme,
+	} {
+		err := addToScheme(scheme)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+
+	reader := fake.NewFakeClientWithScheme(scheme,
+		newRelease("v14.2.0", releasev1alpha1.StateActive),
+		newRelease("v16.0.1", releasev1alpha1.StateActive),
+		newRelease("v16.1.0-beta", releasev1alpha1.StateWIP),
+		newRelease("v9.3.11", releasev1alpha1.StateDeprecated),
+		newRelease("v16.0.10", releasev1alpha1.StateActive),
+		&infrastructurev1alpha3.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "a1b2c", Namespace: "org-acme"},
+			Spec: infrastructurev1alpha3.AWSClusterSpec{
+				Provider: infrastructurev1alpha3.AWSClusterSpecProvider{Region: "eu-west-1"},
+			},
+		},
+		newCluster("x9y8z", "default", "acme", "14.2.0"),
+		newCluster("a1b2c", "org-acme", "acme", "16.0.1"),
+		newCluster("f0o0o", "org-other", "other", "16.0.1"),
+	)
+
+	ctx := context.Background()
+
+	releases, err := Releases(ctx, reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if diff := cmp.Diff([]string{"16.0.10", "16.0.1", "14.2.0"}, releases); diff != "" {
+		t.Fatalf("releases not expected, got:\n %s", diff)
+	}
+
+	region, err := Region(ctx, reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if diff := cmp.Diff([]string{"eu-west-1a", "eu-west-1b", "eu-west-1c"}, AvailabilityZones("aws", region)); diff != "" {
+		t.Fatalf("availability zones not expected, got:\n %s", diff)
+	}
+
+	clusters, err := Clusters(ctx, reader, "acme")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	expectedClusters := []Cluster{
+		{Name: "a1b2c", Namespace: "org-acme", Release: "16.0.1"},
+		{Name: "x9y8z", Namespace: "default", Release: "14.2.0"},
+	}
+	if diff := cmp.Diff(expectedClusters, clusters); diff != "" {
+		t.Fatalf("clusters not expected, got:\n %s", diff)
+	}
+}
+
+func newRelease(name string, state releasev1alpha1.ReleaseState) *releasev1alpha1.Release {
+	return &releasev1alpha1.Release{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       releasev1alpha1.ReleaseSpec{State: state},
+	}
+}
+
+func newCluster(name, namespace, organization, release string) *capiv1alpha3.Cluster {
+	return &capiv1alpha3.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				label.Organization:   organization,
+				label.ReleaseVersion: release,
+			},
+		},
+	}
+}
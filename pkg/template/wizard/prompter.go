@@ -0,0 +1,248 @@
+// Package wizard supports the --interactive mode of the template commands. It
+// asks for the values of the flags one at a time, offering the options
+// found in the management cluster, and turns the answers back into the
+// equivalent command line.
+package wizard
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/giantswarm/microerror"
+)
+
+type Config struct {
+	// In is where the answers are read from, one per line.
+	In io.Reader
+	// Out is where the questions are printed to. This is usually stderr, to
+	// keep stdout for the templated CRs.
+	Out io.Writer
+}
+
+type Prompter struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+func New(config Config) (*Prompter, error) {
+	if config.In == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.In must not be empty", config)
+	}
+	if config.Out == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Out must not be empty", config)
+	}
+
+	p := &Prompter{
+		in:  bufio.NewReader(config.In),
+		out: config.Out,
+	}
+
+	return p, nil
+}
+
+// Ask asks for a free-form answer. An empty answer takes the default value.
+// The question is repeated until validate accepts the answer, validate may
+// be nil.
+func (p *Prompter) Ask(question, defaultValue string, validate func(string) error) (string, error) {
+	prompt := question
+	if defaultValue != "" {
+		prompt += fmt.Sprintf(" [%s]", defaultValue)
+	}
+
+	for {
+		answer, err := p.readLine(prompt)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+		if answer == "" {
+			answer = defaultValue
+		}
+
+		if validate != nil {
+			err = validate(answer)
+			if err != nil {
+				p.printError(err)
+				continue
+			}
+		}
+
+		return answer, nil
+	}
+}
+
+// AskInt asks for a number, see Ask.
+func (p *Prompter) AskInt(question string, defaultValue int, validate func(int) error) (int, error) {
+	var value int
+	_, err := p.Ask(question, strconv.Itoa(defaultValue), func(answer string) error {
+		var err error
+		value, err = strconv.Atoi(answer)
+		if err != nil {
+			return microerror.Maskf(invalidAnswerError, "%#q is not a number", answer)
+		}
+
+		if validate != nil {
+			return validate(value)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, microerror.Mask(err)
+	}
+
+	return value, nil
+}
+
+// Confirm asks a yes or no question.
+func (p *Prompter) Confirm(question string, defaultValue bool) (bool, error) {
+	defaultAnswer := "y/N"
+	if defaultValue {
+		defaultAnswer = "Y/n"
+	}
+
+	for {
+		answer, err := p.readLine(fmt.Sprintf("%s [%s]", question, defaultAnswer))
+		if err != nil {
+			return false, microerror.Mask(err)
+		}
+
+		switch strings.ToLower(answer) {
+		case "":
+			return defaultValue, nil
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+
+		p.printError(microerror.Maskf(invalidAnswerError, "please answer yes or no"))
+	}
+}
+
+// Select asks to choose one of the given options, either by its number or
+// by its value. Without options, it falls back to Ask.
+func (p *Prompter) Select(question string, options []string, defaultValue string, validate func(string) error) (string, error) {
+	if len(options) == 0 {
+		return p.Ask(question, defaultValue, validate)
+	}
+
+	p.printOptions(question, options)
+
+	var value string
+	_, err := p.Ask("Choose", defaultValue, func(answer string) error {
+		if answer == "" {
+			return microerror.Maskf(invalidAnswerError, "please choose one of the options")
+		}
+
+		var err error
+		value, err = option(options, answer)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		if validate != nil {
+			return validate(value)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	return value, nil
+}
+
+// SelectMultiple asks to choose any number of the given options, separated
+// by commas. Without options, it asks for a comma-separated list of values.
+func (p *Prompter) SelectMultiple(question string, options []string, defaultValues []string, validate func([]string) error) ([]string, error) {
+	if len(options) > 0 {
+		p.printOptions(question, options)
+		question = "Choose, separated by commas"
+	}
+
+	var values []string
+	_, err := p.Ask(question, strings.Join(defaultValues, ","), func(answer string) error {
+		values = nil
+		for _, a := range strings.Split(answer, ",") {
+			a = strings.TrimSpace(a)
+			if a == "" {
+				continue
+			}
+
+			if len(options) > 0 {
+				var err error
+				a, err = option(options, a)
+				if err != nil {
+					return microerror.Mask(err)
+				}
+			}
+
+			values = append(values, a)
+		}
+
+		if validate != nil {
+			return validate(values)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return values, nil
+}
+
+// Note prints a line of information between the questions.
+func (p *Prompter) Note(format string, args ...interface{}) {
+	fmt.Fprintf(p.out, format+"\n", args...)
+}
+
+func (p *Prompter) readLine(prompt string) (string, error) {
+	fmt.Fprintf(p.out, "? %s: ", prompt)
+
+	line, err := p.in.ReadString('\n')
+	if err == io.EOF && line == "" {
+		fmt.Fprintln(p.out)
+		return "", microerror.Maskf(abortedError, "no more answers")
+	} else if err != nil && err != io.EOF {
+		return "", microerror.Mask(err)
+	}
+
+	return strings.TrimSpace(line), nil
+}
+
+func (p *Prompter) printOptions(question string, options []string) {
+	fmt.Fprintf(p.out, "? %s\n", question)
+	for i, o := range options {
+		fmt.Fprintf(p.out, "  %d) %s\n", i+1, o)
+	}
+}
+
+func (p *Prompter) printError(err error) {
+	fmt.Fprintf(p.out, "  %s\n", microerror.Pretty(err, false))
+}
+
+// option returns the option the answer refers to, by number or by value.
+func option(options []string, answer string) (string, error) {
+	n, err := strconv.Atoi(answer)
+	if err == nil {
+		if n < 1 || n > len(options) {
+			return "", microerror.Maskf(invalidAnswerError, "please choose a number between 1 and %d", len(options))
+		}
+
+		return options[n-1], nil
+	}
+
+	for _, o := range options {
+		if o == answer {
+			return o, nil
+		}
+	}
+
+	return "", microerror.Maskf(invalidAnswerError, "%#q is not one of the options", answer)
+}
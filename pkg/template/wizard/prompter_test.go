@@ -0,0 +1,146 @@
+package wizard
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/giantswarm/microerror"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+var errTooShort = &microerror.Error{
+	Kind: "errTooShort",
+}
+
+func TestPrompter(t *testing.T) {
+	options := []string{"acme", "giantswarm"}
+
+	testCases := []struct {
+		name           string
+		input          string
+		ask            func(p *Prompter) (interface{}, error)
+		expectedAnswer interface{}
+		expectedOutput string
+		errorMatcher   func(error) bool
+	}{
+		{
+			name:  "case 0: ask takes the default value for an empty answer",
+			input: "\n",
+			ask: func(p *Prompter) (interface{}, error) {
+				return p.Ask("Name", "a1b2c", nil)
+			},
+			expectedAnswer: "a1b2c",
+			expectedOutput: "? Name [a1b2c]: ",
+		},
+		{
+			name:  "case 1: ask repeats the question until the answer is valid",
+			input: "ab\nabc\n",
+			ask: func(p *Prompter) (interface{}, error) {
+				return p.Ask("Name", "", func(answer string) error {
+					if len(answer) < 3 {
+						return microerror.Maskf(errTooShort, "%#q is too short", answer)
+					}
+					return nil
+				})
+			},
+			expectedAnswer: "abc",
+			expectedOutput: "? Name:   Err too short: `ab` is too short\n? Name: ",
+		},
+		{
+			name:  "case 2: select by number",
+			input: "2\n",
+			ask: func(p *Prompter) (interface{}, error) {
+				return p.Select("Organization", options, "", nil)
+			},
+			expectedAnswer: "giantswarm",
+			expectedOutput: "? Organization\n  1) acme\n  2) giantswarm\n? Choose: ",
+		},
+		{
+			name:  "case 3: select by value, after a value which is not an option",
+			input: "other\nacme\n",
+			ask: func(p *Prompter) (interface{}, error) {
+				return p.Select("Organization", options, "", nil)
+			},
+			expectedAnswer: "acme",
+			expectedOutput: "? Organization\n  1) acme\n  2) giantswarm\n? Choose:   Invalid answer: `other` is not one of the options\n? Choose: ",
+		},
+		{
+			name:  "case 4: select without options asks for a value",
+			input: "acme\n",
+			ask: func(p *Prompter) (interface{}, error) {
+				return p.Select("Organization", nil, "", nil)
+			},
+			expectedAnswer: "acme",
+			expectedOutput: "? Organization: ",
+		},
+		{
+			name:  "case 5: select multiple",
+			input: "1, giantswarm\n",
+			ask: func(p *Prompter) (interface{}, error) {
+				return p.SelectMultiple("Organizations", options, nil, nil)
+			},
+			expectedAnswer: []string{"acme", "giantswarm"},
+			expectedOutput: "? Organizations\n  1) acme\n  2) giantswarm\n? Choose, separated by commas: ",
+		},
+		{
+			name:  "case 6: ask for a number",
+			input: "three\n3\n",
+			ask: func(p *Prompter) (interface{}, error) {
+				return p.AskInt("Nodes", 10, nil)
+			},
+			expectedAnswer: 3,
+			expectedOutput: "? Nodes [10]:   Invalid answer: `three` is not a number\n? Nodes [10]: ",
+		},
+		{
+			name:  "case 7: confirm",
+			input: "maybe\nyes\n",
+			ask: func(p *Prompter) (interface{}, error) {
+				return p.Confirm("Use spot VMs", false)
+			},
+			expectedAnswer: true,
+			expectedOutput: "? Use spot VMs [y/N]:   Invalid answer: please answer yes or no\n? Use spot VMs [y/N]: ",
+		},
+		{
+			name:  "case 8: no more answers",
+			input: "",
+			ask: func(p *Prompter) (interface{}, error) {
+				return p.Ask("Name", "", nil)
+			},
+			errorMatcher: IsAborted,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := new(bytes.Buffer)
+
+			p, err := New(Config{
+				In:  strings.NewReader(tc.input),
+				Out: out,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			answer, err := tc.ask(p)
+			if tc.errorMatcher != nil {
+				if !tc.errorMatcher(err) {
+					t.Fatalf("error not matching expected matcher, got: %s", errors.Cause(err))
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if diff := cmp.Diff(tc.expectedAnswer, answer); diff != "" {
+				t.Fatalf("answer not expected, got:\n %s", diff)
+			}
+			if diff := cmp.Diff(tc.expectedOutput, out.String()); diff != "" {
+				t.Fatalf("output not expected, got:\n %s", diff)
+			}
+		})
+	}
+}